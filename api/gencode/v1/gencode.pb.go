@@ -0,0 +1,2771 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: gencode/v1/gencode.proto
+
+package v1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Config is the code generator configuration.
+type Config struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the generated project.
+	ProjectName string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	// The generation options.
+	GenConfig *GenConfig `protobuf:"bytes,2,opt,name=gen_config,json=genConfig,proto3" json:"gen_config,omitempty"`
+	// The package names of the generated code.
+	PackageConfig *PackageConfig `protobuf:"bytes,3,opt,name=package_config,json=packageConfig,proto3" json:"package_config,omitempty"`
+	// The deployment options of the generated Jenkinsfile and Kubernetes
+	// manifests.
+	DeployConfig *DeployConfig `protobuf:"bytes,4,opt,name=deploy_config,json=deployConfig,proto3" json:"deploy_config,omitempty"`
+	// The user-defined template variables, available as `.Vars` in templates.
+	Vars          *structpb.Struct `protobuf:"bytes,5,opt,name=vars,proto3" json:"vars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *Config) GetGenConfig() *GenConfig {
+	if x != nil {
+		return x.GenConfig
+	}
+	return nil
+}
+
+func (x *Config) GetPackageConfig() *PackageConfig {
+	if x != nil {
+		return x.PackageConfig
+	}
+	return nil
+}
+
+func (x *Config) GetDeployConfig() *DeployConfig {
+	if x != nil {
+		return x.DeployConfig
+	}
+	return nil
+}
+
+func (x *Config) GetVars() *structpb.Struct {
+	if x != nil {
+		return x.Vars
+	}
+	return nil
+}
+
+// GenConfig is the code generation options.
+type GenConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to generate Lombok annotations.
+	EnableLombok bool `protobuf:"varint,1,opt,name=enable_lombok,json=enableLombok,proto3" json:"enable_lombok,omitempty"`
+	// Whether to generate Swagger annotations.
+	EnableSwagger bool `protobuf:"varint,2,opt,name=enable_swagger,json=enableSwagger,proto3" json:"enable_swagger,omitempty"`
+	// The author written into the generated comments.
+	Author string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	// The date written into the generated comments, defaults to today.
+	Date string `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	// The generation target, `java` (default), `kratos` or `doc` for the data
+	// dictionary in Markdown and HTML.
+	Target string `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	// The column naming conventions of audit, logic-delete and version columns.
+	Conventions *Conventions `protobuf:"bytes,6,opt,name=conventions,proto3" json:"conventions,omitempty"`
+	// The database dialects, `mysql` and/or `postgres`, to write versioned
+	// migration scripts for. No migrations are written if empty.
+	Migration []string `protobuf:"bytes,7,rep,name=migration,proto3" json:"migration,omitempty"`
+	// The database dialect of the generated schema.sql, `mysql` (default),
+	// `postgres` or `sqlite`.
+	Dialect string `protobuf:"bytes,8,opt,name=dialect,proto3" json:"dialect,omitempty"`
+	// The project layout of the java target, `maven` (default) for a single
+	// module, `maven-multi` for `-api`, `-domain` and `-web` modules or
+	// `gradle` for a single module built with the Gradle Kotlin DSL.
+	Layout string `protobuf:"bytes,9,opt,name=layout,proto3" json:"layout,omitempty"`
+	// The Java version profile of the java target, `boot2` (default) for
+	// Spring Boot 2 on Java 8 with `javax` and `Date`, or `boot3` for
+	// Spring Boot 3 on Java 17 with `jakarta` and `LocalDateTime`.
+	Profile string `protobuf:"bytes,10,opt,name=profile,proto3" json:"profile,omitempty"`
+	// The CI pipeline of the java target, `jenkins` (default), `github` for
+	// GitHub Actions, `gitlab` for GitLab CI or `drone`.
+	Ci string `protobuf:"bytes,11,opt,name=ci,proto3" json:"ci,omitempty"`
+	// Whether to fail on undefined `${ENV}` references in config values and
+	// undefined `.Vars` keys in templates. Otherwise undefined environment
+	// variables expand to an empty string.
+	Strict bool `protobuf:"varint,12,opt,name=strict,proto3" json:"strict,omitempty"`
+	// The mock data written as an INSERT script in the schema.sql dialect and
+	// as a JSON fixture per table. No mock data is written if `rows` is 0.
+	Mock *MockConfig `protobuf:"bytes,13,opt,name=mock,proto3" json:"mock,omitempty"`
+	// Whether to generate EasyExcel export, import template and import
+	// endpoints for each table of the java target.
+	EnableExcel bool `protobuf:"varint,14,opt,name=enable_excel,json=enableExcel,proto3" json:"enable_excel,omitempty"`
+	// The permission framework of the java target, spring-security or
+	// sa-token. Controller methods are annotated with permission identifiers
+	// such as `sys:user:list` and a menu seed is written as menu.sql and
+	// menu.json. No permission checks are generated if empty.
+	Auth          string `protobuf:"bytes,15,opt,name=auth,proto3" json:"auth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenConfig) Reset() {
+	*x = GenConfig{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenConfig) ProtoMessage() {}
+
+func (x *GenConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenConfig.ProtoReflect.Descriptor instead.
+func (*GenConfig) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GenConfig) GetEnableLombok() bool {
+	if x != nil {
+		return x.EnableLombok
+	}
+	return false
+}
+
+func (x *GenConfig) GetEnableSwagger() bool {
+	if x != nil {
+		return x.EnableSwagger
+	}
+	return false
+}
+
+func (x *GenConfig) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *GenConfig) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GenConfig) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *GenConfig) GetConventions() *Conventions {
+	if x != nil {
+		return x.Conventions
+	}
+	return nil
+}
+
+func (x *GenConfig) GetMigration() []string {
+	if x != nil {
+		return x.Migration
+	}
+	return nil
+}
+
+func (x *GenConfig) GetDialect() string {
+	if x != nil {
+		return x.Dialect
+	}
+	return ""
+}
+
+func (x *GenConfig) GetLayout() string {
+	if x != nil {
+		return x.Layout
+	}
+	return ""
+}
+
+func (x *GenConfig) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *GenConfig) GetCi() string {
+	if x != nil {
+		return x.Ci
+	}
+	return ""
+}
+
+func (x *GenConfig) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+func (x *GenConfig) GetMock() *MockConfig {
+	if x != nil {
+		return x.Mock
+	}
+	return nil
+}
+
+func (x *GenConfig) GetEnableExcel() bool {
+	if x != nil {
+		return x.EnableExcel
+	}
+	return false
+}
+
+func (x *GenConfig) GetAuth() string {
+	if x != nil {
+		return x.Auth
+	}
+	return ""
+}
+
+// MockConfig is the mock data options.
+type MockConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of rows per table, at most 1000.
+	Rows int32 `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	// The random seed, the same seed and tables give the same data.
+	Seed          int64 `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MockConfig) Reset() {
+	*x = MockConfig{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MockConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockConfig) ProtoMessage() {}
+
+func (x *MockConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockConfig.ProtoReflect.Descriptor instead.
+func (*MockConfig) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MockConfig) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *MockConfig) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+// Conventions detects special columns by name, case-insensitively.
+// An empty list falls back to the built-in defaults.
+type Conventions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Columns filled on insert, defaults to `create_time`, `create_by`, etc.
+	InsertFill []string `protobuf:"bytes,1,rep,name=insert_fill,json=insertFill,proto3" json:"insert_fill,omitempty"`
+	// Columns filled on insert and update, defaults to `update_time`,
+	// `update_by`, etc.
+	UpdateFill []string `protobuf:"bytes,2,rep,name=update_fill,json=updateFill,proto3" json:"update_fill,omitempty"`
+	// Logic-delete columns, defaults to `deleted`, `is_deleted` and `del_flag`.
+	LogicDelete []string `protobuf:"bytes,3,rep,name=logic_delete,json=logicDelete,proto3" json:"logic_delete,omitempty"`
+	// Optimistic-locking version columns, defaults to `version`.
+	Version       []string `protobuf:"bytes,4,rep,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Conventions) Reset() {
+	*x = Conventions{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Conventions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Conventions) ProtoMessage() {}
+
+func (x *Conventions) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Conventions.ProtoReflect.Descriptor instead.
+func (*Conventions) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Conventions) GetInsertFill() []string {
+	if x != nil {
+		return x.InsertFill
+	}
+	return nil
+}
+
+func (x *Conventions) GetUpdateFill() []string {
+	if x != nil {
+		return x.UpdateFill
+	}
+	return nil
+}
+
+func (x *Conventions) GetLogicDelete() []string {
+	if x != nil {
+		return x.LogicDelete
+	}
+	return nil
+}
+
+func (x *Conventions) GetVersion() []string {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+// DeployConfig is the deployment options. Empty fields fall back to defaults.
+type DeployConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The image registry, defaults to `docker.io`.
+	Registry string `protobuf:"bytes,1,opt,name=registry,proto3" json:"registry,omitempty"`
+	// The namespace in the image registry, defaults to `library`.
+	RegistryNamespace string `protobuf:"bytes,2,opt,name=registry_namespace,json=registryNamespace,proto3" json:"registry_namespace,omitempty"`
+	// The Jenkins credential ID of the image registry.
+	RegistryCredential string `protobuf:"bytes,3,opt,name=registry_credential,json=registryCredential,proto3" json:"registry_credential,omitempty"`
+	// The Jenkins credential ID of the kubeconfig.
+	KubeconfigCredential string `protobuf:"bytes,4,opt,name=kubeconfig_credential,json=kubeconfigCredential,proto3" json:"kubeconfig_credential,omitempty"`
+	// The Jenkins credential ID used to push git tags.
+	GitCredential string `protobuf:"bytes,5,opt,name=git_credential,json=gitCredential,proto3" json:"git_credential,omitempty"`
+	// The Kubernetes namespace, defaults to `default`.
+	Namespace string `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The number of replicas, defaults to 1.
+	Replicas int32 `protobuf:"varint,7,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	// The container port, defaults to 8080.
+	Port int32 `protobuf:"varint,8,opt,name=port,proto3" json:"port,omitempty"`
+	// The service port, defaults to the container port.
+	ServicePort int32 `protobuf:"varint,9,opt,name=service_port,json=servicePort,proto3" json:"service_port,omitempty"`
+	// The service type, `ClusterIP` (default), `NodePort` or `LoadBalancer`.
+	ServiceType string `protobuf:"bytes,10,opt,name=service_type,json=serviceType,proto3" json:"service_type,omitempty"`
+	// The container resource requests and limits.
+	Resources *DeployResources `protobuf:"bytes,11,opt,name=resources,proto3" json:"resources,omitempty"`
+	// The readiness and liveness probe.
+	Probe *DeployProbe `protobuf:"bytes,12,opt,name=probe,proto3" json:"probe,omitempty"`
+	// The environments to deploy to, defaults to `dev`, `test` and `prod`.
+	Environments []*DeployEnvironment `protobuf:"bytes,13,rep,name=environments,proto3" json:"environments,omitempty"`
+	// Whether to generate a Helm chart instead of a manifest per environment.
+	Helm          bool `protobuf:"varint,14,opt,name=helm,proto3" json:"helm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeployConfig) Reset() {
+	*x = DeployConfig{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeployConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployConfig) ProtoMessage() {}
+
+func (x *DeployConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployConfig.ProtoReflect.Descriptor instead.
+func (*DeployConfig) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeployConfig) GetRegistry() string {
+	if x != nil {
+		return x.Registry
+	}
+	return ""
+}
+
+func (x *DeployConfig) GetRegistryNamespace() string {
+	if x != nil {
+		return x.RegistryNamespace
+	}
+	return ""
+}
+
+func (x *DeployConfig) GetRegistryCredential() string {
+	if x != nil {
+		return x.RegistryCredential
+	}
+	return ""
+}
+
+func (x *DeployConfig) GetKubeconfigCredential() string {
+	if x != nil {
+		return x.KubeconfigCredential
+	}
+	return ""
+}
+
+func (x *DeployConfig) GetGitCredential() string {
+	if x != nil {
+		return x.GitCredential
+	}
+	return ""
+}
+
+func (x *DeployConfig) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeployConfig) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *DeployConfig) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *DeployConfig) GetServicePort() int32 {
+	if x != nil {
+		return x.ServicePort
+	}
+	return 0
+}
+
+func (x *DeployConfig) GetServiceType() string {
+	if x != nil {
+		return x.ServiceType
+	}
+	return ""
+}
+
+func (x *DeployConfig) GetResources() *DeployResources {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *DeployConfig) GetProbe() *DeployProbe {
+	if x != nil {
+		return x.Probe
+	}
+	return nil
+}
+
+func (x *DeployConfig) GetEnvironments() []*DeployEnvironment {
+	if x != nil {
+		return x.Environments
+	}
+	return nil
+}
+
+func (x *DeployConfig) GetHelm() bool {
+	if x != nil {
+		return x.Helm
+	}
+	return false
+}
+
+// DeployResources is the container resource requests and limits.
+type DeployResources struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The CPU request, defaults to `100m`.
+	CpuRequest string `protobuf:"bytes,1,opt,name=cpu_request,json=cpuRequest,proto3" json:"cpu_request,omitempty"`
+	// The memory request, defaults to `256Mi`.
+	MemoryRequest string `protobuf:"bytes,2,opt,name=memory_request,json=memoryRequest,proto3" json:"memory_request,omitempty"`
+	// The CPU limit, defaults to `1`.
+	CpuLimit string `protobuf:"bytes,3,opt,name=cpu_limit,json=cpuLimit,proto3" json:"cpu_limit,omitempty"`
+	// The memory limit, defaults to `1Gi`.
+	MemoryLimit   string `protobuf:"bytes,4,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeployResources) Reset() {
+	*x = DeployResources{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeployResources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployResources) ProtoMessage() {}
+
+func (x *DeployResources) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployResources.ProtoReflect.Descriptor instead.
+func (*DeployResources) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeployResources) GetCpuRequest() string {
+	if x != nil {
+		return x.CpuRequest
+	}
+	return ""
+}
+
+func (x *DeployResources) GetMemoryRequest() string {
+	if x != nil {
+		return x.MemoryRequest
+	}
+	return ""
+}
+
+func (x *DeployResources) GetCpuLimit() string {
+	if x != nil {
+		return x.CpuLimit
+	}
+	return ""
+}
+
+func (x *DeployResources) GetMemoryLimit() string {
+	if x != nil {
+		return x.MemoryLimit
+	}
+	return ""
+}
+
+// DeployProbe is the readiness and liveness probe.
+type DeployProbe struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The HTTP path to probe, i.e. `/actuator/health`. The TCP port is probed
+	// if empty.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The delay before the first probe.
+	InitialDelaySeconds int32 `protobuf:"varint,2,opt,name=initial_delay_seconds,json=initialDelaySeconds,proto3" json:"initial_delay_seconds,omitempty"`
+	// Defaults to 5.
+	PeriodSeconds int32 `protobuf:"varint,3,opt,name=period_seconds,json=periodSeconds,proto3" json:"period_seconds,omitempty"`
+	// Defaults to 10.
+	TimeoutSeconds int32 `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	// Defaults to 30.
+	FailureThreshold int32 `protobuf:"varint,5,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeployProbe) Reset() {
+	*x = DeployProbe{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeployProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployProbe) ProtoMessage() {}
+
+func (x *DeployProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployProbe.ProtoReflect.Descriptor instead.
+func (*DeployProbe) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeployProbe) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DeployProbe) GetInitialDelaySeconds() int32 {
+	if x != nil {
+		return x.InitialDelaySeconds
+	}
+	return 0
+}
+
+func (x *DeployProbe) GetPeriodSeconds() int32 {
+	if x != nil {
+		return x.PeriodSeconds
+	}
+	return 0
+}
+
+func (x *DeployProbe) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *DeployProbe) GetFailureThreshold() int32 {
+	if x != nil {
+		return x.FailureThreshold
+	}
+	return 0
+}
+
+// DeployEnvironment is an environment to deploy to.
+type DeployEnvironment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The environment name, i.e. `dev`, also the Spring profile to activate.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The Kubernetes namespace, defaults to the namespace of DeployConfig.
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The number of replicas, defaults to the replicas of DeployConfig.
+	Replicas int32 `protobuf:"varint,3,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	// Extra container environment variables.
+	Env           map[string]string `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeployEnvironment) Reset() {
+	*x = DeployEnvironment{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeployEnvironment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployEnvironment) ProtoMessage() {}
+
+func (x *DeployEnvironment) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployEnvironment.ProtoReflect.Descriptor instead.
+func (*DeployEnvironment) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeployEnvironment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeployEnvironment) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeployEnvironment) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *DeployEnvironment) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+// PackageConfig is the package names of the generated code.
+type PackageConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The base package, i.e. `com.example`.
+	BasePackage string `protobuf:"bytes,1,opt,name=base_package,json=basePackage,proto3" json:"base_package,omitempty"`
+	// The package of the entities.
+	EntityPackage string `protobuf:"bytes,2,opt,name=entity_package,json=entityPackage,proto3" json:"entity_package,omitempty"`
+	// The package of the mappers.
+	MapperPackage string `protobuf:"bytes,3,opt,name=mapper_package,json=mapperPackage,proto3" json:"mapper_package,omitempty"`
+	// The package of the services.
+	ServicePackage string `protobuf:"bytes,4,opt,name=service_package,json=servicePackage,proto3" json:"service_package,omitempty"`
+	// The package of the controllers.
+	ControllerPackage string `protobuf:"bytes,5,opt,name=controller_package,json=controllerPackage,proto3" json:"controller_package,omitempty"`
+	// The package of the query conditions, defaults to `{base_package}.query`.
+	QueryPackage string `protobuf:"bytes,6,opt,name=query_package,json=queryPackage,proto3" json:"query_package,omitempty"`
+	// The package of the request DTOs, defaults to `{base_package}.dto`.
+	DtoPackage string `protobuf:"bytes,7,opt,name=dto_package,json=dtoPackage,proto3" json:"dto_package,omitempty"`
+	// The package of the response VOs, defaults to `{base_package}.vo`.
+	VoPackage string `protobuf:"bytes,8,opt,name=vo_package,json=voPackage,proto3" json:"vo_package,omitempty"`
+	// The package of the DTO/VO converters, defaults to
+	// `{base_package}.converter`.
+	ConverterPackage string `protobuf:"bytes,9,opt,name=converter_package,json=converterPackage,proto3" json:"converter_package,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PackageConfig) Reset() {
+	*x = PackageConfig{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackageConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageConfig) ProtoMessage() {}
+
+func (x *PackageConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageConfig.ProtoReflect.Descriptor instead.
+func (*PackageConfig) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PackageConfig) GetBasePackage() string {
+	if x != nil {
+		return x.BasePackage
+	}
+	return ""
+}
+
+func (x *PackageConfig) GetEntityPackage() string {
+	if x != nil {
+		return x.EntityPackage
+	}
+	return ""
+}
+
+func (x *PackageConfig) GetMapperPackage() string {
+	if x != nil {
+		return x.MapperPackage
+	}
+	return ""
+}
+
+func (x *PackageConfig) GetServicePackage() string {
+	if x != nil {
+		return x.ServicePackage
+	}
+	return ""
+}
+
+func (x *PackageConfig) GetControllerPackage() string {
+	if x != nil {
+		return x.ControllerPackage
+	}
+	return ""
+}
+
+func (x *PackageConfig) GetQueryPackage() string {
+	if x != nil {
+		return x.QueryPackage
+	}
+	return ""
+}
+
+func (x *PackageConfig) GetDtoPackage() string {
+	if x != nil {
+		return x.DtoPackage
+	}
+	return ""
+}
+
+func (x *PackageConfig) GetVoPackage() string {
+	if x != nil {
+		return x.VoPackage
+	}
+	return ""
+}
+
+func (x *PackageConfig) GetConverterPackage() string {
+	if x != nil {
+		return x.ConverterPackage
+	}
+	return ""
+}
+
+// Table is the table to generate code for.
+type Table struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the table.
+	TableName string `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	// The comment of the table.
+	TableComment string `protobuf:"bytes,2,opt,name=table_comment,json=tableComment,proto3" json:"table_comment,omitempty"`
+	// The columns of the table.
+	Fields []*Field `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	// The secondary indexes of the table.
+	Indexes []*Index `protobuf:"bytes,4,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	// The declared foreign keys of the table, used for documentation only.
+	ForeignKeys   []*ForeignKey `protobuf:"bytes,5,rep,name=foreign_keys,json=foreignKeys,proto3" json:"foreign_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Table) Reset() {
+	*x = Table{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Table) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Table) ProtoMessage() {}
+
+func (x *Table) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Table.ProtoReflect.Descriptor instead.
+func (*Table) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Table) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *Table) GetTableComment() string {
+	if x != nil {
+		return x.TableComment
+	}
+	return ""
+}
+
+func (x *Table) GetFields() []*Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *Table) GetIndexes() []*Index {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+func (x *Table) GetForeignKeys() []*ForeignKey {
+	if x != nil {
+		return x.ForeignKeys
+	}
+	return nil
+}
+
+// Index is a secondary index of a table.
+type Index struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the index.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The indexed columns in order.
+	Columns []string `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	// Whether the index is unique.
+	Unique        bool `protobuf:"varint,3,opt,name=unique,proto3" json:"unique,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Index) Reset() {
+	*x = Index{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Index) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Index) ProtoMessage() {}
+
+func (x *Index) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Index.ProtoReflect.Descriptor instead.
+func (*Index) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Index) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Index) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *Index) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+// ForeignKey is a declared foreign key of a table.
+type ForeignKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the foreign key.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The referencing columns in order.
+	Columns []string `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	// The referenced table.
+	RefTable string `protobuf:"bytes,3,opt,name=ref_table,json=refTable,proto3" json:"ref_table,omitempty"`
+	// The referenced columns in order.
+	RefColumns    []string `protobuf:"bytes,4,rep,name=ref_columns,json=refColumns,proto3" json:"ref_columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForeignKey) Reset() {
+	*x = ForeignKey{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForeignKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForeignKey) ProtoMessage() {}
+
+func (x *ForeignKey) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForeignKey.ProtoReflect.Descriptor instead.
+func (*ForeignKey) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ForeignKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ForeignKey) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ForeignKey) GetRefTable() string {
+	if x != nil {
+		return x.RefTable
+	}
+	return ""
+}
+
+func (x *ForeignKey) GetRefColumns() []string {
+	if x != nil {
+		return x.RefColumns
+	}
+	return nil
+}
+
+// Field is the column of a table.
+type Field struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the column.
+	ColumnName string `protobuf:"bytes,1,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
+	// The database type of the column, i.e. `varchar`.
+	ColumnType string `protobuf:"bytes,2,opt,name=column_type,json=columnType,proto3" json:"column_type,omitempty"`
+	// The comment of the column.
+	ColumnComment string `protobuf:"bytes,3,opt,name=column_comment,json=columnComment,proto3" json:"column_comment,omitempty"`
+	// Whether the column is nullable.
+	IsNullable bool `protobuf:"varint,4,opt,name=is_nullable,json=isNullable,proto3" json:"is_nullable,omitempty"`
+	// Whether the column is the primary key.
+	IsPrimaryKey bool `protobuf:"varint,5,opt,name=is_primary_key,json=isPrimaryKey,proto3" json:"is_primary_key,omitempty"`
+	// The Go type of the column, derived from column_type if empty.
+	GoType string `protobuf:"bytes,6,opt,name=go_type,json=goType,proto3" json:"go_type,omitempty"`
+	// The Java type of the column, derived from column_type if empty.
+	JavaType string `protobuf:"bytes,7,opt,name=java_type,json=javaType,proto3" json:"java_type,omitempty"`
+	// The field name in the generated code, derived from column_name if empty.
+	FieldName string `protobuf:"bytes,8,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	// Whether to hide the column in the generated list.
+	HideInList bool `protobuf:"varint,9,opt,name=hide_in_list,json=hideInList,proto3" json:"hide_in_list,omitempty"`
+	// Whether the column is read-only in the generated form.
+	ReadOnly bool `protobuf:"varint,10,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	// The query operator of the column, one of `eq`, `like`, `between` or `in`.
+	// The column is not a query condition if empty.
+	QueryType string `protobuf:"bytes,11,opt,name=query_type,json=queryType,proto3" json:"query_type,omitempty"`
+	// The form widget of the column, i.e. `input`, `textarea`, `select` or
+	// `datetime`, derived from column_type if empty.
+	HtmlType string `protobuf:"bytes,12,opt,name=html_type,json=htmlType,proto3" json:"html_type,omitempty"`
+	// The dictionary type bound to the column.
+	DictType string `protobuf:"bytes,13,opt,name=dict_type,json=dictType,proto3" json:"dict_type,omitempty"`
+	// Whether the column is required in the generated form, derived from
+	// is_nullable if unset.
+	Required *bool `protobuf:"varint,14,opt,name=required,proto3,oneof" json:"required,omitempty"`
+	// The auto-fill strategy of the column, `INSERT` or `INSERT_UPDATE`,
+	// detected by conventions if empty.
+	Fill string `protobuf:"bytes,15,opt,name=fill,proto3" json:"fill,omitempty"`
+	// Whether the column is the logic-delete flag.
+	LogicDelete bool `protobuf:"varint,16,opt,name=logic_delete,json=logicDelete,proto3" json:"logic_delete,omitempty"`
+	// Whether the column is the optimistic-locking version.
+	Version bool `protobuf:"varint,17,opt,name=version,proto3" json:"version,omitempty"`
+	// The declared length of the column, i.e. 64 for `varchar(64)`.
+	Length int32 `protobuf:"varint,18,opt,name=length,proto3" json:"length,omitempty"`
+	// Whether the column is sensitive and excluded from responses.
+	Sensitive bool `protobuf:"varint,19,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	// The declared scale of the column, i.e. 2 for `decimal(10,2)`.
+	Scale int32 `protobuf:"varint,20,opt,name=scale,proto3" json:"scale,omitempty"`
+	// The default value expression of the column, i.e. `0` or
+	// `CURRENT_TIMESTAMP`.
+	DefaultValue string `protobuf:"bytes,21,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	// The allowed values of an `enum` or `set` column.
+	EnumValues []string `protobuf:"bytes,22,rep,name=enum_values,json=enumValues,proto3" json:"enum_values,omitempty"`
+	// Whether the column is declared `AUTO_INCREMENT`. Primary keys that are
+	// not auto-increment are generated by the application, i.e. snowflake IDs.
+	AutoIncrement bool `protobuf:"varint,23,opt,name=auto_increment,json=autoIncrement,proto3" json:"auto_increment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Field) Reset() {
+	*x = Field{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Field) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Field) ProtoMessage() {}
+
+func (x *Field) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Field.ProtoReflect.Descriptor instead.
+func (*Field) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Field) GetColumnName() string {
+	if x != nil {
+		return x.ColumnName
+	}
+	return ""
+}
+
+func (x *Field) GetColumnType() string {
+	if x != nil {
+		return x.ColumnType
+	}
+	return ""
+}
+
+func (x *Field) GetColumnComment() string {
+	if x != nil {
+		return x.ColumnComment
+	}
+	return ""
+}
+
+func (x *Field) GetIsNullable() bool {
+	if x != nil {
+		return x.IsNullable
+	}
+	return false
+}
+
+func (x *Field) GetIsPrimaryKey() bool {
+	if x != nil {
+		return x.IsPrimaryKey
+	}
+	return false
+}
+
+func (x *Field) GetGoType() string {
+	if x != nil {
+		return x.GoType
+	}
+	return ""
+}
+
+func (x *Field) GetJavaType() string {
+	if x != nil {
+		return x.JavaType
+	}
+	return ""
+}
+
+func (x *Field) GetFieldName() string {
+	if x != nil {
+		return x.FieldName
+	}
+	return ""
+}
+
+func (x *Field) GetHideInList() bool {
+	if x != nil {
+		return x.HideInList
+	}
+	return false
+}
+
+func (x *Field) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *Field) GetQueryType() string {
+	if x != nil {
+		return x.QueryType
+	}
+	return ""
+}
+
+func (x *Field) GetHtmlType() string {
+	if x != nil {
+		return x.HtmlType
+	}
+	return ""
+}
+
+func (x *Field) GetDictType() string {
+	if x != nil {
+		return x.DictType
+	}
+	return ""
+}
+
+func (x *Field) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *Field) GetFill() string {
+	if x != nil {
+		return x.Fill
+	}
+	return ""
+}
+
+func (x *Field) GetLogicDelete() bool {
+	if x != nil {
+		return x.LogicDelete
+	}
+	return false
+}
+
+func (x *Field) GetVersion() bool {
+	if x != nil {
+		return x.Version
+	}
+	return false
+}
+
+func (x *Field) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Field) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+func (x *Field) GetScale() int32 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+func (x *Field) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *Field) GetEnumValues() []string {
+	if x != nil {
+		return x.EnumValues
+	}
+	return nil
+}
+
+func (x *Field) GetAutoIncrement() bool {
+	if x != nil {
+		return x.AutoIncrement
+	}
+	return false
+}
+
+// Project is a generator project persisted for later regeneration.
+type Project struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique ID of the project.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name of the project, used as the generated project name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The description of the project.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The code generator configuration, `config.project_name` is taken from `name`.
+	Config *Config `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	// The timestamp at which the project was created.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The latest timestamp at which the project was updated.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Project) Reset() {
+	*x = Project{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project) ProtoMessage() {}
+
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Project) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Project) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Project) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Project) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *Project) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Project) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// ProjectSet is the set of projects.
+type ProjectSet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The set of projects.
+	Projects []*Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	// The next page token.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectSet) Reset() {
+	*x = ProjectSet{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectSet) ProtoMessage() {}
+
+func (x *ProjectSet) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectSet.ProtoReflect.Descriptor instead.
+func (*ProjectSet) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ProjectSet) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *ProjectSet) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ProjectTable is a table imported into a project.
+type ProjectTable struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique ID of the project table.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The ID of the project the table belongs to.
+	ProjectId int64 `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// The table and its column settings.
+	Table *Table `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	// The timestamp at which the table was imported.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The latest timestamp at which the table was updated.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectTable) Reset() {
+	*x = ProjectTable{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectTable) ProtoMessage() {}
+
+func (x *ProjectTable) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectTable.ProtoReflect.Descriptor instead.
+func (*ProjectTable) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ProjectTable) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProjectTable) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *ProjectTable) GetTable() *Table {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+func (x *ProjectTable) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *ProjectTable) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// ProjectTableSet is the set of project tables.
+type ProjectTableSet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The set of project tables.
+	Tables        []*ProjectTable `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectTableSet) Reset() {
+	*x = ProjectTableSet{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectTableSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectTableSet) ProtoMessage() {}
+
+func (x *ProjectTableSet) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectTableSet.ProtoReflect.Descriptor instead.
+func (*ProjectTableSet) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ProjectTableSet) GetTables() []*ProjectTable {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+// GenerateRequest is the request message for the GenerateFiles and
+// GenerateArchive methods.
+type GenerateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The code generator configuration, required unless `project_id` is set.
+	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	// The tables to generate code for.
+	Tables []*Table `protobuf:"bytes,2,rep,name=tables,proto3" json:"tables,omitempty"`
+	// The MySQL `CREATE TABLE` statements to generate code for.
+	// Exactly one of `tables`, `ddl` or `project_id` must be set.
+	Ddl string `protobuf:"bytes,3,opt,name=ddl,proto3" json:"ddl,omitempty"`
+	// The ID of a saved project to regenerate with its config and tables.
+	ProjectId     int64 `protobuf:"varint,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateRequest) Reset() {
+	*x = GenerateRequest{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRequest) ProtoMessage() {}
+
+func (x *GenerateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRequest.ProtoReflect.Descriptor instead.
+func (*GenerateRequest) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GenerateRequest) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *GenerateRequest) GetTables() []*Table {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *GenerateRequest) GetDdl() string {
+	if x != nil {
+		return x.Ddl
+	}
+	return ""
+}
+
+func (x *GenerateRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+// GeneratedFile is a generated file.
+type GeneratedFile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The path of the file relative to the project root.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The size of the file in bytes.
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// The content of the file.
+	Content       string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneratedFile) Reset() {
+	*x = GeneratedFile{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratedFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratedFile) ProtoMessage() {}
+
+func (x *GeneratedFile) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratedFile.ProtoReflect.Descriptor instead.
+func (*GeneratedFile) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GeneratedFile) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GeneratedFile) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GeneratedFile) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// GeneratedFileSet is the set of generated files.
+type GeneratedFileSet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The generated files.
+	Files         []*GeneratedFile `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneratedFileSet) Reset() {
+	*x = GeneratedFileSet{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratedFileSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratedFileSet) ProtoMessage() {}
+
+func (x *GeneratedFileSet) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratedFileSet.ProtoReflect.Descriptor instead.
+func (*GeneratedFileSet) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GeneratedFileSet) GetFiles() []*GeneratedFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+// GeneratedArchive is the zip archive of the generated files.
+type GeneratedArchive struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The file name of the archive, i.e. `demo.zip`.
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// The content of the archive.
+	Content       []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneratedArchive) Reset() {
+	*x = GeneratedArchive{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratedArchive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratedArchive) ProtoMessage() {}
+
+func (x *GeneratedArchive) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratedArchive.ProtoReflect.Descriptor instead.
+func (*GeneratedArchive) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GeneratedArchive) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *GeneratedArchive) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// GetProjectRequest is the request message for the GetProject method.
+type GetProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the project to retrieve.
+	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectRequest) Reset() {
+	*x = GetProjectRequest{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectRequest) ProtoMessage() {}
+
+func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectRequest) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetProjectRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ListProjectsRequest is the request message for the ListProjects method.
+type ListProjectsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The number of projects per page.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. The page token.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. The standard list filter.
+	// Supported fields:
+	//    * `name` (i.e. `name="demo"`)
+	//    * `create_time` range (i.e. `timestamp>="2025-01-31T11:30:00-04:00"` where
+	//    the timestamp is in RFC 3339 format)
+	//
+	// More detail in [AIP-160](https://google.aip.dev/160).
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Optional. A comma-separated list of fields to order by, sorted in ascending
+	// order. Use "desc" after a field name for descending. Supported fields:
+	// - `name`
+	// - `create_time`
+	// - `update_time`
+	//
+	// Example: `update_time desc`.
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsRequest) Reset() {
+	*x = ListProjectsRequest{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsRequest) ProtoMessage() {}
+
+func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListProjectsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProjectsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListProjectsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListProjectsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+// CreateProjectRequest is the request message for the CreateProject method.
+type CreateProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The project to create.
+	Project       *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectRequest) Reset() {
+	*x = CreateProjectRequest{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectRequest) ProtoMessage() {}
+
+func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateProjectRequest) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+// UpdateProjectRequest is the request message for the UpdateProject method.
+type UpdateProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The project to update.
+	Project *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// Required. Mask of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectRequest) Reset() {
+	*x = UpdateProjectRequest{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectRequest) ProtoMessage() {}
+
+func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateProjectRequest) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *UpdateProjectRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// DeleteProjectRequest is the request message for the DeleteProject method.
+type DeleteProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The ID of the project to delete.
+	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectRequest) Reset() {
+	*x = DeleteProjectRequest{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectRequest) ProtoMessage() {}
+
+func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteProjectRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ImportTablesRequest is the request message for the ImportTables method.
+type ImportTablesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The ID of the project to import the tables into.
+	ProjectId int64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// The tables to import.
+	Tables []*Table `protobuf:"bytes,2,rep,name=tables,proto3" json:"tables,omitempty"`
+	// The MySQL `CREATE TABLE` statements to import.
+	// Exactly one of `tables` or `ddl` must be set.
+	Ddl           string `protobuf:"bytes,3,opt,name=ddl,proto3" json:"ddl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTablesRequest) Reset() {
+	*x = ImportTablesRequest{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTablesRequest) ProtoMessage() {}
+
+func (x *ImportTablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTablesRequest.ProtoReflect.Descriptor instead.
+func (*ImportTablesRequest) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ImportTablesRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *ImportTablesRequest) GetTables() []*Table {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *ImportTablesRequest) GetDdl() string {
+	if x != nil {
+		return x.Ddl
+	}
+	return ""
+}
+
+// ListTablesRequest is the request message for the ListTables method.
+type ListTablesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The ID of the project.
+	ProjectId     int64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTablesRequest) Reset() {
+	*x = ListTablesRequest{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTablesRequest) ProtoMessage() {}
+
+func (x *ListTablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTablesRequest.ProtoReflect.Descriptor instead.
+func (*ListTablesRequest) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListTablesRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+// UpdateTableRequest is the request message for the UpdateTable method.
+type UpdateTableRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The project table to update.
+	Table *ProjectTable `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	// Required. Mask of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTableRequest) Reset() {
+	*x = UpdateTableRequest{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTableRequest) ProtoMessage() {}
+
+func (x *UpdateTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTableRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTableRequest) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateTableRequest) GetTable() *ProjectTable {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+func (x *UpdateTableRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// DeleteTableRequest is the request message for the DeleteTable method.
+type DeleteTableRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The ID of the project table to delete.
+	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTableRequest) Reset() {
+	*x = DeleteTableRequest{}
+	mi := &file_gencode_v1_gencode_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTableRequest) ProtoMessage() {}
+
+func (x *DeleteTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gencode_v1_gencode_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTableRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTableRequest) Descriptor() ([]byte, []int) {
+	return file_gencode_v1_gencode_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteTableRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_gencode_v1_gencode_proto protoreflect.FileDescriptor
+
+const file_gencode_v1_gencode_proto_rawDesc = "" +
+	"\n" +
+	"\x18gencode/v1/gencode.proto\x12\n" +
+	"gencode.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\"\x8f\x02\n" +
+	"\x06Config\x12!\n" +
+	"\fproject_name\x18\x01 \x01(\tR\vprojectName\x124\n" +
+	"\n" +
+	"gen_config\x18\x02 \x01(\v2\x15.gencode.v1.GenConfigR\tgenConfig\x12@\n" +
+	"\x0epackage_config\x18\x03 \x01(\v2\x19.gencode.v1.PackageConfigR\rpackageConfig\x12=\n" +
+	"\rdeploy_config\x18\x04 \x01(\v2\x18.gencode.v1.DeployConfigR\fdeployConfig\x12+\n" +
+	"\x04vars\x18\x05 \x01(\v2\x17.google.protobuf.StructR\x04vars\"\xcb\x03\n" +
+	"\tGenConfig\x12#\n" +
+	"\renable_lombok\x18\x01 \x01(\bR\fenableLombok\x12%\n" +
+	"\x0eenable_swagger\x18\x02 \x01(\bR\renableSwagger\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12\x16\n" +
+	"\x06target\x18\x05 \x01(\tR\x06target\x129\n" +
+	"\vconventions\x18\x06 \x01(\v2\x17.gencode.v1.ConventionsR\vconventions\x12\x1c\n" +
+	"\tmigration\x18\a \x03(\tR\tmigration\x12\x18\n" +
+	"\adialect\x18\b \x01(\tR\adialect\x12\x16\n" +
+	"\x06layout\x18\t \x01(\tR\x06layout\x12\x18\n" +
+	"\aprofile\x18\n" +
+	" \x01(\tR\aprofile\x12\x0e\n" +
+	"\x02ci\x18\v \x01(\tR\x02ci\x12\x16\n" +
+	"\x06strict\x18\f \x01(\bR\x06strict\x12*\n" +
+	"\x04mock\x18\r \x01(\v2\x16.gencode.v1.MockConfigR\x04mock\x12!\n" +
+	"\fenable_excel\x18\x0e \x01(\bR\venableExcel\x12\x12\n" +
+	"\x04auth\x18\x0f \x01(\tR\x04auth\"4\n" +
+	"\n" +
+	"MockConfig\x12\x12\n" +
+	"\x04rows\x18\x01 \x01(\x05R\x04rows\x12\x12\n" +
+	"\x04seed\x18\x02 \x01(\x03R\x04seed\"\x8c\x01\n" +
+	"\vConventions\x12\x1f\n" +
+	"\vinsert_fill\x18\x01 \x03(\tR\n" +
+	"insertFill\x12\x1f\n" +
+	"\vupdate_fill\x18\x02 \x03(\tR\n" +
+	"updateFill\x12!\n" +
+	"\flogic_delete\x18\x03 \x03(\tR\vlogicDelete\x12\x18\n" +
+	"\aversion\x18\x04 \x03(\tR\aversion\"\xbb\x04\n" +
+	"\fDeployConfig\x12\x1a\n" +
+	"\bregistry\x18\x01 \x01(\tR\bregistry\x12-\n" +
+	"\x12registry_namespace\x18\x02 \x01(\tR\x11registryNamespace\x12/\n" +
+	"\x13registry_credential\x18\x03 \x01(\tR\x12registryCredential\x123\n" +
+	"\x15kubeconfig_credential\x18\x04 \x01(\tR\x14kubeconfigCredential\x12%\n" +
+	"\x0egit_credential\x18\x05 \x01(\tR\rgitCredential\x12\x1c\n" +
+	"\tnamespace\x18\x06 \x01(\tR\tnamespace\x12\x1a\n" +
+	"\breplicas\x18\a \x01(\x05R\breplicas\x12\x12\n" +
+	"\x04port\x18\b \x01(\x05R\x04port\x12!\n" +
+	"\fservice_port\x18\t \x01(\x05R\vservicePort\x12!\n" +
+	"\fservice_type\x18\n" +
+	" \x01(\tR\vserviceType\x129\n" +
+	"\tresources\x18\v \x01(\v2\x1b.gencode.v1.DeployResourcesR\tresources\x12-\n" +
+	"\x05probe\x18\f \x01(\v2\x17.gencode.v1.DeployProbeR\x05probe\x12A\n" +
+	"\fenvironments\x18\r \x03(\v2\x1d.gencode.v1.DeployEnvironmentR\fenvironments\x12\x12\n" +
+	"\x04helm\x18\x0e \x01(\bR\x04helm\"\x99\x01\n" +
+	"\x0fDeployResources\x12\x1f\n" +
+	"\vcpu_request\x18\x01 \x01(\tR\n" +
+	"cpuRequest\x12%\n" +
+	"\x0ememory_request\x18\x02 \x01(\tR\rmemoryRequest\x12\x1b\n" +
+	"\tcpu_limit\x18\x03 \x01(\tR\bcpuLimit\x12!\n" +
+	"\fmemory_limit\x18\x04 \x01(\tR\vmemoryLimit\"\xd2\x01\n" +
+	"\vDeployProbe\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x122\n" +
+	"\x15initial_delay_seconds\x18\x02 \x01(\x05R\x13initialDelaySeconds\x12%\n" +
+	"\x0eperiod_seconds\x18\x03 \x01(\x05R\rperiodSeconds\x12'\n" +
+	"\x0ftimeout_seconds\x18\x04 \x01(\x05R\x0etimeoutSeconds\x12+\n" +
+	"\x11failure_threshold\x18\x05 \x01(\x05R\x10failureThreshold\"\xd3\x01\n" +
+	"\x11DeployEnvironment\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1a\n" +
+	"\breplicas\x18\x03 \x01(\x05R\breplicas\x128\n" +
+	"\x03env\x18\x04 \x03(\v2&.gencode.v1.DeployEnvironment.EnvEntryR\x03env\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xea\x02\n" +
+	"\rPackageConfig\x12!\n" +
+	"\fbase_package\x18\x01 \x01(\tR\vbasePackage\x12%\n" +
+	"\x0eentity_package\x18\x02 \x01(\tR\rentityPackage\x12%\n" +
+	"\x0emapper_package\x18\x03 \x01(\tR\rmapperPackage\x12'\n" +
+	"\x0fservice_package\x18\x04 \x01(\tR\x0eservicePackage\x12-\n" +
+	"\x12controller_package\x18\x05 \x01(\tR\x11controllerPackage\x12#\n" +
+	"\rquery_package\x18\x06 \x01(\tR\fqueryPackage\x12\x1f\n" +
+	"\vdto_package\x18\a \x01(\tR\n" +
+	"dtoPackage\x12\x1d\n" +
+	"\n" +
+	"vo_package\x18\b \x01(\tR\tvoPackage\x12+\n" +
+	"\x11converter_package\x18\t \x01(\tR\x10converterPackage\"\xde\x01\n" +
+	"\x05Table\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x01 \x01(\tR\ttableName\x12#\n" +
+	"\rtable_comment\x18\x02 \x01(\tR\ftableComment\x12)\n" +
+	"\x06fields\x18\x03 \x03(\v2\x11.gencode.v1.FieldR\x06fields\x12+\n" +
+	"\aindexes\x18\x04 \x03(\v2\x11.gencode.v1.IndexR\aindexes\x129\n" +
+	"\fforeign_keys\x18\x05 \x03(\v2\x16.gencode.v1.ForeignKeyR\vforeignKeys\"M\n" +
+	"\x05Index\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\acolumns\x18\x02 \x03(\tR\acolumns\x12\x16\n" +
+	"\x06unique\x18\x03 \x01(\bR\x06unique\"x\n" +
+	"\n" +
+	"ForeignKey\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\acolumns\x18\x02 \x03(\tR\acolumns\x12\x1b\n" +
+	"\tref_table\x18\x03 \x01(\tR\brefTable\x12\x1f\n" +
+	"\vref_columns\x18\x04 \x03(\tR\n" +
+	"refColumns\"\xdc\x05\n" +
+	"\x05Field\x12\x1f\n" +
+	"\vcolumn_name\x18\x01 \x01(\tR\n" +
+	"columnName\x12\x1f\n" +
+	"\vcolumn_type\x18\x02 \x01(\tR\n" +
+	"columnType\x12%\n" +
+	"\x0ecolumn_comment\x18\x03 \x01(\tR\rcolumnComment\x12\x1f\n" +
+	"\vis_nullable\x18\x04 \x01(\bR\n" +
+	"isNullable\x12$\n" +
+	"\x0eis_primary_key\x18\x05 \x01(\bR\fisPrimaryKey\x12\x17\n" +
+	"\ago_type\x18\x06 \x01(\tR\x06goType\x12\x1b\n" +
+	"\tjava_type\x18\a \x01(\tR\bjavaType\x12\x1d\n" +
+	"\n" +
+	"field_name\x18\b \x01(\tR\tfieldName\x12 \n" +
+	"\fhide_in_list\x18\t \x01(\bR\n" +
+	"hideInList\x12\x1b\n" +
+	"\tread_only\x18\n" +
+	" \x01(\bR\breadOnly\x12\x1d\n" +
+	"\n" +
+	"query_type\x18\v \x01(\tR\tqueryType\x12\x1b\n" +
+	"\thtml_type\x18\f \x01(\tR\bhtmlType\x12\x1b\n" +
+	"\tdict_type\x18\r \x01(\tR\bdictType\x12\x1f\n" +
+	"\brequired\x18\x0e \x01(\bH\x00R\brequired\x88\x01\x01\x12\x12\n" +
+	"\x04fill\x18\x0f \x01(\tR\x04fill\x12!\n" +
+	"\flogic_delete\x18\x10 \x01(\bR\vlogicDelete\x12\x18\n" +
+	"\aversion\x18\x11 \x01(\bR\aversion\x12\x16\n" +
+	"\x06length\x18\x12 \x01(\x05R\x06length\x12\x1c\n" +
+	"\tsensitive\x18\x13 \x01(\bR\tsensitive\x12\x14\n" +
+	"\x05scale\x18\x14 \x01(\x05R\x05scale\x12#\n" +
+	"\rdefault_value\x18\x15 \x01(\tR\fdefaultValue\x12\x1f\n" +
+	"\venum_values\x18\x16 \x03(\tR\n" +
+	"enumValues\x12%\n" +
+	"\x0eauto_increment\x18\x17 \x01(\bR\rautoIncrementB\v\n" +
+	"\t_required\"\xf5\x01\n" +
+	"\aProject\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12*\n" +
+	"\x06config\x18\x04 \x01(\v2\x12.gencode.v1.ConfigR\x06config\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"e\n" +
+	"\n" +
+	"ProjectSet\x12/\n" +
+	"\bprojects\x18\x01 \x03(\v2\x13.gencode.v1.ProjectR\bprojects\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xe0\x01\n" +
+	"\fProjectTable\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\x03R\tprojectId\x12'\n" +
+	"\x05table\x18\x03 \x01(\v2\x11.gencode.v1.TableR\x05table\x12;\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"C\n" +
+	"\x0fProjectTableSet\x120\n" +
+	"\x06tables\x18\x01 \x03(\v2\x18.gencode.v1.ProjectTableR\x06tables\"\x99\x01\n" +
+	"\x0fGenerateRequest\x12*\n" +
+	"\x06config\x18\x01 \x01(\v2\x12.gencode.v1.ConfigR\x06config\x12)\n" +
+	"\x06tables\x18\x02 \x03(\v2\x11.gencode.v1.TableR\x06tables\x12\x10\n" +
+	"\x03ddl\x18\x03 \x01(\tR\x03ddl\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x04 \x01(\x03R\tprojectId\"Q\n" +
+	"\rGeneratedFile\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"C\n" +
+	"\x10GeneratedFileSet\x12/\n" +
+	"\x05files\x18\x01 \x03(\v2\x19.gencode.v1.GeneratedFileR\x05files\"H\n" +
+	"\x10GeneratedArchive\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\")\n" +
+	"\x11GetProjectRequest\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\x03B\x04\xe2A\x01\x02R\x02id\"\x84\x01\n" +
+	"\x13ListProjectsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\"K\n" +
+	"\x14CreateProjectRequest\x123\n" +
+	"\aproject\x18\x01 \x01(\v2\x13.gencode.v1.ProjectB\x04\xe2A\x01\x02R\aproject\"\x8e\x01\n" +
+	"\x14UpdateProjectRequest\x123\n" +
+	"\aproject\x18\x01 \x01(\v2\x13.gencode.v1.ProjectB\x04\xe2A\x01\x02R\aproject\x12A\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x04\xe2A\x01\x02R\n" +
+	"updateMask\",\n" +
+	"\x14DeleteProjectRequest\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\x03B\x04\xe2A\x01\x02R\x02id\"w\n" +
+	"\x13ImportTablesRequest\x12#\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\x03B\x04\xe2A\x01\x02R\tprojectId\x12)\n" +
+	"\x06tables\x18\x02 \x03(\v2\x11.gencode.v1.TableR\x06tables\x12\x10\n" +
+	"\x03ddl\x18\x03 \x01(\tR\x03ddl\"8\n" +
+	"\x11ListTablesRequest\x12#\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\x03B\x04\xe2A\x01\x02R\tprojectId\"\x8d\x01\n" +
+	"\x12UpdateTableRequest\x124\n" +
+	"\x05table\x18\x01 \x01(\v2\x18.gencode.v1.ProjectTableB\x04\xe2A\x01\x02R\x05table\x12A\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x04\xe2A\x01\x02R\n" +
+	"updateMask\"*\n" +
+	"\x12DeleteTableRequest\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\x03B\x04\xe2A\x01\x02R\x02id2\xad\t\n" +
+	"\x0eGenCodeService\x12h\n" +
+	"\rGenerateFiles\x12\x1b.gencode.v1.GenerateRequest\x1a\x1c.gencode.v1.GeneratedFileSet\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/gencode/files\x12l\n" +
+	"\x0fGenerateArchive\x12\x1b.gencode.v1.GenerateRequest\x1a\x1c.gencode.v1.GeneratedArchive\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/gencode/archive\x12b\n" +
+	"\fListProjects\x12\x1f.gencode.v1.ListProjectsRequest\x1a\x16.gencode.v1.ProjectSet\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/projects/list\x12l\n" +
+	"\rCreateProject\x12 .gencode.v1.CreateProjectRequest\x1a\x13.gencode.v1.Project\"$\x82\xd3\xe4\x93\x02\x1e:\aproject\"\x13/v1/projects/create\x12l\n" +
+	"\rUpdateProject\x12 .gencode.v1.UpdateProjectRequest\x1a\x13.gencode.v1.Project\"$\x82\xd3\xe4\x93\x02\x1e:\aproject\x1a\x13/v1/projects/update\x12d\n" +
+	"\rDeleteProject\x12 .gencode.v1.DeleteProjectRequest\x1a\x16.google.protobuf.Empty\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/projects/{id}\x12[\n" +
+	"\n" +
+	"GetProject\x12\x1d.gencode.v1.GetProjectRequest\x1a\x13.gencode.v1.Project\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/projects/{id}\x12\x80\x01\n" +
+	"\fImportTables\x12\x1f.gencode.v1.ImportTablesRequest\x1a\x1b.gencode.v1.ProjectTableSet\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/projects/{project_id}/tables/import\x12r\n" +
+	"\n" +
+	"ListTables\x12\x1d.gencode.v1.ListTablesRequest\x1a\x1b.gencode.v1.ProjectTableSet\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/projects/{project_id}/tables\x12i\n" +
+	"\vUpdateTable\x12\x1e.gencode.v1.UpdateTableRequest\x1a\x18.gencode.v1.ProjectTable\" \x82\xd3\xe4\x93\x02\x1a:\x05table\x1a\x11/v1/tables/update\x12^\n" +
+	"\vDeleteTable\x12\x1e.gencode.v1.DeleteTableRequest\x1a\x16.google.protobuf.Empty\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/tables/{id}B.\n" +
+	"\x0eapi.gencode.v1P\x01Z\x1agen_code/api/gencode/v1;v1b\x06proto3"
+
+var (
+	file_gencode_v1_gencode_proto_rawDescOnce sync.Once
+	file_gencode_v1_gencode_proto_rawDescData []byte
+)
+
+func file_gencode_v1_gencode_proto_rawDescGZIP() []byte {
+	file_gencode_v1_gencode_proto_rawDescOnce.Do(func() {
+		file_gencode_v1_gencode_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_gencode_v1_gencode_proto_rawDesc), len(file_gencode_v1_gencode_proto_rawDesc)))
+	})
+	return file_gencode_v1_gencode_proto_rawDescData
+}
+
+var file_gencode_v1_gencode_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_gencode_v1_gencode_proto_goTypes = []any{
+	(*Config)(nil),                // 0: gencode.v1.Config
+	(*GenConfig)(nil),             // 1: gencode.v1.GenConfig
+	(*MockConfig)(nil),            // 2: gencode.v1.MockConfig
+	(*Conventions)(nil),           // 3: gencode.v1.Conventions
+	(*DeployConfig)(nil),          // 4: gencode.v1.DeployConfig
+	(*DeployResources)(nil),       // 5: gencode.v1.DeployResources
+	(*DeployProbe)(nil),           // 6: gencode.v1.DeployProbe
+	(*DeployEnvironment)(nil),     // 7: gencode.v1.DeployEnvironment
+	(*PackageConfig)(nil),         // 8: gencode.v1.PackageConfig
+	(*Table)(nil),                 // 9: gencode.v1.Table
+	(*Index)(nil),                 // 10: gencode.v1.Index
+	(*ForeignKey)(nil),            // 11: gencode.v1.ForeignKey
+	(*Field)(nil),                 // 12: gencode.v1.Field
+	(*Project)(nil),               // 13: gencode.v1.Project
+	(*ProjectSet)(nil),            // 14: gencode.v1.ProjectSet
+	(*ProjectTable)(nil),          // 15: gencode.v1.ProjectTable
+	(*ProjectTableSet)(nil),       // 16: gencode.v1.ProjectTableSet
+	(*GenerateRequest)(nil),       // 17: gencode.v1.GenerateRequest
+	(*GeneratedFile)(nil),         // 18: gencode.v1.GeneratedFile
+	(*GeneratedFileSet)(nil),      // 19: gencode.v1.GeneratedFileSet
+	(*GeneratedArchive)(nil),      // 20: gencode.v1.GeneratedArchive
+	(*GetProjectRequest)(nil),     // 21: gencode.v1.GetProjectRequest
+	(*ListProjectsRequest)(nil),   // 22: gencode.v1.ListProjectsRequest
+	(*CreateProjectRequest)(nil),  // 23: gencode.v1.CreateProjectRequest
+	(*UpdateProjectRequest)(nil),  // 24: gencode.v1.UpdateProjectRequest
+	(*DeleteProjectRequest)(nil),  // 25: gencode.v1.DeleteProjectRequest
+	(*ImportTablesRequest)(nil),   // 26: gencode.v1.ImportTablesRequest
+	(*ListTablesRequest)(nil),     // 27: gencode.v1.ListTablesRequest
+	(*UpdateTableRequest)(nil),    // 28: gencode.v1.UpdateTableRequest
+	(*DeleteTableRequest)(nil),    // 29: gencode.v1.DeleteTableRequest
+	nil,                           // 30: gencode.v1.DeployEnvironment.EnvEntry
+	(*structpb.Struct)(nil),       // 31: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil), // 32: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 33: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 34: google.protobuf.Empty
+}
+var file_gencode_v1_gencode_proto_depIdxs = []int32{
+	1,  // 0: gencode.v1.Config.gen_config:type_name -> gencode.v1.GenConfig
+	8,  // 1: gencode.v1.Config.package_config:type_name -> gencode.v1.PackageConfig
+	4,  // 2: gencode.v1.Config.deploy_config:type_name -> gencode.v1.DeployConfig
+	31, // 3: gencode.v1.Config.vars:type_name -> google.protobuf.Struct
+	3,  // 4: gencode.v1.GenConfig.conventions:type_name -> gencode.v1.Conventions
+	2,  // 5: gencode.v1.GenConfig.mock:type_name -> gencode.v1.MockConfig
+	5,  // 6: gencode.v1.DeployConfig.resources:type_name -> gencode.v1.DeployResources
+	6,  // 7: gencode.v1.DeployConfig.probe:type_name -> gencode.v1.DeployProbe
+	7,  // 8: gencode.v1.DeployConfig.environments:type_name -> gencode.v1.DeployEnvironment
+	30, // 9: gencode.v1.DeployEnvironment.env:type_name -> gencode.v1.DeployEnvironment.EnvEntry
+	12, // 10: gencode.v1.Table.fields:type_name -> gencode.v1.Field
+	10, // 11: gencode.v1.Table.indexes:type_name -> gencode.v1.Index
+	11, // 12: gencode.v1.Table.foreign_keys:type_name -> gencode.v1.ForeignKey
+	0,  // 13: gencode.v1.Project.config:type_name -> gencode.v1.Config
+	32, // 14: gencode.v1.Project.create_time:type_name -> google.protobuf.Timestamp
+	32, // 15: gencode.v1.Project.update_time:type_name -> google.protobuf.Timestamp
+	13, // 16: gencode.v1.ProjectSet.projects:type_name -> gencode.v1.Project
+	9,  // 17: gencode.v1.ProjectTable.table:type_name -> gencode.v1.Table
+	32, // 18: gencode.v1.ProjectTable.create_time:type_name -> google.protobuf.Timestamp
+	32, // 19: gencode.v1.ProjectTable.update_time:type_name -> google.protobuf.Timestamp
+	15, // 20: gencode.v1.ProjectTableSet.tables:type_name -> gencode.v1.ProjectTable
+	0,  // 21: gencode.v1.GenerateRequest.config:type_name -> gencode.v1.Config
+	9,  // 22: gencode.v1.GenerateRequest.tables:type_name -> gencode.v1.Table
+	18, // 23: gencode.v1.GeneratedFileSet.files:type_name -> gencode.v1.GeneratedFile
+	13, // 24: gencode.v1.CreateProjectRequest.project:type_name -> gencode.v1.Project
+	13, // 25: gencode.v1.UpdateProjectRequest.project:type_name -> gencode.v1.Project
+	33, // 26: gencode.v1.UpdateProjectRequest.update_mask:type_name -> google.protobuf.FieldMask
+	9,  // 27: gencode.v1.ImportTablesRequest.tables:type_name -> gencode.v1.Table
+	15, // 28: gencode.v1.UpdateTableRequest.table:type_name -> gencode.v1.ProjectTable
+	33, // 29: gencode.v1.UpdateTableRequest.update_mask:type_name -> google.protobuf.FieldMask
+	17, // 30: gencode.v1.GenCodeService.GenerateFiles:input_type -> gencode.v1.GenerateRequest
+	17, // 31: gencode.v1.GenCodeService.GenerateArchive:input_type -> gencode.v1.GenerateRequest
+	22, // 32: gencode.v1.GenCodeService.ListProjects:input_type -> gencode.v1.ListProjectsRequest
+	23, // 33: gencode.v1.GenCodeService.CreateProject:input_type -> gencode.v1.CreateProjectRequest
+	24, // 34: gencode.v1.GenCodeService.UpdateProject:input_type -> gencode.v1.UpdateProjectRequest
+	25, // 35: gencode.v1.GenCodeService.DeleteProject:input_type -> gencode.v1.DeleteProjectRequest
+	21, // 36: gencode.v1.GenCodeService.GetProject:input_type -> gencode.v1.GetProjectRequest
+	26, // 37: gencode.v1.GenCodeService.ImportTables:input_type -> gencode.v1.ImportTablesRequest
+	27, // 38: gencode.v1.GenCodeService.ListTables:input_type -> gencode.v1.ListTablesRequest
+	28, // 39: gencode.v1.GenCodeService.UpdateTable:input_type -> gencode.v1.UpdateTableRequest
+	29, // 40: gencode.v1.GenCodeService.DeleteTable:input_type -> gencode.v1.DeleteTableRequest
+	19, // 41: gencode.v1.GenCodeService.GenerateFiles:output_type -> gencode.v1.GeneratedFileSet
+	20, // 42: gencode.v1.GenCodeService.GenerateArchive:output_type -> gencode.v1.GeneratedArchive
+	14, // 43: gencode.v1.GenCodeService.ListProjects:output_type -> gencode.v1.ProjectSet
+	13, // 44: gencode.v1.GenCodeService.CreateProject:output_type -> gencode.v1.Project
+	13, // 45: gencode.v1.GenCodeService.UpdateProject:output_type -> gencode.v1.Project
+	34, // 46: gencode.v1.GenCodeService.DeleteProject:output_type -> google.protobuf.Empty
+	13, // 47: gencode.v1.GenCodeService.GetProject:output_type -> gencode.v1.Project
+	16, // 48: gencode.v1.GenCodeService.ImportTables:output_type -> gencode.v1.ProjectTableSet
+	16, // 49: gencode.v1.GenCodeService.ListTables:output_type -> gencode.v1.ProjectTableSet
+	15, // 50: gencode.v1.GenCodeService.UpdateTable:output_type -> gencode.v1.ProjectTable
+	34, // 51: gencode.v1.GenCodeService.DeleteTable:output_type -> google.protobuf.Empty
+	41, // [41:52] is the sub-list for method output_type
+	30, // [30:41] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_gencode_v1_gencode_proto_init() }
+func file_gencode_v1_gencode_proto_init() {
+	if File_gencode_v1_gencode_proto != nil {
+		return
+	}
+	file_gencode_v1_gencode_proto_msgTypes[12].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gencode_v1_gencode_proto_rawDesc), len(file_gencode_v1_gencode_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   31,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gencode_v1_gencode_proto_goTypes,
+		DependencyIndexes: file_gencode_v1_gencode_proto_depIdxs,
+		MessageInfos:      file_gencode_v1_gencode_proto_msgTypes,
+	}.Build()
+	File_gencode_v1_gencode_proto = out.File
+	file_gencode_v1_gencode_proto_goTypes = nil
+	file_gencode_v1_gencode_proto_depIdxs = nil
+}
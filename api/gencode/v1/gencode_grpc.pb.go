@@ -0,0 +1,530 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v3.21.12
+// source: gencode/v1/gencode.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GenCodeService_GenerateFiles_FullMethodName   = "/gencode.v1.GenCodeService/GenerateFiles"
+	GenCodeService_GenerateArchive_FullMethodName = "/gencode.v1.GenCodeService/GenerateArchive"
+	GenCodeService_ListProjects_FullMethodName    = "/gencode.v1.GenCodeService/ListProjects"
+	GenCodeService_CreateProject_FullMethodName   = "/gencode.v1.GenCodeService/CreateProject"
+	GenCodeService_UpdateProject_FullMethodName   = "/gencode.v1.GenCodeService/UpdateProject"
+	GenCodeService_DeleteProject_FullMethodName   = "/gencode.v1.GenCodeService/DeleteProject"
+	GenCodeService_GetProject_FullMethodName      = "/gencode.v1.GenCodeService/GetProject"
+	GenCodeService_ImportTables_FullMethodName    = "/gencode.v1.GenCodeService/ImportTables"
+	GenCodeService_ListTables_FullMethodName      = "/gencode.v1.GenCodeService/ListTables"
+	GenCodeService_UpdateTable_FullMethodName     = "/gencode.v1.GenCodeService/UpdateTable"
+	GenCodeService_DeleteTable_FullMethodName     = "/gencode.v1.GenCodeService/DeleteTable"
+)
+
+// GenCodeServiceClient is the client API for GenCodeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// GenCodeService is the code generation service definition.
+type GenCodeServiceClient interface {
+	// GenerateFiles generates code and returns the generated files.
+	GenerateFiles(ctx context.Context, in *GenerateRequest, opts ...grpc.CallOption) (*GeneratedFileSet, error)
+	// GenerateArchive generates code and returns a zip archive.
+	GenerateArchive(ctx context.Context, in *GenerateRequest, opts ...grpc.CallOption) (*GeneratedArchive, error)
+	// ListProjects returns a list of projects.
+	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ProjectSet, error)
+	// CreateProject creates a new project.
+	CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*Project, error)
+	// UpdateProject updates an existing project.
+	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*Project, error)
+	// DeleteProject deletes a project and its tables by ID.
+	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetProject retrieves a project by ID.
+	GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*Project, error)
+	// ImportTables imports tables into a project, replacing the tables with the
+	// same name.
+	ImportTables(ctx context.Context, in *ImportTablesRequest, opts ...grpc.CallOption) (*ProjectTableSet, error)
+	// ListTables returns the tables of a project.
+	ListTables(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (*ProjectTableSet, error)
+	// UpdateTable updates a project table and its column settings.
+	UpdateTable(ctx context.Context, in *UpdateTableRequest, opts ...grpc.CallOption) (*ProjectTable, error)
+	// DeleteTable deletes a project table by ID.
+	DeleteTable(ctx context.Context, in *DeleteTableRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type genCodeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGenCodeServiceClient(cc grpc.ClientConnInterface) GenCodeServiceClient {
+	return &genCodeServiceClient{cc}
+}
+
+func (c *genCodeServiceClient) GenerateFiles(ctx context.Context, in *GenerateRequest, opts ...grpc.CallOption) (*GeneratedFileSet, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneratedFileSet)
+	err := c.cc.Invoke(ctx, GenCodeService_GenerateFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genCodeServiceClient) GenerateArchive(ctx context.Context, in *GenerateRequest, opts ...grpc.CallOption) (*GeneratedArchive, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneratedArchive)
+	err := c.cc.Invoke(ctx, GenCodeService_GenerateArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genCodeServiceClient) ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ProjectSet, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProjectSet)
+	err := c.cc.Invoke(ctx, GenCodeService_ListProjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genCodeServiceClient) CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*Project, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Project)
+	err := c.cc.Invoke(ctx, GenCodeService_CreateProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genCodeServiceClient) UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*Project, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Project)
+	err := c.cc.Invoke(ctx, GenCodeService_UpdateProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genCodeServiceClient) DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GenCodeService_DeleteProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genCodeServiceClient) GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*Project, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Project)
+	err := c.cc.Invoke(ctx, GenCodeService_GetProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genCodeServiceClient) ImportTables(ctx context.Context, in *ImportTablesRequest, opts ...grpc.CallOption) (*ProjectTableSet, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProjectTableSet)
+	err := c.cc.Invoke(ctx, GenCodeService_ImportTables_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genCodeServiceClient) ListTables(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (*ProjectTableSet, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProjectTableSet)
+	err := c.cc.Invoke(ctx, GenCodeService_ListTables_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genCodeServiceClient) UpdateTable(ctx context.Context, in *UpdateTableRequest, opts ...grpc.CallOption) (*ProjectTable, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProjectTable)
+	err := c.cc.Invoke(ctx, GenCodeService_UpdateTable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genCodeServiceClient) DeleteTable(ctx context.Context, in *DeleteTableRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GenCodeService_DeleteTable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GenCodeServiceServer is the server API for GenCodeService service.
+// All implementations must embed UnimplementedGenCodeServiceServer
+// for forward compatibility.
+//
+// GenCodeService is the code generation service definition.
+type GenCodeServiceServer interface {
+	// GenerateFiles generates code and returns the generated files.
+	GenerateFiles(context.Context, *GenerateRequest) (*GeneratedFileSet, error)
+	// GenerateArchive generates code and returns a zip archive.
+	GenerateArchive(context.Context, *GenerateRequest) (*GeneratedArchive, error)
+	// ListProjects returns a list of projects.
+	ListProjects(context.Context, *ListProjectsRequest) (*ProjectSet, error)
+	// CreateProject creates a new project.
+	CreateProject(context.Context, *CreateProjectRequest) (*Project, error)
+	// UpdateProject updates an existing project.
+	UpdateProject(context.Context, *UpdateProjectRequest) (*Project, error)
+	// DeleteProject deletes a project and its tables by ID.
+	DeleteProject(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error)
+	// GetProject retrieves a project by ID.
+	GetProject(context.Context, *GetProjectRequest) (*Project, error)
+	// ImportTables imports tables into a project, replacing the tables with the
+	// same name.
+	ImportTables(context.Context, *ImportTablesRequest) (*ProjectTableSet, error)
+	// ListTables returns the tables of a project.
+	ListTables(context.Context, *ListTablesRequest) (*ProjectTableSet, error)
+	// UpdateTable updates a project table and its column settings.
+	UpdateTable(context.Context, *UpdateTableRequest) (*ProjectTable, error)
+	// DeleteTable deletes a project table by ID.
+	DeleteTable(context.Context, *DeleteTableRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedGenCodeServiceServer()
+}
+
+// UnimplementedGenCodeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGenCodeServiceServer struct{}
+
+func (UnimplementedGenCodeServiceServer) GenerateFiles(context.Context, *GenerateRequest) (*GeneratedFileSet, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateFiles not implemented")
+}
+func (UnimplementedGenCodeServiceServer) GenerateArchive(context.Context, *GenerateRequest) (*GeneratedArchive, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateArchive not implemented")
+}
+func (UnimplementedGenCodeServiceServer) ListProjects(context.Context, *ListProjectsRequest) (*ProjectSet, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProjects not implemented")
+}
+func (UnimplementedGenCodeServiceServer) CreateProject(context.Context, *CreateProjectRequest) (*Project, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateProject not implemented")
+}
+func (UnimplementedGenCodeServiceServer) UpdateProject(context.Context, *UpdateProjectRequest) (*Project, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateProject not implemented")
+}
+func (UnimplementedGenCodeServiceServer) DeleteProject(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteProject not implemented")
+}
+func (UnimplementedGenCodeServiceServer) GetProject(context.Context, *GetProjectRequest) (*Project, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProject not implemented")
+}
+func (UnimplementedGenCodeServiceServer) ImportTables(context.Context, *ImportTablesRequest) (*ProjectTableSet, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportTables not implemented")
+}
+func (UnimplementedGenCodeServiceServer) ListTables(context.Context, *ListTablesRequest) (*ProjectTableSet, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTables not implemented")
+}
+func (UnimplementedGenCodeServiceServer) UpdateTable(context.Context, *UpdateTableRequest) (*ProjectTable, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTable not implemented")
+}
+func (UnimplementedGenCodeServiceServer) DeleteTable(context.Context, *DeleteTableRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTable not implemented")
+}
+func (UnimplementedGenCodeServiceServer) mustEmbedUnimplementedGenCodeServiceServer() {}
+func (UnimplementedGenCodeServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeGenCodeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GenCodeServiceServer will
+// result in compilation errors.
+type UnsafeGenCodeServiceServer interface {
+	mustEmbedUnimplementedGenCodeServiceServer()
+}
+
+func RegisterGenCodeServiceServer(s grpc.ServiceRegistrar, srv GenCodeServiceServer) {
+	// If the following call panics, it indicates UnimplementedGenCodeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GenCodeService_ServiceDesc, srv)
+}
+
+func _GenCodeService_GenerateFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenCodeServiceServer).GenerateFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenCodeService_GenerateFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenCodeServiceServer).GenerateFiles(ctx, req.(*GenerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenCodeService_GenerateArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenCodeServiceServer).GenerateArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenCodeService_GenerateArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenCodeServiceServer).GenerateArchive(ctx, req.(*GenerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenCodeService_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenCodeServiceServer).ListProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenCodeService_ListProjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenCodeServiceServer).ListProjects(ctx, req.(*ListProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenCodeService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenCodeServiceServer).CreateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenCodeService_CreateProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenCodeServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenCodeService_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenCodeServiceServer).UpdateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenCodeService_UpdateProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenCodeServiceServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenCodeService_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenCodeServiceServer).DeleteProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenCodeService_DeleteProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenCodeServiceServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenCodeService_GetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenCodeServiceServer).GetProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenCodeService_GetProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenCodeServiceServer).GetProject(ctx, req.(*GetProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenCodeService_ImportTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenCodeServiceServer).ImportTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenCodeService_ImportTables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenCodeServiceServer).ImportTables(ctx, req.(*ImportTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenCodeService_ListTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenCodeServiceServer).ListTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenCodeService_ListTables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenCodeServiceServer).ListTables(ctx, req.(*ListTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenCodeService_UpdateTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenCodeServiceServer).UpdateTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenCodeService_UpdateTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenCodeServiceServer).UpdateTable(ctx, req.(*UpdateTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenCodeService_DeleteTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenCodeServiceServer).DeleteTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenCodeService_DeleteTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenCodeServiceServer).DeleteTable(ctx, req.(*DeleteTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GenCodeService_ServiceDesc is the grpc.ServiceDesc for GenCodeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GenCodeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gencode.v1.GenCodeService",
+	HandlerType: (*GenCodeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenerateFiles",
+			Handler:    _GenCodeService_GenerateFiles_Handler,
+		},
+		{
+			MethodName: "GenerateArchive",
+			Handler:    _GenCodeService_GenerateArchive_Handler,
+		},
+		{
+			MethodName: "ListProjects",
+			Handler:    _GenCodeService_ListProjects_Handler,
+		},
+		{
+			MethodName: "CreateProject",
+			Handler:    _GenCodeService_CreateProject_Handler,
+		},
+		{
+			MethodName: "UpdateProject",
+			Handler:    _GenCodeService_UpdateProject_Handler,
+		},
+		{
+			MethodName: "DeleteProject",
+			Handler:    _GenCodeService_DeleteProject_Handler,
+		},
+		{
+			MethodName: "GetProject",
+			Handler:    _GenCodeService_GetProject_Handler,
+		},
+		{
+			MethodName: "ImportTables",
+			Handler:    _GenCodeService_ImportTables_Handler,
+		},
+		{
+			MethodName: "ListTables",
+			Handler:    _GenCodeService_ListTables_Handler,
+		},
+		{
+			MethodName: "UpdateTable",
+			Handler:    _GenCodeService_UpdateTable_Handler,
+		},
+		{
+			MethodName: "DeleteTable",
+			Handler:    _GenCodeService_DeleteTable_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gencode/v1/gencode.proto",
+}
@@ -5,4 +5,34 @@ import "github.com/go-kratos/kratos/v2/errors"
 var (
 	// ErrAdminNotFound error admin not found.
 	ErrAdminNotFound = errors.NotFound("ADMIN", "admin not found")
+	// ErrProjectNotFound error project not found.
+	ErrProjectNotFound = errors.NotFound("PROJECT", "project not found")
+	// ErrTableNotFound error project table not found.
+	ErrTableNotFound = errors.NotFound("PROJECT", "table not found")
+	// ErrTableExists error project table name already exists.
+	ErrTableExists = errors.Conflict("PROJECT", "table already exists")
+	// ErrNoTables error no tables to generate.
+	ErrNoTables = errors.BadRequest("GENCODE", "tables or ddl is required")
+	// ErrInvalidTable error invalid table or column name.
+	ErrInvalidTable = errors.BadRequest("GENCODE", "invalid table or column name")
+	// ErrInvalidPackage error invalid package name.
+	ErrInvalidPackage = errors.BadRequest("GENCODE", "invalid package name")
+	// ErrInvalidModule error invalid go module path.
+	ErrInvalidModule = errors.BadRequest("GENCODE", "invalid project name, it must be a go module path")
+	// ErrInvalidTarget error unsupported generation target.
+	ErrInvalidTarget = errors.BadRequest("GENCODE", "unsupported target")
+	// ErrInvalidDialect error unsupported database dialect.
+	ErrInvalidDialect = errors.BadRequest("GENCODE", "unsupported database dialect, migrations support mysql or postgres and schema.sql also supports sqlite")
+	// ErrInvalidLayout error unsupported java project layout.
+	ErrInvalidLayout = errors.BadRequest("GENCODE", "unsupported layout, it must be maven, maven-multi or gradle")
+	// ErrInvalidProfile error unsupported java version profile.
+	ErrInvalidProfile = errors.BadRequest("GENCODE", "unsupported profile, it must be boot2 or boot3")
+	// ErrInvalidCI error unsupported ci pipeline.
+	ErrInvalidCI = errors.BadRequest("GENCODE", "unsupported ci, it must be jenkins, github, gitlab or drone")
+	// ErrInvalidAuth error unsupported permission framework.
+	ErrInvalidAuth = errors.BadRequest("GENCODE", "unsupported auth, it must be spring-security or sa-token")
+	// ErrInvalidMock error invalid mock data options.
+	ErrInvalidMock = errors.BadRequest("GENCODE", "invalid mock rows, it must be between 0 and 1000")
+	// ErrInvalidDeploy error invalid deployment options.
+	ErrInvalidDeploy = errors.BadRequest("GENCODE", "invalid deploy config, environment names and namespaces must be DNS labels and service type must be ClusterIP, NodePort or LoadBalancer")
 )
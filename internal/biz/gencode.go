@@ -0,0 +1,248 @@
+package biz
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gen_code/pkg/gencode"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+var (
+	identPattern   = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	packagePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+	modulePattern  = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.\-]*(/[A-Za-z0-9_][A-Za-z0-9_.\-]*)*$`)
+	labelPattern   = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+)
+
+const (
+	// envPrefix is the prefix of the environment variables visible to the config.
+	envPrefix = "GENCODE_"
+	// maxMockRows is the maximum number of mock rows per table.
+	maxMockRows = 1000
+)
+
+// GeneratedFile is a generated file.
+type GeneratedFile struct {
+	Path    string
+	Content []byte
+}
+
+// GenCodeUsecase is a code generation usecase.
+type GenCodeUsecase struct{}
+
+// NewGenCodeUsecase new a code generation usecase.
+func NewGenCodeUsecase() *GenCodeUsecase {
+	return &GenCodeUsecase{}
+}
+
+// ParseDDL parses the MySQL create table statements into tables.
+func (uc *GenCodeUsecase) ParseDDL(ctx context.Context, ddl string) ([]gencode.Table, error) {
+	tables, err := gencode.ParseDDL(ddl)
+	if err != nil {
+		return nil, errors.BadRequest("GENCODE", err.Error()).WithCause(err)
+	}
+	return tables, nil
+}
+
+// Generate generates code for the tables and returns the generated files.
+func (uc *GenCodeUsecase) Generate(ctx context.Context, config gencode.Config, tables []gencode.Table) ([]*GeneratedFile, error) {
+	// The ${ENV} references are expanded first, so the expanded values are validated.
+	generator := gencode.NewGenerator(config, nil)
+	generator.LookupEnv = lookupEnv
+	if err := generator.ExpandConfig(); err != nil {
+		return nil, errors.BadRequest("GENCODE", err.Error()).WithCause(err)
+	}
+	if err := validateGenerate(generator.Config, tables); err != nil {
+		return nil, err
+	}
+	for i := range tables {
+		tables[i] = gencode.CompleteTable(tables[i])
+	}
+	generator.Tables = tables
+	dir, err := os.MkdirTemp("", "gencode-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	// The output is always rendered into a private directory.
+	generator.Config.GenConfig.OutputPath = dir
+	generator.Config.GenConfig.CleanStale = false
+	generator.Config.GenConfig.KeepModified = false
+	// The generation stops early once the request is canceled.
+	if err := generator.GenerateCodeContext(ctx); err != nil {
+		if ctx.Err() != nil {
+			return nil, errors.ClientClosed("GENCODE", err.Error()).WithCause(err)
+		}
+		return nil, errors.InternalServer("GENCODE", err.Error()).WithCause(err)
+	}
+
+	var files []*GeneratedFile
+	err = filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, &GeneratedFile{Path: filepath.ToSlash(rel), Content: content})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+	return files, nil
+}
+
+// lookupEnv looks up the environment variables referenced by the config. Only
+// the GENCODE_ prefixed variables are visible, so that callers can't read the
+// server's credentials into the generated code.
+func lookupEnv(key string) (string, bool) {
+	if !strings.HasPrefix(key, envPrefix) {
+		return "", false
+	}
+	return os.LookupEnv(key)
+}
+
+// Archive packs the generated files into a zip archive.
+func (uc *GenCodeUsecase) Archive(ctx context.Context, files []*GeneratedFile) ([]byte, error) {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, file := range files {
+		f, err := w.Create(file.Path)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := f.Write(file.Content); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// validateGenerate validates the names that end up in the generated file paths.
+func validateGenerate(config gencode.Config, tables []gencode.Table) error {
+	if len(tables) == 0 {
+		return ErrNoTables
+	}
+	switch config.GenConfig.Target {
+	case "", gencode.TargetJava:
+		pkg := config.PackageConfig
+		for _, name := range []string{pkg.BasePackage, pkg.EntityPackage, pkg.MapperPackage, pkg.ServicePackage, pkg.ControllerPackage} {
+			if !packagePattern.MatchString(name) {
+				return ErrInvalidPackage
+			}
+		}
+		// Optional packages default to sub-packages of the base package.
+		for _, name := range []string{pkg.QueryPackage, pkg.DtoPackage, pkg.VoPackage, pkg.ConverterPackage} {
+			if name != "" && !packagePattern.MatchString(name) {
+				return ErrInvalidPackage
+			}
+		}
+		switch config.GenConfig.Layout {
+		case "", gencode.LayoutMaven, gencode.LayoutMavenMulti, gencode.LayoutGradle:
+		default:
+			return ErrInvalidLayout
+		}
+		switch config.GenConfig.Profile {
+		case "", gencode.ProfileBoot2, gencode.ProfileBoot3:
+		default:
+			return ErrInvalidProfile
+		}
+		switch config.GenConfig.CI {
+		case "", gencode.CIJenkins, gencode.CIGitHub, gencode.CIGitLab, gencode.CIDrone:
+		default:
+			return ErrInvalidCI
+		}
+		switch config.GenConfig.Auth {
+		case "", gencode.AuthSecurity, gencode.AuthSaToken:
+		default:
+			return ErrInvalidAuth
+		}
+	case gencode.TargetKratos:
+		// The project name is the Go module path of the generated code.
+		if !modulePattern.MatchString(config.ProjectName) {
+			return ErrInvalidModule
+		}
+	case gencode.TargetDoc:
+		// The data dictionary does not depend on packages or modules.
+	default:
+		return ErrInvalidTarget
+	}
+	for _, dialect := range config.GenConfig.Migration {
+		if dialect != gencode.DialectMySQL && dialect != gencode.DialectPostgres {
+			return ErrInvalidDialect
+		}
+	}
+	switch config.GenConfig.Dialect {
+	case "", gencode.DialectMySQL, gencode.DialectPostgres, gencode.DialectSQLite:
+	default:
+		return ErrInvalidDialect
+	}
+	if config.GenConfig.Mock.Rows < 0 || config.GenConfig.Mock.Rows > maxMockRows {
+		return ErrInvalidMock
+	}
+	if err := validateDeploy(config.DeployConfig); err != nil {
+		return err
+	}
+	return validateTables(tables)
+}
+
+// validateDeploy validates the deployment options, environment names end up
+// in the generated file paths and namespaces in the Kubernetes manifests.
+func validateDeploy(deploy gencode.DeployConfig) error {
+	switch deploy.ServiceType {
+	case "", "ClusterIP", "NodePort", "LoadBalancer":
+	default:
+		return ErrInvalidDeploy
+	}
+	if deploy.Namespace != "" && !labelPattern.MatchString(deploy.Namespace) {
+		return ErrInvalidDeploy
+	}
+	for _, env := range deploy.Environments {
+		if !labelPattern.MatchString(env.Name) {
+			return ErrInvalidDeploy
+		}
+		if env.Namespace != "" && !labelPattern.MatchString(env.Namespace) {
+			return ErrInvalidDeploy
+		}
+	}
+	return nil
+}
+
+// validateTables validates the table and column names.
+func validateTables(tables []gencode.Table) error {
+	for _, table := range tables {
+		if !identPattern.MatchString(table.TableName) {
+			return ErrInvalidTable
+		}
+		for _, field := range table.Fields {
+			if !identPattern.MatchString(field.ColumnName) {
+				return ErrInvalidTable
+			}
+			if err := field.Validate(); err != nil {
+				return errors.BadRequest("GENCODE", err.Error()).WithCause(err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"gen_code/pkg/gencode"
+)
+
+func TestGenerateOutsideRepository(t *testing.T) {
+	// the deployed service runs without the template directory of the source tree.
+	t.Chdir(t.TempDir())
+
+	uc := NewGenCodeUsecase()
+	tables, err := uc.ParseDDL(context.Background(), "CREATE TABLE sys_user (id bigint PRIMARY KEY, name varchar(32)) COMMENT='用户'")
+	if err != nil {
+		t.Fatalf("parse ddl: %v", err)
+	}
+	config := gencode.Config{
+		ProjectName: "demo",
+		PackageConfig: gencode.PackageConfig{
+			BasePackage:       "com.example",
+			EntityPackage:     "com.example.entity",
+			MapperPackage:     "com.example.mapper",
+			ServicePackage:    "com.example.service",
+			ControllerPackage: "com.example.controller",
+		},
+	}
+	files, err := uc.Generate(context.Background(), config, tables)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	paths := map[string]bool{}
+	for _, f := range files {
+		paths[f.Path] = true
+	}
+	for _, path := range []string{"pom.xml", "src/main/java/com/example/entity/SysUser.java"} {
+		if !paths[path] {
+			t.Errorf("missing %s in %d generated files", path, len(files))
+		}
+	}
+}
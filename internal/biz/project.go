@@ -0,0 +1,134 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"gen_code/pkg/gencode"
+)
+
+// Project is a generator project model.
+type Project struct {
+	ID          int64
+	Name        string
+	Description string
+	Config      gencode.Config
+	CreateTime  time.Time
+	UpdateTime  time.Time
+}
+
+// ProjectTable is a table imported into a generator project.
+type ProjectTable struct {
+	ID         int64
+	ProjectID  int64
+	Table      gencode.Table
+	CreateTime time.Time
+	UpdateTime time.Time
+}
+
+// ProjectRepo is a generator project repo.
+type ProjectRepo interface {
+	FindByID(context.Context, int64) (*Project, error)
+	ListProjects(context.Context, ...ListOption) ([]*Project, error)
+	CreateProject(context.Context, *Project) (*Project, error)
+	UpdateProject(context.Context, *Project) (*Project, error)
+	DeleteProject(context.Context, int64) error
+	FindTableByID(context.Context, int64) (*ProjectTable, error)
+	ListTables(context.Context, int64) ([]*ProjectTable, error)
+	SaveTables(context.Context, int64, []gencode.Table) ([]*ProjectTable, error)
+	UpdateTable(context.Context, *ProjectTable) (*ProjectTable, error)
+	DeleteTable(context.Context, int64) error
+}
+
+// ProjectUsecase is a generator project usecase.
+type ProjectUsecase struct {
+	project ProjectRepo
+}
+
+// NewProjectUsecase new a generator project usecase.
+func NewProjectUsecase(repo ProjectRepo) *ProjectUsecase {
+	return &ProjectUsecase{project: repo}
+}
+
+// GetProject retrieves a project by ID.
+func (uc *ProjectUsecase) GetProject(ctx context.Context, id int64) (*Project, error) {
+	return uc.project.FindByID(ctx, id)
+}
+
+// ListProjects lists projects with pagination.
+func (uc *ProjectUsecase) ListProjects(ctx context.Context, opts ...ListOption) ([]*Project, error) {
+	return uc.project.ListProjects(ctx, opts...)
+}
+
+// CreateProject creates a new project.
+func (uc *ProjectUsecase) CreateProject(ctx context.Context, project *Project) (*Project, error) {
+	return uc.project.CreateProject(ctx, project)
+}
+
+// UpdateProject updates an existing project.
+func (uc *ProjectUsecase) UpdateProject(ctx context.Context, project *Project) (*Project, error) {
+	return uc.project.UpdateProject(ctx, project)
+}
+
+// DeleteProject deletes a project and its tables by ID.
+func (uc *ProjectUsecase) DeleteProject(ctx context.Context, id int64) error {
+	return uc.project.DeleteProject(ctx, id)
+}
+
+// GetTable retrieves a project table by ID.
+func (uc *ProjectUsecase) GetTable(ctx context.Context, id int64) (*ProjectTable, error) {
+	return uc.project.FindTableByID(ctx, id)
+}
+
+// ListTables lists the tables of a project.
+func (uc *ProjectUsecase) ListTables(ctx context.Context, projectID int64) ([]*ProjectTable, error) {
+	return uc.project.ListTables(ctx, projectID)
+}
+
+// ImportTables imports tables into a project, replacing the tables with the same name.
+func (uc *ProjectUsecase) ImportTables(ctx context.Context, projectID int64, tables []gencode.Table) ([]*ProjectTable, error) {
+	if _, err := uc.project.FindByID(ctx, projectID); err != nil {
+		return nil, err
+	}
+	if len(tables) == 0 {
+		return nil, ErrNoTables
+	}
+	if err := validateTables(tables); err != nil {
+		return nil, err
+	}
+	for i := range tables {
+		tables[i] = gencode.CompleteTable(tables[i])
+	}
+	return uc.project.SaveTables(ctx, projectID, tables)
+}
+
+// UpdateTable updates a project table and its columns.
+func (uc *ProjectUsecase) UpdateTable(ctx context.Context, table *ProjectTable) (*ProjectTable, error) {
+	if err := validateTables([]gencode.Table{table.Table}); err != nil {
+		return nil, err
+	}
+	table.Table = gencode.CompleteTable(table.Table)
+	return uc.project.UpdateTable(ctx, table)
+}
+
+// DeleteTable deletes a project table by ID.
+func (uc *ProjectUsecase) DeleteTable(ctx context.Context, id int64) error {
+	return uc.project.DeleteTable(ctx, id)
+}
+
+// LoadProject loads the project config and tables for code generation.
+func (uc *ProjectUsecase) LoadProject(ctx context.Context, id int64) (gencode.Config, []gencode.Table, error) {
+	project, err := uc.project.FindByID(ctx, id)
+	if err != nil {
+		return gencode.Config{}, nil, err
+	}
+	projectTables, err := uc.project.ListTables(ctx, id)
+	if err != nil {
+		return gencode.Config{}, nil, err
+	}
+	tables := make([]gencode.Table, 0, len(projectTables))
+	for _, t := range projectTables {
+		tables = append(tables, t.Table)
+	}
+	return project.Config, tables, nil
+}
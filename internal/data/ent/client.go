@@ -14,7 +14,11 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"gen_code/internal/data/ent/admin"
+	"gen_code/internal/data/ent/gencolumn"
+	"gen_code/internal/data/ent/gentable"
+	"gen_code/internal/data/ent/project"
 
 	stdsql "database/sql"
 )
@@ -26,6 +30,12 @@ type Client struct {
 	Schema *migrate.Schema
 	// Admin is the client for interacting with the Admin builders.
 	Admin *AdminClient
+	// GenColumn is the client for interacting with the GenColumn builders.
+	GenColumn *GenColumnClient
+	// GenTable is the client for interacting with the GenTable builders.
+	GenTable *GenTableClient
+	// Project is the client for interacting with the Project builders.
+	Project *ProjectClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -38,6 +48,9 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Admin = NewAdminClient(c.config)
+	c.GenColumn = NewGenColumnClient(c.config)
+	c.GenTable = NewGenTableClient(c.config)
+	c.Project = NewProjectClient(c.config)
 }
 
 type (
@@ -128,9 +141,12 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Admin:  NewAdminClient(cfg),
+		ctx:       ctx,
+		config:    cfg,
+		Admin:     NewAdminClient(cfg),
+		GenColumn: NewGenColumnClient(cfg),
+		GenTable:  NewGenTableClient(cfg),
+		Project:   NewProjectClient(cfg),
 	}, nil
 }
 
@@ -148,9 +164,12 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Admin:  NewAdminClient(cfg),
+		ctx:       ctx,
+		config:    cfg,
+		Admin:     NewAdminClient(cfg),
+		GenColumn: NewGenColumnClient(cfg),
+		GenTable:  NewGenTableClient(cfg),
+		Project:   NewProjectClient(cfg),
 	}, nil
 }
 
@@ -180,12 +199,18 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	c.Admin.Use(hooks...)
+	c.GenColumn.Use(hooks...)
+	c.GenTable.Use(hooks...)
+	c.Project.Use(hooks...)
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	c.Admin.Intercept(interceptors...)
+	c.GenColumn.Intercept(interceptors...)
+	c.GenTable.Intercept(interceptors...)
+	c.Project.Intercept(interceptors...)
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -193,6 +218,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AdminMutation:
 		return c.Admin.mutate(ctx, m)
+	case *GenColumnMutation:
+		return c.GenColumn.mutate(ctx, m)
+	case *GenTableMutation:
+		return c.GenTable.mutate(ctx, m)
+	case *ProjectMutation:
+		return c.Project.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -331,13 +362,476 @@ func (c *AdminClient) mutate(ctx context.Context, m *AdminMutation) (Value, erro
 	}
 }
 
+// GenColumnClient is a client for the GenColumn schema.
+type GenColumnClient struct {
+	config
+}
+
+// NewGenColumnClient returns a client for the GenColumn from the given config.
+func NewGenColumnClient(c config) *GenColumnClient {
+	return &GenColumnClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `gencolumn.Hooks(f(g(h())))`.
+func (c *GenColumnClient) Use(hooks ...Hook) {
+	c.hooks.GenColumn = append(c.hooks.GenColumn, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `gencolumn.Intercept(f(g(h())))`.
+func (c *GenColumnClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GenColumn = append(c.inters.GenColumn, interceptors...)
+}
+
+// Create returns a builder for creating a GenColumn entity.
+func (c *GenColumnClient) Create() *GenColumnCreate {
+	mutation := newGenColumnMutation(c.config, OpCreate)
+	return &GenColumnCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GenColumn entities.
+func (c *GenColumnClient) CreateBulk(builders ...*GenColumnCreate) *GenColumnCreateBulk {
+	return &GenColumnCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GenColumnClient) MapCreateBulk(slice any, setFunc func(*GenColumnCreate, int)) *GenColumnCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GenColumnCreateBulk{err: fmt.Errorf("calling to GenColumnClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GenColumnCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GenColumnCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GenColumn.
+func (c *GenColumnClient) Update() *GenColumnUpdate {
+	mutation := newGenColumnMutation(c.config, OpUpdate)
+	return &GenColumnUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GenColumnClient) UpdateOne(_m *GenColumn) *GenColumnUpdateOne {
+	mutation := newGenColumnMutation(c.config, OpUpdateOne, withGenColumn(_m))
+	return &GenColumnUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GenColumnClient) UpdateOneID(id int64) *GenColumnUpdateOne {
+	mutation := newGenColumnMutation(c.config, OpUpdateOne, withGenColumnID(id))
+	return &GenColumnUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GenColumn.
+func (c *GenColumnClient) Delete() *GenColumnDelete {
+	mutation := newGenColumnMutation(c.config, OpDelete)
+	return &GenColumnDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GenColumnClient) DeleteOne(_m *GenColumn) *GenColumnDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GenColumnClient) DeleteOneID(id int64) *GenColumnDeleteOne {
+	builder := c.Delete().Where(gencolumn.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GenColumnDeleteOne{builder}
+}
+
+// Query returns a query builder for GenColumn.
+func (c *GenColumnClient) Query() *GenColumnQuery {
+	return &GenColumnQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGenColumn},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GenColumn entity by its id.
+func (c *GenColumnClient) Get(ctx context.Context, id int64) (*GenColumn, error) {
+	return c.Query().Where(gencolumn.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GenColumnClient) GetX(ctx context.Context, id int64) *GenColumn {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGenTable queries the gen_table edge of a GenColumn.
+func (c *GenColumnClient) QueryGenTable(_m *GenColumn) *GenTableQuery {
+	query := (&GenTableClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gencolumn.Table, gencolumn.FieldID, id),
+			sqlgraph.To(gentable.Table, gentable.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, gencolumn.GenTableTable, gencolumn.GenTableColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GenColumnClient) Hooks() []Hook {
+	return c.hooks.GenColumn
+}
+
+// Interceptors returns the client interceptors.
+func (c *GenColumnClient) Interceptors() []Interceptor {
+	return c.inters.GenColumn
+}
+
+func (c *GenColumnClient) mutate(ctx context.Context, m *GenColumnMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GenColumnCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GenColumnUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GenColumnUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GenColumnDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GenColumn mutation op: %q", m.Op())
+	}
+}
+
+// GenTableClient is a client for the GenTable schema.
+type GenTableClient struct {
+	config
+}
+
+// NewGenTableClient returns a client for the GenTable from the given config.
+func NewGenTableClient(c config) *GenTableClient {
+	return &GenTableClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `gentable.Hooks(f(g(h())))`.
+func (c *GenTableClient) Use(hooks ...Hook) {
+	c.hooks.GenTable = append(c.hooks.GenTable, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `gentable.Intercept(f(g(h())))`.
+func (c *GenTableClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GenTable = append(c.inters.GenTable, interceptors...)
+}
+
+// Create returns a builder for creating a GenTable entity.
+func (c *GenTableClient) Create() *GenTableCreate {
+	mutation := newGenTableMutation(c.config, OpCreate)
+	return &GenTableCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GenTable entities.
+func (c *GenTableClient) CreateBulk(builders ...*GenTableCreate) *GenTableCreateBulk {
+	return &GenTableCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GenTableClient) MapCreateBulk(slice any, setFunc func(*GenTableCreate, int)) *GenTableCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GenTableCreateBulk{err: fmt.Errorf("calling to GenTableClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GenTableCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GenTableCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GenTable.
+func (c *GenTableClient) Update() *GenTableUpdate {
+	mutation := newGenTableMutation(c.config, OpUpdate)
+	return &GenTableUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GenTableClient) UpdateOne(_m *GenTable) *GenTableUpdateOne {
+	mutation := newGenTableMutation(c.config, OpUpdateOne, withGenTable(_m))
+	return &GenTableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GenTableClient) UpdateOneID(id int64) *GenTableUpdateOne {
+	mutation := newGenTableMutation(c.config, OpUpdateOne, withGenTableID(id))
+	return &GenTableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GenTable.
+func (c *GenTableClient) Delete() *GenTableDelete {
+	mutation := newGenTableMutation(c.config, OpDelete)
+	return &GenTableDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GenTableClient) DeleteOne(_m *GenTable) *GenTableDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GenTableClient) DeleteOneID(id int64) *GenTableDeleteOne {
+	builder := c.Delete().Where(gentable.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GenTableDeleteOne{builder}
+}
+
+// Query returns a query builder for GenTable.
+func (c *GenTableClient) Query() *GenTableQuery {
+	return &GenTableQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGenTable},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GenTable entity by its id.
+func (c *GenTableClient) Get(ctx context.Context, id int64) (*GenTable, error) {
+	return c.Query().Where(gentable.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GenTableClient) GetX(ctx context.Context, id int64) *GenTable {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProject queries the project edge of a GenTable.
+func (c *GenTableClient) QueryProject(_m *GenTable) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gentable.Table, gentable.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, gentable.ProjectTable, gentable.ProjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryColumns queries the columns edge of a GenTable.
+func (c *GenTableClient) QueryColumns(_m *GenTable) *GenColumnQuery {
+	query := (&GenColumnClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gentable.Table, gentable.FieldID, id),
+			sqlgraph.To(gencolumn.Table, gencolumn.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, gentable.ColumnsTable, gentable.ColumnsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GenTableClient) Hooks() []Hook {
+	return c.hooks.GenTable
+}
+
+// Interceptors returns the client interceptors.
+func (c *GenTableClient) Interceptors() []Interceptor {
+	return c.inters.GenTable
+}
+
+func (c *GenTableClient) mutate(ctx context.Context, m *GenTableMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GenTableCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GenTableUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GenTableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GenTableDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GenTable mutation op: %q", m.Op())
+	}
+}
+
+// ProjectClient is a client for the Project schema.
+type ProjectClient struct {
+	config
+}
+
+// NewProjectClient returns a client for the Project from the given config.
+func NewProjectClient(c config) *ProjectClient {
+	return &ProjectClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `project.Hooks(f(g(h())))`.
+func (c *ProjectClient) Use(hooks ...Hook) {
+	c.hooks.Project = append(c.hooks.Project, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `project.Intercept(f(g(h())))`.
+func (c *ProjectClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Project = append(c.inters.Project, interceptors...)
+}
+
+// Create returns a builder for creating a Project entity.
+func (c *ProjectClient) Create() *ProjectCreate {
+	mutation := newProjectMutation(c.config, OpCreate)
+	return &ProjectCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Project entities.
+func (c *ProjectClient) CreateBulk(builders ...*ProjectCreate) *ProjectCreateBulk {
+	return &ProjectCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProjectClient) MapCreateBulk(slice any, setFunc func(*ProjectCreate, int)) *ProjectCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProjectCreateBulk{err: fmt.Errorf("calling to ProjectClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProjectCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProjectCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Project.
+func (c *ProjectClient) Update() *ProjectUpdate {
+	mutation := newProjectMutation(c.config, OpUpdate)
+	return &ProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProjectClient) UpdateOne(_m *Project) *ProjectUpdateOne {
+	mutation := newProjectMutation(c.config, OpUpdateOne, withProject(_m))
+	return &ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProjectClient) UpdateOneID(id int64) *ProjectUpdateOne {
+	mutation := newProjectMutation(c.config, OpUpdateOne, withProjectID(id))
+	return &ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Project.
+func (c *ProjectClient) Delete() *ProjectDelete {
+	mutation := newProjectMutation(c.config, OpDelete)
+	return &ProjectDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProjectClient) DeleteOne(_m *Project) *ProjectDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProjectClient) DeleteOneID(id int64) *ProjectDeleteOne {
+	builder := c.Delete().Where(project.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProjectDeleteOne{builder}
+}
+
+// Query returns a query builder for Project.
+func (c *ProjectClient) Query() *ProjectQuery {
+	return &ProjectQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProject},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Project entity by its id.
+func (c *ProjectClient) Get(ctx context.Context, id int64) (*Project, error) {
+	return c.Query().Where(project.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProjectClient) GetX(ctx context.Context, id int64) *Project {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTables queries the tables edge of a Project.
+func (c *ProjectClient) QueryTables(_m *Project) *GenTableQuery {
+	query := (&GenTableClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(gentable.Table, gentable.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.TablesTable, project.TablesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProjectClient) Hooks() []Hook {
+	return c.hooks.Project
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProjectClient) Interceptors() []Interceptor {
+	return c.inters.Project
+}
+
+func (c *ProjectClient) mutate(ctx context.Context, m *ProjectMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProjectCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProjectDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Project mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Admin []ent.Hook
+		Admin, GenColumn, GenTable, Project []ent.Hook
 	}
 	inters struct {
-		Admin []ent.Interceptor
+		Admin, GenColumn, GenTable, Project []ent.Interceptor
 	}
 )
 
@@ -13,6 +13,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"gen_code/internal/data/ent/admin"
+	"gen_code/internal/data/ent/gencolumn"
+	"gen_code/internal/data/ent/gentable"
+	"gen_code/internal/data/ent/project"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +76,10 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			admin.Table: admin.ValidColumn,
+			admin.Table:     admin.ValidColumn,
+			gencolumn.Table: gencolumn.ValidColumn,
+			gentable.Table:  gentable.ValidColumn,
+			project.Table:   project.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
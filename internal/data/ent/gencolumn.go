@@ -0,0 +1,406 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gen_code/internal/data/ent/gencolumn"
+	"gen_code/internal/data/ent/gentable"
+)
+
+// GenColumn is the model entity for the GenColumn schema.
+type GenColumn struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// TableID holds the value of the "table_id" field.
+	TableID int64 `json:"table_id,omitempty"`
+	// ColumnName holds the value of the "column_name" field.
+	ColumnName string `json:"column_name,omitempty"`
+	// ColumnType holds the value of the "column_type" field.
+	ColumnType string `json:"column_type,omitempty"`
+	// ColumnComment holds the value of the "column_comment" field.
+	ColumnComment string `json:"column_comment,omitempty"`
+	// IsNullable holds the value of the "is_nullable" field.
+	IsNullable bool `json:"is_nullable,omitempty"`
+	// IsPrimaryKey holds the value of the "is_primary_key" field.
+	IsPrimaryKey bool `json:"is_primary_key,omitempty"`
+	// GoType holds the value of the "go_type" field.
+	GoType string `json:"go_type,omitempty"`
+	// JavaType holds the value of the "java_type" field.
+	JavaType string `json:"java_type,omitempty"`
+	// FieldName holds the value of the "field_name" field.
+	FieldName string `json:"field_name,omitempty"`
+	// HideInList holds the value of the "hide_in_list" field.
+	HideInList bool `json:"hide_in_list,omitempty"`
+	// ReadOnly holds the value of the "read_only" field.
+	ReadOnly bool `json:"read_only,omitempty"`
+	// QueryType holds the value of the "query_type" field.
+	QueryType string `json:"query_type,omitempty"`
+	// HTMLType holds the value of the "html_type" field.
+	HTMLType string `json:"html_type,omitempty"`
+	// DictType holds the value of the "dict_type" field.
+	DictType string `json:"dict_type,omitempty"`
+	// Required holds the value of the "required" field.
+	Required *bool `json:"required,omitempty"`
+	// Fill holds the value of the "fill" field.
+	Fill string `json:"fill,omitempty"`
+	// LogicDelete holds the value of the "logic_delete" field.
+	LogicDelete bool `json:"logic_delete,omitempty"`
+	// Version holds the value of the "version" field.
+	Version bool `json:"version,omitempty"`
+	// Length holds the value of the "length" field.
+	Length int `json:"length,omitempty"`
+	// Sensitive holds the value of the "sensitive" field.
+	Sensitive bool `json:"sensitive,omitempty"`
+	// Scale holds the value of the "scale" field.
+	Scale int `json:"scale,omitempty"`
+	// DefaultValue holds the value of the "default_value" field.
+	DefaultValue string `json:"default_value,omitempty"`
+	// EnumValues holds the value of the "enum_values" field.
+	EnumValues []string `json:"enum_values,omitempty"`
+	// AutoIncrement holds the value of the "auto_increment" field.
+	AutoIncrement bool `json:"auto_increment,omitempty"`
+	// Sort holds the value of the "sort" field.
+	Sort int `json:"sort,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GenColumnQuery when eager-loading is set.
+	Edges        GenColumnEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GenColumnEdges holds the relations/edges for other nodes in the graph.
+type GenColumnEdges struct {
+	// GenTable holds the value of the gen_table edge.
+	GenTable *GenTable `json:"gen_table,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// GenTableOrErr returns the GenTable value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GenColumnEdges) GenTableOrErr() (*GenTable, error) {
+	if e.GenTable != nil {
+		return e.GenTable, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: gentable.Label}
+	}
+	return nil, &NotLoadedError{edge: "gen_table"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GenColumn) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case gencolumn.FieldEnumValues:
+			values[i] = new([]byte)
+		case gencolumn.FieldIsNullable, gencolumn.FieldIsPrimaryKey, gencolumn.FieldHideInList, gencolumn.FieldReadOnly, gencolumn.FieldRequired, gencolumn.FieldLogicDelete, gencolumn.FieldVersion, gencolumn.FieldSensitive, gencolumn.FieldAutoIncrement:
+			values[i] = new(sql.NullBool)
+		case gencolumn.FieldID, gencolumn.FieldTableID, gencolumn.FieldLength, gencolumn.FieldScale, gencolumn.FieldSort:
+			values[i] = new(sql.NullInt64)
+		case gencolumn.FieldColumnName, gencolumn.FieldColumnType, gencolumn.FieldColumnComment, gencolumn.FieldGoType, gencolumn.FieldJavaType, gencolumn.FieldFieldName, gencolumn.FieldQueryType, gencolumn.FieldHTMLType, gencolumn.FieldDictType, gencolumn.FieldFill, gencolumn.FieldDefaultValue:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GenColumn fields.
+func (_m *GenColumn) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case gencolumn.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case gencolumn.FieldTableID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field table_id", values[i])
+			} else if value.Valid {
+				_m.TableID = value.Int64
+			}
+		case gencolumn.FieldColumnName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column_name", values[i])
+			} else if value.Valid {
+				_m.ColumnName = value.String
+			}
+		case gencolumn.FieldColumnType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column_type", values[i])
+			} else if value.Valid {
+				_m.ColumnType = value.String
+			}
+		case gencolumn.FieldColumnComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column_comment", values[i])
+			} else if value.Valid {
+				_m.ColumnComment = value.String
+			}
+		case gencolumn.FieldIsNullable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_nullable", values[i])
+			} else if value.Valid {
+				_m.IsNullable = value.Bool
+			}
+		case gencolumn.FieldIsPrimaryKey:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_primary_key", values[i])
+			} else if value.Valid {
+				_m.IsPrimaryKey = value.Bool
+			}
+		case gencolumn.FieldGoType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field go_type", values[i])
+			} else if value.Valid {
+				_m.GoType = value.String
+			}
+		case gencolumn.FieldJavaType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field java_type", values[i])
+			} else if value.Valid {
+				_m.JavaType = value.String
+			}
+		case gencolumn.FieldFieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field field_name", values[i])
+			} else if value.Valid {
+				_m.FieldName = value.String
+			}
+		case gencolumn.FieldHideInList:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field hide_in_list", values[i])
+			} else if value.Valid {
+				_m.HideInList = value.Bool
+			}
+		case gencolumn.FieldReadOnly:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field read_only", values[i])
+			} else if value.Valid {
+				_m.ReadOnly = value.Bool
+			}
+		case gencolumn.FieldQueryType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field query_type", values[i])
+			} else if value.Valid {
+				_m.QueryType = value.String
+			}
+		case gencolumn.FieldHTMLType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field html_type", values[i])
+			} else if value.Valid {
+				_m.HTMLType = value.String
+			}
+		case gencolumn.FieldDictType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dict_type", values[i])
+			} else if value.Valid {
+				_m.DictType = value.String
+			}
+		case gencolumn.FieldRequired:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field required", values[i])
+			} else if value.Valid {
+				_m.Required = new(bool)
+				*_m.Required = value.Bool
+			}
+		case gencolumn.FieldFill:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fill", values[i])
+			} else if value.Valid {
+				_m.Fill = value.String
+			}
+		case gencolumn.FieldLogicDelete:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field logic_delete", values[i])
+			} else if value.Valid {
+				_m.LogicDelete = value.Bool
+			}
+		case gencolumn.FieldVersion:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = value.Bool
+			}
+		case gencolumn.FieldLength:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field length", values[i])
+			} else if value.Valid {
+				_m.Length = int(value.Int64)
+			}
+		case gencolumn.FieldSensitive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sensitive", values[i])
+			} else if value.Valid {
+				_m.Sensitive = value.Bool
+			}
+		case gencolumn.FieldScale:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field scale", values[i])
+			} else if value.Valid {
+				_m.Scale = int(value.Int64)
+			}
+		case gencolumn.FieldDefaultValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field default_value", values[i])
+			} else if value.Valid {
+				_m.DefaultValue = value.String
+			}
+		case gencolumn.FieldEnumValues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field enum_values", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EnumValues); err != nil {
+					return fmt.Errorf("unmarshal field enum_values: %w", err)
+				}
+			}
+		case gencolumn.FieldAutoIncrement:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_increment", values[i])
+			} else if value.Valid {
+				_m.AutoIncrement = value.Bool
+			}
+		case gencolumn.FieldSort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort", values[i])
+			} else if value.Valid {
+				_m.Sort = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GenColumn.
+// This includes values selected through modifiers, order, etc.
+func (_m *GenColumn) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryGenTable queries the "gen_table" edge of the GenColumn entity.
+func (_m *GenColumn) QueryGenTable() *GenTableQuery {
+	return NewGenColumnClient(_m.config).QueryGenTable(_m)
+}
+
+// Update returns a builder for updating this GenColumn.
+// Note that you need to call GenColumn.Unwrap() before calling this method if this GenColumn
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GenColumn) Update() *GenColumnUpdateOne {
+	return NewGenColumnClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GenColumn entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GenColumn) Unwrap() *GenColumn {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GenColumn is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GenColumn) String() string {
+	var builder strings.Builder
+	builder.WriteString("GenColumn(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("table_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TableID))
+	builder.WriteString(", ")
+	builder.WriteString("column_name=")
+	builder.WriteString(_m.ColumnName)
+	builder.WriteString(", ")
+	builder.WriteString("column_type=")
+	builder.WriteString(_m.ColumnType)
+	builder.WriteString(", ")
+	builder.WriteString("column_comment=")
+	builder.WriteString(_m.ColumnComment)
+	builder.WriteString(", ")
+	builder.WriteString("is_nullable=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsNullable))
+	builder.WriteString(", ")
+	builder.WriteString("is_primary_key=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsPrimaryKey))
+	builder.WriteString(", ")
+	builder.WriteString("go_type=")
+	builder.WriteString(_m.GoType)
+	builder.WriteString(", ")
+	builder.WriteString("java_type=")
+	builder.WriteString(_m.JavaType)
+	builder.WriteString(", ")
+	builder.WriteString("field_name=")
+	builder.WriteString(_m.FieldName)
+	builder.WriteString(", ")
+	builder.WriteString("hide_in_list=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HideInList))
+	builder.WriteString(", ")
+	builder.WriteString("read_only=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReadOnly))
+	builder.WriteString(", ")
+	builder.WriteString("query_type=")
+	builder.WriteString(_m.QueryType)
+	builder.WriteString(", ")
+	builder.WriteString("html_type=")
+	builder.WriteString(_m.HTMLType)
+	builder.WriteString(", ")
+	builder.WriteString("dict_type=")
+	builder.WriteString(_m.DictType)
+	builder.WriteString(", ")
+	if v := _m.Required; v != nil {
+		builder.WriteString("required=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("fill=")
+	builder.WriteString(_m.Fill)
+	builder.WriteString(", ")
+	builder.WriteString("logic_delete=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LogicDelete))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("length=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Length))
+	builder.WriteString(", ")
+	builder.WriteString("sensitive=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sensitive))
+	builder.WriteString(", ")
+	builder.WriteString("scale=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Scale))
+	builder.WriteString(", ")
+	builder.WriteString("default_value=")
+	builder.WriteString(_m.DefaultValue)
+	builder.WriteString(", ")
+	builder.WriteString("enum_values=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnumValues))
+	builder.WriteString(", ")
+	builder.WriteString("auto_increment=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoIncrement))
+	builder.WriteString(", ")
+	builder.WriteString("sort=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sort))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GenColumns is a parsable slice of GenColumn.
+type GenColumns []*GenColumn
@@ -0,0 +1,305 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gencolumn
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the gencolumn type in the database.
+	Label = "gen_column"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTableID holds the string denoting the table_id field in the database.
+	FieldTableID = "table_id"
+	// FieldColumnName holds the string denoting the column_name field in the database.
+	FieldColumnName = "column_name"
+	// FieldColumnType holds the string denoting the column_type field in the database.
+	FieldColumnType = "column_type"
+	// FieldColumnComment holds the string denoting the column_comment field in the database.
+	FieldColumnComment = "column_comment"
+	// FieldIsNullable holds the string denoting the is_nullable field in the database.
+	FieldIsNullable = "is_nullable"
+	// FieldIsPrimaryKey holds the string denoting the is_primary_key field in the database.
+	FieldIsPrimaryKey = "is_primary_key"
+	// FieldGoType holds the string denoting the go_type field in the database.
+	FieldGoType = "go_type"
+	// FieldJavaType holds the string denoting the java_type field in the database.
+	FieldJavaType = "java_type"
+	// FieldFieldName holds the string denoting the field_name field in the database.
+	FieldFieldName = "field_name"
+	// FieldHideInList holds the string denoting the hide_in_list field in the database.
+	FieldHideInList = "hide_in_list"
+	// FieldReadOnly holds the string denoting the read_only field in the database.
+	FieldReadOnly = "read_only"
+	// FieldQueryType holds the string denoting the query_type field in the database.
+	FieldQueryType = "query_type"
+	// FieldHTMLType holds the string denoting the html_type field in the database.
+	FieldHTMLType = "html_type"
+	// FieldDictType holds the string denoting the dict_type field in the database.
+	FieldDictType = "dict_type"
+	// FieldRequired holds the string denoting the required field in the database.
+	FieldRequired = "required"
+	// FieldFill holds the string denoting the fill field in the database.
+	FieldFill = "fill"
+	// FieldLogicDelete holds the string denoting the logic_delete field in the database.
+	FieldLogicDelete = "logic_delete"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldLength holds the string denoting the length field in the database.
+	FieldLength = "length"
+	// FieldSensitive holds the string denoting the sensitive field in the database.
+	FieldSensitive = "sensitive"
+	// FieldScale holds the string denoting the scale field in the database.
+	FieldScale = "scale"
+	// FieldDefaultValue holds the string denoting the default_value field in the database.
+	FieldDefaultValue = "default_value"
+	// FieldEnumValues holds the string denoting the enum_values field in the database.
+	FieldEnumValues = "enum_values"
+	// FieldAutoIncrement holds the string denoting the auto_increment field in the database.
+	FieldAutoIncrement = "auto_increment"
+	// FieldSort holds the string denoting the sort field in the database.
+	FieldSort = "sort"
+	// EdgeGenTable holds the string denoting the gen_table edge name in mutations.
+	EdgeGenTable = "gen_table"
+	// Table holds the table name of the gencolumn in the database.
+	Table = "gen_columns"
+	// GenTableTable is the table that holds the gen_table relation/edge.
+	GenTableTable = "gen_columns"
+	// GenTableInverseTable is the table name for the GenTable entity.
+	// It exists in this package in order to avoid circular dependency with the "gentable" package.
+	GenTableInverseTable = "gen_tables"
+	// GenTableColumn is the table column denoting the gen_table relation/edge.
+	GenTableColumn = "table_id"
+)
+
+// Columns holds all SQL columns for gencolumn fields.
+var Columns = []string{
+	FieldID,
+	FieldTableID,
+	FieldColumnName,
+	FieldColumnType,
+	FieldColumnComment,
+	FieldIsNullable,
+	FieldIsPrimaryKey,
+	FieldGoType,
+	FieldJavaType,
+	FieldFieldName,
+	FieldHideInList,
+	FieldReadOnly,
+	FieldQueryType,
+	FieldHTMLType,
+	FieldDictType,
+	FieldRequired,
+	FieldFill,
+	FieldLogicDelete,
+	FieldVersion,
+	FieldLength,
+	FieldSensitive,
+	FieldScale,
+	FieldDefaultValue,
+	FieldEnumValues,
+	FieldAutoIncrement,
+	FieldSort,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultColumnName holds the default value on creation for the "column_name" field.
+	DefaultColumnName string
+	// DefaultColumnType holds the default value on creation for the "column_type" field.
+	DefaultColumnType string
+	// DefaultColumnComment holds the default value on creation for the "column_comment" field.
+	DefaultColumnComment string
+	// DefaultIsNullable holds the default value on creation for the "is_nullable" field.
+	DefaultIsNullable bool
+	// DefaultIsPrimaryKey holds the default value on creation for the "is_primary_key" field.
+	DefaultIsPrimaryKey bool
+	// DefaultGoType holds the default value on creation for the "go_type" field.
+	DefaultGoType string
+	// DefaultJavaType holds the default value on creation for the "java_type" field.
+	DefaultJavaType string
+	// DefaultFieldName holds the default value on creation for the "field_name" field.
+	DefaultFieldName string
+	// DefaultHideInList holds the default value on creation for the "hide_in_list" field.
+	DefaultHideInList bool
+	// DefaultReadOnly holds the default value on creation for the "read_only" field.
+	DefaultReadOnly bool
+	// DefaultQueryType holds the default value on creation for the "query_type" field.
+	DefaultQueryType string
+	// DefaultHTMLType holds the default value on creation for the "html_type" field.
+	DefaultHTMLType string
+	// DefaultDictType holds the default value on creation for the "dict_type" field.
+	DefaultDictType string
+	// DefaultFill holds the default value on creation for the "fill" field.
+	DefaultFill string
+	// DefaultLogicDelete holds the default value on creation for the "logic_delete" field.
+	DefaultLogicDelete bool
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion bool
+	// DefaultLength holds the default value on creation for the "length" field.
+	DefaultLength int
+	// DefaultSensitive holds the default value on creation for the "sensitive" field.
+	DefaultSensitive bool
+	// DefaultScale holds the default value on creation for the "scale" field.
+	DefaultScale int
+	// DefaultDefaultValue holds the default value on creation for the "default_value" field.
+	DefaultDefaultValue string
+	// DefaultAutoIncrement holds the default value on creation for the "auto_increment" field.
+	DefaultAutoIncrement bool
+	// DefaultSort holds the default value on creation for the "sort" field.
+	DefaultSort int
+)
+
+// OrderOption defines the ordering options for the GenColumn queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTableID orders the results by the table_id field.
+func ByTableID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTableID, opts...).ToFunc()
+}
+
+// ByColumnName orders the results by the column_name field.
+func ByColumnName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumnName, opts...).ToFunc()
+}
+
+// ByColumnType orders the results by the column_type field.
+func ByColumnType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumnType, opts...).ToFunc()
+}
+
+// ByColumnComment orders the results by the column_comment field.
+func ByColumnComment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumnComment, opts...).ToFunc()
+}
+
+// ByIsNullable orders the results by the is_nullable field.
+func ByIsNullable(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsNullable, opts...).ToFunc()
+}
+
+// ByIsPrimaryKey orders the results by the is_primary_key field.
+func ByIsPrimaryKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsPrimaryKey, opts...).ToFunc()
+}
+
+// ByGoType orders the results by the go_type field.
+func ByGoType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGoType, opts...).ToFunc()
+}
+
+// ByJavaType orders the results by the java_type field.
+func ByJavaType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJavaType, opts...).ToFunc()
+}
+
+// ByFieldName orders the results by the field_name field.
+func ByFieldName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFieldName, opts...).ToFunc()
+}
+
+// ByHideInList orders the results by the hide_in_list field.
+func ByHideInList(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHideInList, opts...).ToFunc()
+}
+
+// ByReadOnly orders the results by the read_only field.
+func ByReadOnly(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReadOnly, opts...).ToFunc()
+}
+
+// ByQueryType orders the results by the query_type field.
+func ByQueryType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQueryType, opts...).ToFunc()
+}
+
+// ByHTMLType orders the results by the html_type field.
+func ByHTMLType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHTMLType, opts...).ToFunc()
+}
+
+// ByDictType orders the results by the dict_type field.
+func ByDictType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDictType, opts...).ToFunc()
+}
+
+// ByRequired orders the results by the required field.
+func ByRequired(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequired, opts...).ToFunc()
+}
+
+// ByFill orders the results by the fill field.
+func ByFill(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFill, opts...).ToFunc()
+}
+
+// ByLogicDelete orders the results by the logic_delete field.
+func ByLogicDelete(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLogicDelete, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByLength orders the results by the length field.
+func ByLength(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLength, opts...).ToFunc()
+}
+
+// BySensitive orders the results by the sensitive field.
+func BySensitive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSensitive, opts...).ToFunc()
+}
+
+// ByScale orders the results by the scale field.
+func ByScale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScale, opts...).ToFunc()
+}
+
+// ByDefaultValue orders the results by the default_value field.
+func ByDefaultValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultValue, opts...).ToFunc()
+}
+
+// ByAutoIncrement orders the results by the auto_increment field.
+func ByAutoIncrement(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoIncrement, opts...).ToFunc()
+}
+
+// BySort orders the results by the sort field.
+func BySort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSort, opts...).ToFunc()
+}
+
+// ByGenTableField orders the results by gen_table field.
+func ByGenTableField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGenTableStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newGenTableStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GenTableInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, GenTableTable, GenTableColumn),
+	)
+}
@@ -0,0 +1,1178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gencolumn
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+
+	"gen_code/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLTE(FieldID, id))
+}
+
+// TableID applies equality check predicate on the "table_id" field. It's identical to TableIDEQ.
+func TableID(v int64) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldTableID, v))
+}
+
+// ColumnName applies equality check predicate on the "column_name" field. It's identical to ColumnNameEQ.
+func ColumnName(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldColumnName, v))
+}
+
+// ColumnType applies equality check predicate on the "column_type" field. It's identical to ColumnTypeEQ.
+func ColumnType(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldColumnType, v))
+}
+
+// ColumnComment applies equality check predicate on the "column_comment" field. It's identical to ColumnCommentEQ.
+func ColumnComment(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldColumnComment, v))
+}
+
+// IsNullable applies equality check predicate on the "is_nullable" field. It's identical to IsNullableEQ.
+func IsNullable(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldIsNullable, v))
+}
+
+// IsPrimaryKey applies equality check predicate on the "is_primary_key" field. It's identical to IsPrimaryKeyEQ.
+func IsPrimaryKey(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldIsPrimaryKey, v))
+}
+
+// GoType applies equality check predicate on the "go_type" field. It's identical to GoTypeEQ.
+func GoType(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldGoType, v))
+}
+
+// JavaType applies equality check predicate on the "java_type" field. It's identical to JavaTypeEQ.
+func JavaType(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldJavaType, v))
+}
+
+// FieldName applies equality check predicate on the "field_name" field. It's identical to FieldNameEQ.
+func FieldName(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldFieldName, v))
+}
+
+// HideInList applies equality check predicate on the "hide_in_list" field. It's identical to HideInListEQ.
+func HideInList(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldHideInList, v))
+}
+
+// ReadOnly applies equality check predicate on the "read_only" field. It's identical to ReadOnlyEQ.
+func ReadOnly(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldReadOnly, v))
+}
+
+// QueryType applies equality check predicate on the "query_type" field. It's identical to QueryTypeEQ.
+func QueryType(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldQueryType, v))
+}
+
+// HTMLType applies equality check predicate on the "html_type" field. It's identical to HTMLTypeEQ.
+func HTMLType(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldHTMLType, v))
+}
+
+// DictType applies equality check predicate on the "dict_type" field. It's identical to DictTypeEQ.
+func DictType(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldDictType, v))
+}
+
+// Required applies equality check predicate on the "required" field. It's identical to RequiredEQ.
+func Required(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldRequired, v))
+}
+
+// Fill applies equality check predicate on the "fill" field. It's identical to FillEQ.
+func Fill(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldFill, v))
+}
+
+// LogicDelete applies equality check predicate on the "logic_delete" field. It's identical to LogicDeleteEQ.
+func LogicDelete(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldLogicDelete, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldVersion, v))
+}
+
+// Length applies equality check predicate on the "length" field. It's identical to LengthEQ.
+func Length(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldLength, v))
+}
+
+// Sensitive applies equality check predicate on the "sensitive" field. It's identical to SensitiveEQ.
+func Sensitive(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldSensitive, v))
+}
+
+// Scale applies equality check predicate on the "scale" field. It's identical to ScaleEQ.
+func Scale(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldScale, v))
+}
+
+// DefaultValue applies equality check predicate on the "default_value" field. It's identical to DefaultValueEQ.
+func DefaultValue(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldDefaultValue, v))
+}
+
+// AutoIncrement applies equality check predicate on the "auto_increment" field. It's identical to AutoIncrementEQ.
+func AutoIncrement(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldAutoIncrement, v))
+}
+
+// Sort applies equality check predicate on the "sort" field. It's identical to SortEQ.
+func Sort(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldSort, v))
+}
+
+// TableIDEQ applies the EQ predicate on the "table_id" field.
+func TableIDEQ(v int64) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldTableID, v))
+}
+
+// TableIDNEQ applies the NEQ predicate on the "table_id" field.
+func TableIDNEQ(v int64) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldTableID, v))
+}
+
+// TableIDIn applies the In predicate on the "table_id" field.
+func TableIDIn(vs ...int64) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldIn(FieldTableID, vs...))
+}
+
+// TableIDNotIn applies the NotIn predicate on the "table_id" field.
+func TableIDNotIn(vs ...int64) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNotIn(FieldTableID, vs...))
+}
+
+// ColumnNameEQ applies the EQ predicate on the "column_name" field.
+func ColumnNameEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldColumnName, v))
+}
+
+// ColumnNameNEQ applies the NEQ predicate on the "column_name" field.
+func ColumnNameNEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldColumnName, v))
+}
+
+// ColumnNameIn applies the In predicate on the "column_name" field.
+func ColumnNameIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldIn(FieldColumnName, vs...))
+}
+
+// ColumnNameNotIn applies the NotIn predicate on the "column_name" field.
+func ColumnNameNotIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNotIn(FieldColumnName, vs...))
+}
+
+// ColumnNameGT applies the GT predicate on the "column_name" field.
+func ColumnNameGT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGT(FieldColumnName, v))
+}
+
+// ColumnNameGTE applies the GTE predicate on the "column_name" field.
+func ColumnNameGTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGTE(FieldColumnName, v))
+}
+
+// ColumnNameLT applies the LT predicate on the "column_name" field.
+func ColumnNameLT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLT(FieldColumnName, v))
+}
+
+// ColumnNameLTE applies the LTE predicate on the "column_name" field.
+func ColumnNameLTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLTE(FieldColumnName, v))
+}
+
+// ColumnNameContains applies the Contains predicate on the "column_name" field.
+func ColumnNameContains(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContains(FieldColumnName, v))
+}
+
+// ColumnNameHasPrefix applies the HasPrefix predicate on the "column_name" field.
+func ColumnNameHasPrefix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasPrefix(FieldColumnName, v))
+}
+
+// ColumnNameHasSuffix applies the HasSuffix predicate on the "column_name" field.
+func ColumnNameHasSuffix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasSuffix(FieldColumnName, v))
+}
+
+// ColumnNameEqualFold applies the EqualFold predicate on the "column_name" field.
+func ColumnNameEqualFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEqualFold(FieldColumnName, v))
+}
+
+// ColumnNameContainsFold applies the ContainsFold predicate on the "column_name" field.
+func ColumnNameContainsFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContainsFold(FieldColumnName, v))
+}
+
+// ColumnTypeEQ applies the EQ predicate on the "column_type" field.
+func ColumnTypeEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldColumnType, v))
+}
+
+// ColumnTypeNEQ applies the NEQ predicate on the "column_type" field.
+func ColumnTypeNEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldColumnType, v))
+}
+
+// ColumnTypeIn applies the In predicate on the "column_type" field.
+func ColumnTypeIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldIn(FieldColumnType, vs...))
+}
+
+// ColumnTypeNotIn applies the NotIn predicate on the "column_type" field.
+func ColumnTypeNotIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNotIn(FieldColumnType, vs...))
+}
+
+// ColumnTypeGT applies the GT predicate on the "column_type" field.
+func ColumnTypeGT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGT(FieldColumnType, v))
+}
+
+// ColumnTypeGTE applies the GTE predicate on the "column_type" field.
+func ColumnTypeGTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGTE(FieldColumnType, v))
+}
+
+// ColumnTypeLT applies the LT predicate on the "column_type" field.
+func ColumnTypeLT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLT(FieldColumnType, v))
+}
+
+// ColumnTypeLTE applies the LTE predicate on the "column_type" field.
+func ColumnTypeLTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLTE(FieldColumnType, v))
+}
+
+// ColumnTypeContains applies the Contains predicate on the "column_type" field.
+func ColumnTypeContains(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContains(FieldColumnType, v))
+}
+
+// ColumnTypeHasPrefix applies the HasPrefix predicate on the "column_type" field.
+func ColumnTypeHasPrefix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasPrefix(FieldColumnType, v))
+}
+
+// ColumnTypeHasSuffix applies the HasSuffix predicate on the "column_type" field.
+func ColumnTypeHasSuffix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasSuffix(FieldColumnType, v))
+}
+
+// ColumnTypeEqualFold applies the EqualFold predicate on the "column_type" field.
+func ColumnTypeEqualFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEqualFold(FieldColumnType, v))
+}
+
+// ColumnTypeContainsFold applies the ContainsFold predicate on the "column_type" field.
+func ColumnTypeContainsFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContainsFold(FieldColumnType, v))
+}
+
+// ColumnCommentEQ applies the EQ predicate on the "column_comment" field.
+func ColumnCommentEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldColumnComment, v))
+}
+
+// ColumnCommentNEQ applies the NEQ predicate on the "column_comment" field.
+func ColumnCommentNEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldColumnComment, v))
+}
+
+// ColumnCommentIn applies the In predicate on the "column_comment" field.
+func ColumnCommentIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldIn(FieldColumnComment, vs...))
+}
+
+// ColumnCommentNotIn applies the NotIn predicate on the "column_comment" field.
+func ColumnCommentNotIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNotIn(FieldColumnComment, vs...))
+}
+
+// ColumnCommentGT applies the GT predicate on the "column_comment" field.
+func ColumnCommentGT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGT(FieldColumnComment, v))
+}
+
+// ColumnCommentGTE applies the GTE predicate on the "column_comment" field.
+func ColumnCommentGTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGTE(FieldColumnComment, v))
+}
+
+// ColumnCommentLT applies the LT predicate on the "column_comment" field.
+func ColumnCommentLT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLT(FieldColumnComment, v))
+}
+
+// ColumnCommentLTE applies the LTE predicate on the "column_comment" field.
+func ColumnCommentLTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLTE(FieldColumnComment, v))
+}
+
+// ColumnCommentContains applies the Contains predicate on the "column_comment" field.
+func ColumnCommentContains(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContains(FieldColumnComment, v))
+}
+
+// ColumnCommentHasPrefix applies the HasPrefix predicate on the "column_comment" field.
+func ColumnCommentHasPrefix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasPrefix(FieldColumnComment, v))
+}
+
+// ColumnCommentHasSuffix applies the HasSuffix predicate on the "column_comment" field.
+func ColumnCommentHasSuffix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasSuffix(FieldColumnComment, v))
+}
+
+// ColumnCommentEqualFold applies the EqualFold predicate on the "column_comment" field.
+func ColumnCommentEqualFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEqualFold(FieldColumnComment, v))
+}
+
+// ColumnCommentContainsFold applies the ContainsFold predicate on the "column_comment" field.
+func ColumnCommentContainsFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContainsFold(FieldColumnComment, v))
+}
+
+// IsNullableEQ applies the EQ predicate on the "is_nullable" field.
+func IsNullableEQ(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldIsNullable, v))
+}
+
+// IsNullableNEQ applies the NEQ predicate on the "is_nullable" field.
+func IsNullableNEQ(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldIsNullable, v))
+}
+
+// IsPrimaryKeyEQ applies the EQ predicate on the "is_primary_key" field.
+func IsPrimaryKeyEQ(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldIsPrimaryKey, v))
+}
+
+// IsPrimaryKeyNEQ applies the NEQ predicate on the "is_primary_key" field.
+func IsPrimaryKeyNEQ(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldIsPrimaryKey, v))
+}
+
+// GoTypeEQ applies the EQ predicate on the "go_type" field.
+func GoTypeEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldGoType, v))
+}
+
+// GoTypeNEQ applies the NEQ predicate on the "go_type" field.
+func GoTypeNEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldGoType, v))
+}
+
+// GoTypeIn applies the In predicate on the "go_type" field.
+func GoTypeIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldIn(FieldGoType, vs...))
+}
+
+// GoTypeNotIn applies the NotIn predicate on the "go_type" field.
+func GoTypeNotIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNotIn(FieldGoType, vs...))
+}
+
+// GoTypeGT applies the GT predicate on the "go_type" field.
+func GoTypeGT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGT(FieldGoType, v))
+}
+
+// GoTypeGTE applies the GTE predicate on the "go_type" field.
+func GoTypeGTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGTE(FieldGoType, v))
+}
+
+// GoTypeLT applies the LT predicate on the "go_type" field.
+func GoTypeLT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLT(FieldGoType, v))
+}
+
+// GoTypeLTE applies the LTE predicate on the "go_type" field.
+func GoTypeLTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLTE(FieldGoType, v))
+}
+
+// GoTypeContains applies the Contains predicate on the "go_type" field.
+func GoTypeContains(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContains(FieldGoType, v))
+}
+
+// GoTypeHasPrefix applies the HasPrefix predicate on the "go_type" field.
+func GoTypeHasPrefix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasPrefix(FieldGoType, v))
+}
+
+// GoTypeHasSuffix applies the HasSuffix predicate on the "go_type" field.
+func GoTypeHasSuffix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasSuffix(FieldGoType, v))
+}
+
+// GoTypeEqualFold applies the EqualFold predicate on the "go_type" field.
+func GoTypeEqualFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEqualFold(FieldGoType, v))
+}
+
+// GoTypeContainsFold applies the ContainsFold predicate on the "go_type" field.
+func GoTypeContainsFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContainsFold(FieldGoType, v))
+}
+
+// JavaTypeEQ applies the EQ predicate on the "java_type" field.
+func JavaTypeEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldJavaType, v))
+}
+
+// JavaTypeNEQ applies the NEQ predicate on the "java_type" field.
+func JavaTypeNEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldJavaType, v))
+}
+
+// JavaTypeIn applies the In predicate on the "java_type" field.
+func JavaTypeIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldIn(FieldJavaType, vs...))
+}
+
+// JavaTypeNotIn applies the NotIn predicate on the "java_type" field.
+func JavaTypeNotIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNotIn(FieldJavaType, vs...))
+}
+
+// JavaTypeGT applies the GT predicate on the "java_type" field.
+func JavaTypeGT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGT(FieldJavaType, v))
+}
+
+// JavaTypeGTE applies the GTE predicate on the "java_type" field.
+func JavaTypeGTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGTE(FieldJavaType, v))
+}
+
+// JavaTypeLT applies the LT predicate on the "java_type" field.
+func JavaTypeLT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLT(FieldJavaType, v))
+}
+
+// JavaTypeLTE applies the LTE predicate on the "java_type" field.
+func JavaTypeLTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLTE(FieldJavaType, v))
+}
+
+// JavaTypeContains applies the Contains predicate on the "java_type" field.
+func JavaTypeContains(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContains(FieldJavaType, v))
+}
+
+// JavaTypeHasPrefix applies the HasPrefix predicate on the "java_type" field.
+func JavaTypeHasPrefix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasPrefix(FieldJavaType, v))
+}
+
+// JavaTypeHasSuffix applies the HasSuffix predicate on the "java_type" field.
+func JavaTypeHasSuffix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasSuffix(FieldJavaType, v))
+}
+
+// JavaTypeEqualFold applies the EqualFold predicate on the "java_type" field.
+func JavaTypeEqualFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEqualFold(FieldJavaType, v))
+}
+
+// JavaTypeContainsFold applies the ContainsFold predicate on the "java_type" field.
+func JavaTypeContainsFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContainsFold(FieldJavaType, v))
+}
+
+// FieldNameEQ applies the EQ predicate on the "field_name" field.
+func FieldNameEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldFieldName, v))
+}
+
+// FieldNameNEQ applies the NEQ predicate on the "field_name" field.
+func FieldNameNEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldFieldName, v))
+}
+
+// FieldNameIn applies the In predicate on the "field_name" field.
+func FieldNameIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldIn(FieldFieldName, vs...))
+}
+
+// FieldNameNotIn applies the NotIn predicate on the "field_name" field.
+func FieldNameNotIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNotIn(FieldFieldName, vs...))
+}
+
+// FieldNameGT applies the GT predicate on the "field_name" field.
+func FieldNameGT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGT(FieldFieldName, v))
+}
+
+// FieldNameGTE applies the GTE predicate on the "field_name" field.
+func FieldNameGTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGTE(FieldFieldName, v))
+}
+
+// FieldNameLT applies the LT predicate on the "field_name" field.
+func FieldNameLT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLT(FieldFieldName, v))
+}
+
+// FieldNameLTE applies the LTE predicate on the "field_name" field.
+func FieldNameLTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLTE(FieldFieldName, v))
+}
+
+// FieldNameContains applies the Contains predicate on the "field_name" field.
+func FieldNameContains(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContains(FieldFieldName, v))
+}
+
+// FieldNameHasPrefix applies the HasPrefix predicate on the "field_name" field.
+func FieldNameHasPrefix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasPrefix(FieldFieldName, v))
+}
+
+// FieldNameHasSuffix applies the HasSuffix predicate on the "field_name" field.
+func FieldNameHasSuffix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasSuffix(FieldFieldName, v))
+}
+
+// FieldNameEqualFold applies the EqualFold predicate on the "field_name" field.
+func FieldNameEqualFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEqualFold(FieldFieldName, v))
+}
+
+// FieldNameContainsFold applies the ContainsFold predicate on the "field_name" field.
+func FieldNameContainsFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContainsFold(FieldFieldName, v))
+}
+
+// HideInListEQ applies the EQ predicate on the "hide_in_list" field.
+func HideInListEQ(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldHideInList, v))
+}
+
+// HideInListNEQ applies the NEQ predicate on the "hide_in_list" field.
+func HideInListNEQ(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldHideInList, v))
+}
+
+// ReadOnlyEQ applies the EQ predicate on the "read_only" field.
+func ReadOnlyEQ(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldReadOnly, v))
+}
+
+// ReadOnlyNEQ applies the NEQ predicate on the "read_only" field.
+func ReadOnlyNEQ(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldReadOnly, v))
+}
+
+// QueryTypeEQ applies the EQ predicate on the "query_type" field.
+func QueryTypeEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldQueryType, v))
+}
+
+// QueryTypeNEQ applies the NEQ predicate on the "query_type" field.
+func QueryTypeNEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldQueryType, v))
+}
+
+// QueryTypeIn applies the In predicate on the "query_type" field.
+func QueryTypeIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldIn(FieldQueryType, vs...))
+}
+
+// QueryTypeNotIn applies the NotIn predicate on the "query_type" field.
+func QueryTypeNotIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNotIn(FieldQueryType, vs...))
+}
+
+// QueryTypeGT applies the GT predicate on the "query_type" field.
+func QueryTypeGT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGT(FieldQueryType, v))
+}
+
+// QueryTypeGTE applies the GTE predicate on the "query_type" field.
+func QueryTypeGTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGTE(FieldQueryType, v))
+}
+
+// QueryTypeLT applies the LT predicate on the "query_type" field.
+func QueryTypeLT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLT(FieldQueryType, v))
+}
+
+// QueryTypeLTE applies the LTE predicate on the "query_type" field.
+func QueryTypeLTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLTE(FieldQueryType, v))
+}
+
+// QueryTypeContains applies the Contains predicate on the "query_type" field.
+func QueryTypeContains(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContains(FieldQueryType, v))
+}
+
+// QueryTypeHasPrefix applies the HasPrefix predicate on the "query_type" field.
+func QueryTypeHasPrefix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasPrefix(FieldQueryType, v))
+}
+
+// QueryTypeHasSuffix applies the HasSuffix predicate on the "query_type" field.
+func QueryTypeHasSuffix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasSuffix(FieldQueryType, v))
+}
+
+// QueryTypeEqualFold applies the EqualFold predicate on the "query_type" field.
+func QueryTypeEqualFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEqualFold(FieldQueryType, v))
+}
+
+// QueryTypeContainsFold applies the ContainsFold predicate on the "query_type" field.
+func QueryTypeContainsFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContainsFold(FieldQueryType, v))
+}
+
+// HTMLTypeEQ applies the EQ predicate on the "html_type" field.
+func HTMLTypeEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldHTMLType, v))
+}
+
+// HTMLTypeNEQ applies the NEQ predicate on the "html_type" field.
+func HTMLTypeNEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldHTMLType, v))
+}
+
+// HTMLTypeIn applies the In predicate on the "html_type" field.
+func HTMLTypeIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldIn(FieldHTMLType, vs...))
+}
+
+// HTMLTypeNotIn applies the NotIn predicate on the "html_type" field.
+func HTMLTypeNotIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNotIn(FieldHTMLType, vs...))
+}
+
+// HTMLTypeGT applies the GT predicate on the "html_type" field.
+func HTMLTypeGT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGT(FieldHTMLType, v))
+}
+
+// HTMLTypeGTE applies the GTE predicate on the "html_type" field.
+func HTMLTypeGTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGTE(FieldHTMLType, v))
+}
+
+// HTMLTypeLT applies the LT predicate on the "html_type" field.
+func HTMLTypeLT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLT(FieldHTMLType, v))
+}
+
+// HTMLTypeLTE applies the LTE predicate on the "html_type" field.
+func HTMLTypeLTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLTE(FieldHTMLType, v))
+}
+
+// HTMLTypeContains applies the Contains predicate on the "html_type" field.
+func HTMLTypeContains(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContains(FieldHTMLType, v))
+}
+
+// HTMLTypeHasPrefix applies the HasPrefix predicate on the "html_type" field.
+func HTMLTypeHasPrefix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasPrefix(FieldHTMLType, v))
+}
+
+// HTMLTypeHasSuffix applies the HasSuffix predicate on the "html_type" field.
+func HTMLTypeHasSuffix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasSuffix(FieldHTMLType, v))
+}
+
+// HTMLTypeEqualFold applies the EqualFold predicate on the "html_type" field.
+func HTMLTypeEqualFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEqualFold(FieldHTMLType, v))
+}
+
+// HTMLTypeContainsFold applies the ContainsFold predicate on the "html_type" field.
+func HTMLTypeContainsFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContainsFold(FieldHTMLType, v))
+}
+
+// DictTypeEQ applies the EQ predicate on the "dict_type" field.
+func DictTypeEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldDictType, v))
+}
+
+// DictTypeNEQ applies the NEQ predicate on the "dict_type" field.
+func DictTypeNEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldDictType, v))
+}
+
+// DictTypeIn applies the In predicate on the "dict_type" field.
+func DictTypeIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldIn(FieldDictType, vs...))
+}
+
+// DictTypeNotIn applies the NotIn predicate on the "dict_type" field.
+func DictTypeNotIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNotIn(FieldDictType, vs...))
+}
+
+// DictTypeGT applies the GT predicate on the "dict_type" field.
+func DictTypeGT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGT(FieldDictType, v))
+}
+
+// DictTypeGTE applies the GTE predicate on the "dict_type" field.
+func DictTypeGTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGTE(FieldDictType, v))
+}
+
+// DictTypeLT applies the LT predicate on the "dict_type" field.
+func DictTypeLT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLT(FieldDictType, v))
+}
+
+// DictTypeLTE applies the LTE predicate on the "dict_type" field.
+func DictTypeLTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLTE(FieldDictType, v))
+}
+
+// DictTypeContains applies the Contains predicate on the "dict_type" field.
+func DictTypeContains(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContains(FieldDictType, v))
+}
+
+// DictTypeHasPrefix applies the HasPrefix predicate on the "dict_type" field.
+func DictTypeHasPrefix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasPrefix(FieldDictType, v))
+}
+
+// DictTypeHasSuffix applies the HasSuffix predicate on the "dict_type" field.
+func DictTypeHasSuffix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasSuffix(FieldDictType, v))
+}
+
+// DictTypeEqualFold applies the EqualFold predicate on the "dict_type" field.
+func DictTypeEqualFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEqualFold(FieldDictType, v))
+}
+
+// DictTypeContainsFold applies the ContainsFold predicate on the "dict_type" field.
+func DictTypeContainsFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContainsFold(FieldDictType, v))
+}
+
+// RequiredEQ applies the EQ predicate on the "required" field.
+func RequiredEQ(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldRequired, v))
+}
+
+// RequiredNEQ applies the NEQ predicate on the "required" field.
+func RequiredNEQ(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldRequired, v))
+}
+
+// RequiredIsNil applies the IsNil predicate on the "required" field.
+func RequiredIsNil() predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldIsNull(FieldRequired))
+}
+
+// RequiredNotNil applies the NotNil predicate on the "required" field.
+func RequiredNotNil() predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNotNull(FieldRequired))
+}
+
+// FillEQ applies the EQ predicate on the "fill" field.
+func FillEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldFill, v))
+}
+
+// FillNEQ applies the NEQ predicate on the "fill" field.
+func FillNEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldFill, v))
+}
+
+// FillIn applies the In predicate on the "fill" field.
+func FillIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldIn(FieldFill, vs...))
+}
+
+// FillNotIn applies the NotIn predicate on the "fill" field.
+func FillNotIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNotIn(FieldFill, vs...))
+}
+
+// FillGT applies the GT predicate on the "fill" field.
+func FillGT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGT(FieldFill, v))
+}
+
+// FillGTE applies the GTE predicate on the "fill" field.
+func FillGTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGTE(FieldFill, v))
+}
+
+// FillLT applies the LT predicate on the "fill" field.
+func FillLT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLT(FieldFill, v))
+}
+
+// FillLTE applies the LTE predicate on the "fill" field.
+func FillLTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLTE(FieldFill, v))
+}
+
+// FillContains applies the Contains predicate on the "fill" field.
+func FillContains(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContains(FieldFill, v))
+}
+
+// FillHasPrefix applies the HasPrefix predicate on the "fill" field.
+func FillHasPrefix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasPrefix(FieldFill, v))
+}
+
+// FillHasSuffix applies the HasSuffix predicate on the "fill" field.
+func FillHasSuffix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasSuffix(FieldFill, v))
+}
+
+// FillEqualFold applies the EqualFold predicate on the "fill" field.
+func FillEqualFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEqualFold(FieldFill, v))
+}
+
+// FillContainsFold applies the ContainsFold predicate on the "fill" field.
+func FillContainsFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContainsFold(FieldFill, v))
+}
+
+// LogicDeleteEQ applies the EQ predicate on the "logic_delete" field.
+func LogicDeleteEQ(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldLogicDelete, v))
+}
+
+// LogicDeleteNEQ applies the NEQ predicate on the "logic_delete" field.
+func LogicDeleteNEQ(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldLogicDelete, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldVersion, v))
+}
+
+// LengthEQ applies the EQ predicate on the "length" field.
+func LengthEQ(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldLength, v))
+}
+
+// LengthNEQ applies the NEQ predicate on the "length" field.
+func LengthNEQ(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldLength, v))
+}
+
+// LengthIn applies the In predicate on the "length" field.
+func LengthIn(vs ...int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldIn(FieldLength, vs...))
+}
+
+// LengthNotIn applies the NotIn predicate on the "length" field.
+func LengthNotIn(vs ...int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNotIn(FieldLength, vs...))
+}
+
+// LengthGT applies the GT predicate on the "length" field.
+func LengthGT(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGT(FieldLength, v))
+}
+
+// LengthGTE applies the GTE predicate on the "length" field.
+func LengthGTE(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGTE(FieldLength, v))
+}
+
+// LengthLT applies the LT predicate on the "length" field.
+func LengthLT(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLT(FieldLength, v))
+}
+
+// LengthLTE applies the LTE predicate on the "length" field.
+func LengthLTE(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLTE(FieldLength, v))
+}
+
+// SensitiveEQ applies the EQ predicate on the "sensitive" field.
+func SensitiveEQ(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldSensitive, v))
+}
+
+// SensitiveNEQ applies the NEQ predicate on the "sensitive" field.
+func SensitiveNEQ(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldSensitive, v))
+}
+
+// ScaleEQ applies the EQ predicate on the "scale" field.
+func ScaleEQ(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldScale, v))
+}
+
+// ScaleNEQ applies the NEQ predicate on the "scale" field.
+func ScaleNEQ(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldScale, v))
+}
+
+// ScaleIn applies the In predicate on the "scale" field.
+func ScaleIn(vs ...int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldIn(FieldScale, vs...))
+}
+
+// ScaleNotIn applies the NotIn predicate on the "scale" field.
+func ScaleNotIn(vs ...int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNotIn(FieldScale, vs...))
+}
+
+// ScaleGT applies the GT predicate on the "scale" field.
+func ScaleGT(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGT(FieldScale, v))
+}
+
+// ScaleGTE applies the GTE predicate on the "scale" field.
+func ScaleGTE(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGTE(FieldScale, v))
+}
+
+// ScaleLT applies the LT predicate on the "scale" field.
+func ScaleLT(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLT(FieldScale, v))
+}
+
+// ScaleLTE applies the LTE predicate on the "scale" field.
+func ScaleLTE(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLTE(FieldScale, v))
+}
+
+// DefaultValueEQ applies the EQ predicate on the "default_value" field.
+func DefaultValueEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldDefaultValue, v))
+}
+
+// DefaultValueNEQ applies the NEQ predicate on the "default_value" field.
+func DefaultValueNEQ(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldDefaultValue, v))
+}
+
+// DefaultValueIn applies the In predicate on the "default_value" field.
+func DefaultValueIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldIn(FieldDefaultValue, vs...))
+}
+
+// DefaultValueNotIn applies the NotIn predicate on the "default_value" field.
+func DefaultValueNotIn(vs ...string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNotIn(FieldDefaultValue, vs...))
+}
+
+// DefaultValueGT applies the GT predicate on the "default_value" field.
+func DefaultValueGT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGT(FieldDefaultValue, v))
+}
+
+// DefaultValueGTE applies the GTE predicate on the "default_value" field.
+func DefaultValueGTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGTE(FieldDefaultValue, v))
+}
+
+// DefaultValueLT applies the LT predicate on the "default_value" field.
+func DefaultValueLT(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLT(FieldDefaultValue, v))
+}
+
+// DefaultValueLTE applies the LTE predicate on the "default_value" field.
+func DefaultValueLTE(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLTE(FieldDefaultValue, v))
+}
+
+// DefaultValueContains applies the Contains predicate on the "default_value" field.
+func DefaultValueContains(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContains(FieldDefaultValue, v))
+}
+
+// DefaultValueHasPrefix applies the HasPrefix predicate on the "default_value" field.
+func DefaultValueHasPrefix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasPrefix(FieldDefaultValue, v))
+}
+
+// DefaultValueHasSuffix applies the HasSuffix predicate on the "default_value" field.
+func DefaultValueHasSuffix(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldHasSuffix(FieldDefaultValue, v))
+}
+
+// DefaultValueEqualFold applies the EqualFold predicate on the "default_value" field.
+func DefaultValueEqualFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEqualFold(FieldDefaultValue, v))
+}
+
+// DefaultValueContainsFold applies the ContainsFold predicate on the "default_value" field.
+func DefaultValueContainsFold(v string) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldContainsFold(FieldDefaultValue, v))
+}
+
+// EnumValuesIsNil applies the IsNil predicate on the "enum_values" field.
+func EnumValuesIsNil() predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldIsNull(FieldEnumValues))
+}
+
+// EnumValuesNotNil applies the NotNil predicate on the "enum_values" field.
+func EnumValuesNotNil() predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNotNull(FieldEnumValues))
+}
+
+// AutoIncrementEQ applies the EQ predicate on the "auto_increment" field.
+func AutoIncrementEQ(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldAutoIncrement, v))
+}
+
+// AutoIncrementNEQ applies the NEQ predicate on the "auto_increment" field.
+func AutoIncrementNEQ(v bool) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldAutoIncrement, v))
+}
+
+// SortEQ applies the EQ predicate on the "sort" field.
+func SortEQ(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldEQ(FieldSort, v))
+}
+
+// SortNEQ applies the NEQ predicate on the "sort" field.
+func SortNEQ(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNEQ(FieldSort, v))
+}
+
+// SortIn applies the In predicate on the "sort" field.
+func SortIn(vs ...int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldIn(FieldSort, vs...))
+}
+
+// SortNotIn applies the NotIn predicate on the "sort" field.
+func SortNotIn(vs ...int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldNotIn(FieldSort, vs...))
+}
+
+// SortGT applies the GT predicate on the "sort" field.
+func SortGT(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGT(FieldSort, v))
+}
+
+// SortGTE applies the GTE predicate on the "sort" field.
+func SortGTE(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldGTE(FieldSort, v))
+}
+
+// SortLT applies the LT predicate on the "sort" field.
+func SortLT(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLT(FieldSort, v))
+}
+
+// SortLTE applies the LTE predicate on the "sort" field.
+func SortLTE(v int) predicate.GenColumn {
+	return predicate.GenColumn(sql.FieldLTE(FieldSort, v))
+}
+
+// HasGenTable applies the HasEdge predicate on the "gen_table" edge.
+func HasGenTable() predicate.GenColumn {
+	return predicate.GenColumn(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GenTableTable, GenTableColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGenTableWith applies the HasEdge predicate on the "gen_table" edge with a given conditions (other predicates).
+func HasGenTableWith(preds ...predicate.GenTable) predicate.GenColumn {
+	return predicate.GenColumn(func(s *sql.Selector) {
+		step := newGenTableStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GenColumn) predicate.GenColumn {
+	return predicate.GenColumn(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GenColumn) predicate.GenColumn {
+	return predicate.GenColumn(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GenColumn) predicate.GenColumn {
+	return predicate.GenColumn(sql.NotPredicates(p))
+}
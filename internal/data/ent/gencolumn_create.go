@@ -0,0 +1,2156 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gen_code/internal/data/ent/gencolumn"
+	"gen_code/internal/data/ent/gentable"
+)
+
+// GenColumnCreate is the builder for creating a GenColumn entity.
+type GenColumnCreate struct {
+	config
+	mutation *GenColumnMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTableID sets the "table_id" field.
+func (_c *GenColumnCreate) SetTableID(v int64) *GenColumnCreate {
+	_c.mutation.SetTableID(v)
+	return _c
+}
+
+// SetColumnName sets the "column_name" field.
+func (_c *GenColumnCreate) SetColumnName(v string) *GenColumnCreate {
+	_c.mutation.SetColumnName(v)
+	return _c
+}
+
+// SetNillableColumnName sets the "column_name" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableColumnName(v *string) *GenColumnCreate {
+	if v != nil {
+		_c.SetColumnName(*v)
+	}
+	return _c
+}
+
+// SetColumnType sets the "column_type" field.
+func (_c *GenColumnCreate) SetColumnType(v string) *GenColumnCreate {
+	_c.mutation.SetColumnType(v)
+	return _c
+}
+
+// SetNillableColumnType sets the "column_type" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableColumnType(v *string) *GenColumnCreate {
+	if v != nil {
+		_c.SetColumnType(*v)
+	}
+	return _c
+}
+
+// SetColumnComment sets the "column_comment" field.
+func (_c *GenColumnCreate) SetColumnComment(v string) *GenColumnCreate {
+	_c.mutation.SetColumnComment(v)
+	return _c
+}
+
+// SetNillableColumnComment sets the "column_comment" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableColumnComment(v *string) *GenColumnCreate {
+	if v != nil {
+		_c.SetColumnComment(*v)
+	}
+	return _c
+}
+
+// SetIsNullable sets the "is_nullable" field.
+func (_c *GenColumnCreate) SetIsNullable(v bool) *GenColumnCreate {
+	_c.mutation.SetIsNullable(v)
+	return _c
+}
+
+// SetNillableIsNullable sets the "is_nullable" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableIsNullable(v *bool) *GenColumnCreate {
+	if v != nil {
+		_c.SetIsNullable(*v)
+	}
+	return _c
+}
+
+// SetIsPrimaryKey sets the "is_primary_key" field.
+func (_c *GenColumnCreate) SetIsPrimaryKey(v bool) *GenColumnCreate {
+	_c.mutation.SetIsPrimaryKey(v)
+	return _c
+}
+
+// SetNillableIsPrimaryKey sets the "is_primary_key" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableIsPrimaryKey(v *bool) *GenColumnCreate {
+	if v != nil {
+		_c.SetIsPrimaryKey(*v)
+	}
+	return _c
+}
+
+// SetGoType sets the "go_type" field.
+func (_c *GenColumnCreate) SetGoType(v string) *GenColumnCreate {
+	_c.mutation.SetGoType(v)
+	return _c
+}
+
+// SetNillableGoType sets the "go_type" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableGoType(v *string) *GenColumnCreate {
+	if v != nil {
+		_c.SetGoType(*v)
+	}
+	return _c
+}
+
+// SetJavaType sets the "java_type" field.
+func (_c *GenColumnCreate) SetJavaType(v string) *GenColumnCreate {
+	_c.mutation.SetJavaType(v)
+	return _c
+}
+
+// SetNillableJavaType sets the "java_type" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableJavaType(v *string) *GenColumnCreate {
+	if v != nil {
+		_c.SetJavaType(*v)
+	}
+	return _c
+}
+
+// SetFieldName sets the "field_name" field.
+func (_c *GenColumnCreate) SetFieldName(v string) *GenColumnCreate {
+	_c.mutation.SetFieldName(v)
+	return _c
+}
+
+// SetNillableFieldName sets the "field_name" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableFieldName(v *string) *GenColumnCreate {
+	if v != nil {
+		_c.SetFieldName(*v)
+	}
+	return _c
+}
+
+// SetHideInList sets the "hide_in_list" field.
+func (_c *GenColumnCreate) SetHideInList(v bool) *GenColumnCreate {
+	_c.mutation.SetHideInList(v)
+	return _c
+}
+
+// SetNillableHideInList sets the "hide_in_list" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableHideInList(v *bool) *GenColumnCreate {
+	if v != nil {
+		_c.SetHideInList(*v)
+	}
+	return _c
+}
+
+// SetReadOnly sets the "read_only" field.
+func (_c *GenColumnCreate) SetReadOnly(v bool) *GenColumnCreate {
+	_c.mutation.SetReadOnly(v)
+	return _c
+}
+
+// SetNillableReadOnly sets the "read_only" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableReadOnly(v *bool) *GenColumnCreate {
+	if v != nil {
+		_c.SetReadOnly(*v)
+	}
+	return _c
+}
+
+// SetQueryType sets the "query_type" field.
+func (_c *GenColumnCreate) SetQueryType(v string) *GenColumnCreate {
+	_c.mutation.SetQueryType(v)
+	return _c
+}
+
+// SetNillableQueryType sets the "query_type" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableQueryType(v *string) *GenColumnCreate {
+	if v != nil {
+		_c.SetQueryType(*v)
+	}
+	return _c
+}
+
+// SetHTMLType sets the "html_type" field.
+func (_c *GenColumnCreate) SetHTMLType(v string) *GenColumnCreate {
+	_c.mutation.SetHTMLType(v)
+	return _c
+}
+
+// SetNillableHTMLType sets the "html_type" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableHTMLType(v *string) *GenColumnCreate {
+	if v != nil {
+		_c.SetHTMLType(*v)
+	}
+	return _c
+}
+
+// SetDictType sets the "dict_type" field.
+func (_c *GenColumnCreate) SetDictType(v string) *GenColumnCreate {
+	_c.mutation.SetDictType(v)
+	return _c
+}
+
+// SetNillableDictType sets the "dict_type" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableDictType(v *string) *GenColumnCreate {
+	if v != nil {
+		_c.SetDictType(*v)
+	}
+	return _c
+}
+
+// SetRequired sets the "required" field.
+func (_c *GenColumnCreate) SetRequired(v bool) *GenColumnCreate {
+	_c.mutation.SetRequired(v)
+	return _c
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableRequired(v *bool) *GenColumnCreate {
+	if v != nil {
+		_c.SetRequired(*v)
+	}
+	return _c
+}
+
+// SetFill sets the "fill" field.
+func (_c *GenColumnCreate) SetFill(v string) *GenColumnCreate {
+	_c.mutation.SetFill(v)
+	return _c
+}
+
+// SetNillableFill sets the "fill" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableFill(v *string) *GenColumnCreate {
+	if v != nil {
+		_c.SetFill(*v)
+	}
+	return _c
+}
+
+// SetLogicDelete sets the "logic_delete" field.
+func (_c *GenColumnCreate) SetLogicDelete(v bool) *GenColumnCreate {
+	_c.mutation.SetLogicDelete(v)
+	return _c
+}
+
+// SetNillableLogicDelete sets the "logic_delete" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableLogicDelete(v *bool) *GenColumnCreate {
+	if v != nil {
+		_c.SetLogicDelete(*v)
+	}
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *GenColumnCreate) SetVersion(v bool) *GenColumnCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableVersion(v *bool) *GenColumnCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetLength sets the "length" field.
+func (_c *GenColumnCreate) SetLength(v int) *GenColumnCreate {
+	_c.mutation.SetLength(v)
+	return _c
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableLength(v *int) *GenColumnCreate {
+	if v != nil {
+		_c.SetLength(*v)
+	}
+	return _c
+}
+
+// SetSensitive sets the "sensitive" field.
+func (_c *GenColumnCreate) SetSensitive(v bool) *GenColumnCreate {
+	_c.mutation.SetSensitive(v)
+	return _c
+}
+
+// SetNillableSensitive sets the "sensitive" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableSensitive(v *bool) *GenColumnCreate {
+	if v != nil {
+		_c.SetSensitive(*v)
+	}
+	return _c
+}
+
+// SetScale sets the "scale" field.
+func (_c *GenColumnCreate) SetScale(v int) *GenColumnCreate {
+	_c.mutation.SetScale(v)
+	return _c
+}
+
+// SetNillableScale sets the "scale" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableScale(v *int) *GenColumnCreate {
+	if v != nil {
+		_c.SetScale(*v)
+	}
+	return _c
+}
+
+// SetDefaultValue sets the "default_value" field.
+func (_c *GenColumnCreate) SetDefaultValue(v string) *GenColumnCreate {
+	_c.mutation.SetDefaultValue(v)
+	return _c
+}
+
+// SetNillableDefaultValue sets the "default_value" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableDefaultValue(v *string) *GenColumnCreate {
+	if v != nil {
+		_c.SetDefaultValue(*v)
+	}
+	return _c
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (_c *GenColumnCreate) SetEnumValues(v []string) *GenColumnCreate {
+	_c.mutation.SetEnumValues(v)
+	return _c
+}
+
+// SetAutoIncrement sets the "auto_increment" field.
+func (_c *GenColumnCreate) SetAutoIncrement(v bool) *GenColumnCreate {
+	_c.mutation.SetAutoIncrement(v)
+	return _c
+}
+
+// SetNillableAutoIncrement sets the "auto_increment" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableAutoIncrement(v *bool) *GenColumnCreate {
+	if v != nil {
+		_c.SetAutoIncrement(*v)
+	}
+	return _c
+}
+
+// SetSort sets the "sort" field.
+func (_c *GenColumnCreate) SetSort(v int) *GenColumnCreate {
+	_c.mutation.SetSort(v)
+	return _c
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_c *GenColumnCreate) SetNillableSort(v *int) *GenColumnCreate {
+	if v != nil {
+		_c.SetSort(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GenColumnCreate) SetID(v int64) *GenColumnCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetGenTableID sets the "gen_table" edge to the GenTable entity by ID.
+func (_c *GenColumnCreate) SetGenTableID(id int64) *GenColumnCreate {
+	_c.mutation.SetGenTableID(id)
+	return _c
+}
+
+// SetGenTable sets the "gen_table" edge to the GenTable entity.
+func (_c *GenColumnCreate) SetGenTable(v *GenTable) *GenColumnCreate {
+	return _c.SetGenTableID(v.ID)
+}
+
+// Mutation returns the GenColumnMutation object of the builder.
+func (_c *GenColumnCreate) Mutation() *GenColumnMutation {
+	return _c.mutation
+}
+
+// Save creates the GenColumn in the database.
+func (_c *GenColumnCreate) Save(ctx context.Context) (*GenColumn, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GenColumnCreate) SaveX(ctx context.Context) *GenColumn {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GenColumnCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GenColumnCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GenColumnCreate) defaults() {
+	if _, ok := _c.mutation.ColumnName(); !ok {
+		v := gencolumn.DefaultColumnName
+		_c.mutation.SetColumnName(v)
+	}
+	if _, ok := _c.mutation.ColumnType(); !ok {
+		v := gencolumn.DefaultColumnType
+		_c.mutation.SetColumnType(v)
+	}
+	if _, ok := _c.mutation.ColumnComment(); !ok {
+		v := gencolumn.DefaultColumnComment
+		_c.mutation.SetColumnComment(v)
+	}
+	if _, ok := _c.mutation.IsNullable(); !ok {
+		v := gencolumn.DefaultIsNullable
+		_c.mutation.SetIsNullable(v)
+	}
+	if _, ok := _c.mutation.IsPrimaryKey(); !ok {
+		v := gencolumn.DefaultIsPrimaryKey
+		_c.mutation.SetIsPrimaryKey(v)
+	}
+	if _, ok := _c.mutation.GoType(); !ok {
+		v := gencolumn.DefaultGoType
+		_c.mutation.SetGoType(v)
+	}
+	if _, ok := _c.mutation.JavaType(); !ok {
+		v := gencolumn.DefaultJavaType
+		_c.mutation.SetJavaType(v)
+	}
+	if _, ok := _c.mutation.FieldName(); !ok {
+		v := gencolumn.DefaultFieldName
+		_c.mutation.SetFieldName(v)
+	}
+	if _, ok := _c.mutation.HideInList(); !ok {
+		v := gencolumn.DefaultHideInList
+		_c.mutation.SetHideInList(v)
+	}
+	if _, ok := _c.mutation.ReadOnly(); !ok {
+		v := gencolumn.DefaultReadOnly
+		_c.mutation.SetReadOnly(v)
+	}
+	if _, ok := _c.mutation.QueryType(); !ok {
+		v := gencolumn.DefaultQueryType
+		_c.mutation.SetQueryType(v)
+	}
+	if _, ok := _c.mutation.HTMLType(); !ok {
+		v := gencolumn.DefaultHTMLType
+		_c.mutation.SetHTMLType(v)
+	}
+	if _, ok := _c.mutation.DictType(); !ok {
+		v := gencolumn.DefaultDictType
+		_c.mutation.SetDictType(v)
+	}
+	if _, ok := _c.mutation.Fill(); !ok {
+		v := gencolumn.DefaultFill
+		_c.mutation.SetFill(v)
+	}
+	if _, ok := _c.mutation.LogicDelete(); !ok {
+		v := gencolumn.DefaultLogicDelete
+		_c.mutation.SetLogicDelete(v)
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := gencolumn.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
+	if _, ok := _c.mutation.Length(); !ok {
+		v := gencolumn.DefaultLength
+		_c.mutation.SetLength(v)
+	}
+	if _, ok := _c.mutation.Sensitive(); !ok {
+		v := gencolumn.DefaultSensitive
+		_c.mutation.SetSensitive(v)
+	}
+	if _, ok := _c.mutation.Scale(); !ok {
+		v := gencolumn.DefaultScale
+		_c.mutation.SetScale(v)
+	}
+	if _, ok := _c.mutation.DefaultValue(); !ok {
+		v := gencolumn.DefaultDefaultValue
+		_c.mutation.SetDefaultValue(v)
+	}
+	if _, ok := _c.mutation.AutoIncrement(); !ok {
+		v := gencolumn.DefaultAutoIncrement
+		_c.mutation.SetAutoIncrement(v)
+	}
+	if _, ok := _c.mutation.Sort(); !ok {
+		v := gencolumn.DefaultSort
+		_c.mutation.SetSort(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GenColumnCreate) check() error {
+	if _, ok := _c.mutation.TableID(); !ok {
+		return &ValidationError{Name: "table_id", err: errors.New(`ent: missing required field "GenColumn.table_id"`)}
+	}
+	if _, ok := _c.mutation.ColumnName(); !ok {
+		return &ValidationError{Name: "column_name", err: errors.New(`ent: missing required field "GenColumn.column_name"`)}
+	}
+	if _, ok := _c.mutation.ColumnType(); !ok {
+		return &ValidationError{Name: "column_type", err: errors.New(`ent: missing required field "GenColumn.column_type"`)}
+	}
+	if _, ok := _c.mutation.ColumnComment(); !ok {
+		return &ValidationError{Name: "column_comment", err: errors.New(`ent: missing required field "GenColumn.column_comment"`)}
+	}
+	if _, ok := _c.mutation.IsNullable(); !ok {
+		return &ValidationError{Name: "is_nullable", err: errors.New(`ent: missing required field "GenColumn.is_nullable"`)}
+	}
+	if _, ok := _c.mutation.IsPrimaryKey(); !ok {
+		return &ValidationError{Name: "is_primary_key", err: errors.New(`ent: missing required field "GenColumn.is_primary_key"`)}
+	}
+	if _, ok := _c.mutation.GoType(); !ok {
+		return &ValidationError{Name: "go_type", err: errors.New(`ent: missing required field "GenColumn.go_type"`)}
+	}
+	if _, ok := _c.mutation.JavaType(); !ok {
+		return &ValidationError{Name: "java_type", err: errors.New(`ent: missing required field "GenColumn.java_type"`)}
+	}
+	if _, ok := _c.mutation.FieldName(); !ok {
+		return &ValidationError{Name: "field_name", err: errors.New(`ent: missing required field "GenColumn.field_name"`)}
+	}
+	if _, ok := _c.mutation.HideInList(); !ok {
+		return &ValidationError{Name: "hide_in_list", err: errors.New(`ent: missing required field "GenColumn.hide_in_list"`)}
+	}
+	if _, ok := _c.mutation.ReadOnly(); !ok {
+		return &ValidationError{Name: "read_only", err: errors.New(`ent: missing required field "GenColumn.read_only"`)}
+	}
+	if _, ok := _c.mutation.QueryType(); !ok {
+		return &ValidationError{Name: "query_type", err: errors.New(`ent: missing required field "GenColumn.query_type"`)}
+	}
+	if _, ok := _c.mutation.HTMLType(); !ok {
+		return &ValidationError{Name: "html_type", err: errors.New(`ent: missing required field "GenColumn.html_type"`)}
+	}
+	if _, ok := _c.mutation.DictType(); !ok {
+		return &ValidationError{Name: "dict_type", err: errors.New(`ent: missing required field "GenColumn.dict_type"`)}
+	}
+	if _, ok := _c.mutation.Fill(); !ok {
+		return &ValidationError{Name: "fill", err: errors.New(`ent: missing required field "GenColumn.fill"`)}
+	}
+	if _, ok := _c.mutation.LogicDelete(); !ok {
+		return &ValidationError{Name: "logic_delete", err: errors.New(`ent: missing required field "GenColumn.logic_delete"`)}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "GenColumn.version"`)}
+	}
+	if _, ok := _c.mutation.Length(); !ok {
+		return &ValidationError{Name: "length", err: errors.New(`ent: missing required field "GenColumn.length"`)}
+	}
+	if _, ok := _c.mutation.Sensitive(); !ok {
+		return &ValidationError{Name: "sensitive", err: errors.New(`ent: missing required field "GenColumn.sensitive"`)}
+	}
+	if _, ok := _c.mutation.Scale(); !ok {
+		return &ValidationError{Name: "scale", err: errors.New(`ent: missing required field "GenColumn.scale"`)}
+	}
+	if _, ok := _c.mutation.DefaultValue(); !ok {
+		return &ValidationError{Name: "default_value", err: errors.New(`ent: missing required field "GenColumn.default_value"`)}
+	}
+	if _, ok := _c.mutation.AutoIncrement(); !ok {
+		return &ValidationError{Name: "auto_increment", err: errors.New(`ent: missing required field "GenColumn.auto_increment"`)}
+	}
+	if _, ok := _c.mutation.Sort(); !ok {
+		return &ValidationError{Name: "sort", err: errors.New(`ent: missing required field "GenColumn.sort"`)}
+	}
+	if len(_c.mutation.GenTableIDs()) == 0 {
+		return &ValidationError{Name: "gen_table", err: errors.New(`ent: missing required edge "GenColumn.gen_table"`)}
+	}
+	return nil
+}
+
+func (_c *GenColumnCreate) sqlSave(ctx context.Context) (*GenColumn, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GenColumnCreate) createSpec() (*GenColumn, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GenColumn{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(gencolumn.Table, sqlgraph.NewFieldSpec(gencolumn.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ColumnName(); ok {
+		_spec.SetField(gencolumn.FieldColumnName, field.TypeString, value)
+		_node.ColumnName = value
+	}
+	if value, ok := _c.mutation.ColumnType(); ok {
+		_spec.SetField(gencolumn.FieldColumnType, field.TypeString, value)
+		_node.ColumnType = value
+	}
+	if value, ok := _c.mutation.ColumnComment(); ok {
+		_spec.SetField(gencolumn.FieldColumnComment, field.TypeString, value)
+		_node.ColumnComment = value
+	}
+	if value, ok := _c.mutation.IsNullable(); ok {
+		_spec.SetField(gencolumn.FieldIsNullable, field.TypeBool, value)
+		_node.IsNullable = value
+	}
+	if value, ok := _c.mutation.IsPrimaryKey(); ok {
+		_spec.SetField(gencolumn.FieldIsPrimaryKey, field.TypeBool, value)
+		_node.IsPrimaryKey = value
+	}
+	if value, ok := _c.mutation.GoType(); ok {
+		_spec.SetField(gencolumn.FieldGoType, field.TypeString, value)
+		_node.GoType = value
+	}
+	if value, ok := _c.mutation.JavaType(); ok {
+		_spec.SetField(gencolumn.FieldJavaType, field.TypeString, value)
+		_node.JavaType = value
+	}
+	if value, ok := _c.mutation.FieldName(); ok {
+		_spec.SetField(gencolumn.FieldFieldName, field.TypeString, value)
+		_node.FieldName = value
+	}
+	if value, ok := _c.mutation.HideInList(); ok {
+		_spec.SetField(gencolumn.FieldHideInList, field.TypeBool, value)
+		_node.HideInList = value
+	}
+	if value, ok := _c.mutation.ReadOnly(); ok {
+		_spec.SetField(gencolumn.FieldReadOnly, field.TypeBool, value)
+		_node.ReadOnly = value
+	}
+	if value, ok := _c.mutation.QueryType(); ok {
+		_spec.SetField(gencolumn.FieldQueryType, field.TypeString, value)
+		_node.QueryType = value
+	}
+	if value, ok := _c.mutation.HTMLType(); ok {
+		_spec.SetField(gencolumn.FieldHTMLType, field.TypeString, value)
+		_node.HTMLType = value
+	}
+	if value, ok := _c.mutation.DictType(); ok {
+		_spec.SetField(gencolumn.FieldDictType, field.TypeString, value)
+		_node.DictType = value
+	}
+	if value, ok := _c.mutation.Required(); ok {
+		_spec.SetField(gencolumn.FieldRequired, field.TypeBool, value)
+		_node.Required = &value
+	}
+	if value, ok := _c.mutation.Fill(); ok {
+		_spec.SetField(gencolumn.FieldFill, field.TypeString, value)
+		_node.Fill = value
+	}
+	if value, ok := _c.mutation.LogicDelete(); ok {
+		_spec.SetField(gencolumn.FieldLogicDelete, field.TypeBool, value)
+		_node.LogicDelete = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(gencolumn.FieldVersion, field.TypeBool, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Length(); ok {
+		_spec.SetField(gencolumn.FieldLength, field.TypeInt, value)
+		_node.Length = value
+	}
+	if value, ok := _c.mutation.Sensitive(); ok {
+		_spec.SetField(gencolumn.FieldSensitive, field.TypeBool, value)
+		_node.Sensitive = value
+	}
+	if value, ok := _c.mutation.Scale(); ok {
+		_spec.SetField(gencolumn.FieldScale, field.TypeInt, value)
+		_node.Scale = value
+	}
+	if value, ok := _c.mutation.DefaultValue(); ok {
+		_spec.SetField(gencolumn.FieldDefaultValue, field.TypeString, value)
+		_node.DefaultValue = value
+	}
+	if value, ok := _c.mutation.EnumValues(); ok {
+		_spec.SetField(gencolumn.FieldEnumValues, field.TypeJSON, value)
+		_node.EnumValues = value
+	}
+	if value, ok := _c.mutation.AutoIncrement(); ok {
+		_spec.SetField(gencolumn.FieldAutoIncrement, field.TypeBool, value)
+		_node.AutoIncrement = value
+	}
+	if value, ok := _c.mutation.Sort(); ok {
+		_spec.SetField(gencolumn.FieldSort, field.TypeInt, value)
+		_node.Sort = value
+	}
+	if nodes := _c.mutation.GenTableIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gencolumn.GenTableTable,
+			Columns: []string{gencolumn.GenTableColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gentable.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TableID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GenColumn.Create().
+//		SetTableID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GenColumnUpsert) {
+//			SetTableID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GenColumnCreate) OnConflict(opts ...sql.ConflictOption) *GenColumnUpsertOne {
+	_c.conflict = opts
+	return &GenColumnUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GenColumn.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GenColumnCreate) OnConflictColumns(columns ...string) *GenColumnUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GenColumnUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GenColumnUpsertOne is the builder for "upsert"-ing
+	//  one GenColumn node.
+	GenColumnUpsertOne struct {
+		create *GenColumnCreate
+	}
+
+	// GenColumnUpsert is the "OnConflict" setter.
+	GenColumnUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTableID sets the "table_id" field.
+func (u *GenColumnUpsert) SetTableID(v int64) *GenColumnUpsert {
+	u.Set(gencolumn.FieldTableID, v)
+	return u
+}
+
+// UpdateTableID sets the "table_id" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateTableID() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldTableID)
+	return u
+}
+
+// SetColumnName sets the "column_name" field.
+func (u *GenColumnUpsert) SetColumnName(v string) *GenColumnUpsert {
+	u.Set(gencolumn.FieldColumnName, v)
+	return u
+}
+
+// UpdateColumnName sets the "column_name" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateColumnName() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldColumnName)
+	return u
+}
+
+// SetColumnType sets the "column_type" field.
+func (u *GenColumnUpsert) SetColumnType(v string) *GenColumnUpsert {
+	u.Set(gencolumn.FieldColumnType, v)
+	return u
+}
+
+// UpdateColumnType sets the "column_type" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateColumnType() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldColumnType)
+	return u
+}
+
+// SetColumnComment sets the "column_comment" field.
+func (u *GenColumnUpsert) SetColumnComment(v string) *GenColumnUpsert {
+	u.Set(gencolumn.FieldColumnComment, v)
+	return u
+}
+
+// UpdateColumnComment sets the "column_comment" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateColumnComment() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldColumnComment)
+	return u
+}
+
+// SetIsNullable sets the "is_nullable" field.
+func (u *GenColumnUpsert) SetIsNullable(v bool) *GenColumnUpsert {
+	u.Set(gencolumn.FieldIsNullable, v)
+	return u
+}
+
+// UpdateIsNullable sets the "is_nullable" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateIsNullable() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldIsNullable)
+	return u
+}
+
+// SetIsPrimaryKey sets the "is_primary_key" field.
+func (u *GenColumnUpsert) SetIsPrimaryKey(v bool) *GenColumnUpsert {
+	u.Set(gencolumn.FieldIsPrimaryKey, v)
+	return u
+}
+
+// UpdateIsPrimaryKey sets the "is_primary_key" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateIsPrimaryKey() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldIsPrimaryKey)
+	return u
+}
+
+// SetGoType sets the "go_type" field.
+func (u *GenColumnUpsert) SetGoType(v string) *GenColumnUpsert {
+	u.Set(gencolumn.FieldGoType, v)
+	return u
+}
+
+// UpdateGoType sets the "go_type" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateGoType() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldGoType)
+	return u
+}
+
+// SetJavaType sets the "java_type" field.
+func (u *GenColumnUpsert) SetJavaType(v string) *GenColumnUpsert {
+	u.Set(gencolumn.FieldJavaType, v)
+	return u
+}
+
+// UpdateJavaType sets the "java_type" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateJavaType() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldJavaType)
+	return u
+}
+
+// SetFieldName sets the "field_name" field.
+func (u *GenColumnUpsert) SetFieldName(v string) *GenColumnUpsert {
+	u.Set(gencolumn.FieldFieldName, v)
+	return u
+}
+
+// UpdateFieldName sets the "field_name" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateFieldName() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldFieldName)
+	return u
+}
+
+// SetHideInList sets the "hide_in_list" field.
+func (u *GenColumnUpsert) SetHideInList(v bool) *GenColumnUpsert {
+	u.Set(gencolumn.FieldHideInList, v)
+	return u
+}
+
+// UpdateHideInList sets the "hide_in_list" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateHideInList() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldHideInList)
+	return u
+}
+
+// SetReadOnly sets the "read_only" field.
+func (u *GenColumnUpsert) SetReadOnly(v bool) *GenColumnUpsert {
+	u.Set(gencolumn.FieldReadOnly, v)
+	return u
+}
+
+// UpdateReadOnly sets the "read_only" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateReadOnly() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldReadOnly)
+	return u
+}
+
+// SetQueryType sets the "query_type" field.
+func (u *GenColumnUpsert) SetQueryType(v string) *GenColumnUpsert {
+	u.Set(gencolumn.FieldQueryType, v)
+	return u
+}
+
+// UpdateQueryType sets the "query_type" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateQueryType() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldQueryType)
+	return u
+}
+
+// SetHTMLType sets the "html_type" field.
+func (u *GenColumnUpsert) SetHTMLType(v string) *GenColumnUpsert {
+	u.Set(gencolumn.FieldHTMLType, v)
+	return u
+}
+
+// UpdateHTMLType sets the "html_type" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateHTMLType() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldHTMLType)
+	return u
+}
+
+// SetDictType sets the "dict_type" field.
+func (u *GenColumnUpsert) SetDictType(v string) *GenColumnUpsert {
+	u.Set(gencolumn.FieldDictType, v)
+	return u
+}
+
+// UpdateDictType sets the "dict_type" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateDictType() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldDictType)
+	return u
+}
+
+// SetRequired sets the "required" field.
+func (u *GenColumnUpsert) SetRequired(v bool) *GenColumnUpsert {
+	u.Set(gencolumn.FieldRequired, v)
+	return u
+}
+
+// UpdateRequired sets the "required" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateRequired() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldRequired)
+	return u
+}
+
+// ClearRequired clears the value of the "required" field.
+func (u *GenColumnUpsert) ClearRequired() *GenColumnUpsert {
+	u.SetNull(gencolumn.FieldRequired)
+	return u
+}
+
+// SetFill sets the "fill" field.
+func (u *GenColumnUpsert) SetFill(v string) *GenColumnUpsert {
+	u.Set(gencolumn.FieldFill, v)
+	return u
+}
+
+// UpdateFill sets the "fill" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateFill() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldFill)
+	return u
+}
+
+// SetLogicDelete sets the "logic_delete" field.
+func (u *GenColumnUpsert) SetLogicDelete(v bool) *GenColumnUpsert {
+	u.Set(gencolumn.FieldLogicDelete, v)
+	return u
+}
+
+// UpdateLogicDelete sets the "logic_delete" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateLogicDelete() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldLogicDelete)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *GenColumnUpsert) SetVersion(v bool) *GenColumnUpsert {
+	u.Set(gencolumn.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateVersion() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldVersion)
+	return u
+}
+
+// SetLength sets the "length" field.
+func (u *GenColumnUpsert) SetLength(v int) *GenColumnUpsert {
+	u.Set(gencolumn.FieldLength, v)
+	return u
+}
+
+// UpdateLength sets the "length" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateLength() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldLength)
+	return u
+}
+
+// AddLength adds v to the "length" field.
+func (u *GenColumnUpsert) AddLength(v int) *GenColumnUpsert {
+	u.Add(gencolumn.FieldLength, v)
+	return u
+}
+
+// SetSensitive sets the "sensitive" field.
+func (u *GenColumnUpsert) SetSensitive(v bool) *GenColumnUpsert {
+	u.Set(gencolumn.FieldSensitive, v)
+	return u
+}
+
+// UpdateSensitive sets the "sensitive" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateSensitive() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldSensitive)
+	return u
+}
+
+// SetScale sets the "scale" field.
+func (u *GenColumnUpsert) SetScale(v int) *GenColumnUpsert {
+	u.Set(gencolumn.FieldScale, v)
+	return u
+}
+
+// UpdateScale sets the "scale" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateScale() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldScale)
+	return u
+}
+
+// AddScale adds v to the "scale" field.
+func (u *GenColumnUpsert) AddScale(v int) *GenColumnUpsert {
+	u.Add(gencolumn.FieldScale, v)
+	return u
+}
+
+// SetDefaultValue sets the "default_value" field.
+func (u *GenColumnUpsert) SetDefaultValue(v string) *GenColumnUpsert {
+	u.Set(gencolumn.FieldDefaultValue, v)
+	return u
+}
+
+// UpdateDefaultValue sets the "default_value" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateDefaultValue() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldDefaultValue)
+	return u
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (u *GenColumnUpsert) SetEnumValues(v []string) *GenColumnUpsert {
+	u.Set(gencolumn.FieldEnumValues, v)
+	return u
+}
+
+// UpdateEnumValues sets the "enum_values" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateEnumValues() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldEnumValues)
+	return u
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (u *GenColumnUpsert) ClearEnumValues() *GenColumnUpsert {
+	u.SetNull(gencolumn.FieldEnumValues)
+	return u
+}
+
+// SetAutoIncrement sets the "auto_increment" field.
+func (u *GenColumnUpsert) SetAutoIncrement(v bool) *GenColumnUpsert {
+	u.Set(gencolumn.FieldAutoIncrement, v)
+	return u
+}
+
+// UpdateAutoIncrement sets the "auto_increment" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateAutoIncrement() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldAutoIncrement)
+	return u
+}
+
+// SetSort sets the "sort" field.
+func (u *GenColumnUpsert) SetSort(v int) *GenColumnUpsert {
+	u.Set(gencolumn.FieldSort, v)
+	return u
+}
+
+// UpdateSort sets the "sort" field to the value that was provided on create.
+func (u *GenColumnUpsert) UpdateSort() *GenColumnUpsert {
+	u.SetExcluded(gencolumn.FieldSort)
+	return u
+}
+
+// AddSort adds v to the "sort" field.
+func (u *GenColumnUpsert) AddSort(v int) *GenColumnUpsert {
+	u.Add(gencolumn.FieldSort, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GenColumn.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(gencolumn.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GenColumnUpsertOne) UpdateNewValues() *GenColumnUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(gencolumn.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GenColumn.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GenColumnUpsertOne) Ignore() *GenColumnUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GenColumnUpsertOne) DoNothing() *GenColumnUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GenColumnCreate.OnConflict
+// documentation for more info.
+func (u *GenColumnUpsertOne) Update(set func(*GenColumnUpsert)) *GenColumnUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GenColumnUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTableID sets the "table_id" field.
+func (u *GenColumnUpsertOne) SetTableID(v int64) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetTableID(v)
+	})
+}
+
+// UpdateTableID sets the "table_id" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateTableID() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateTableID()
+	})
+}
+
+// SetColumnName sets the "column_name" field.
+func (u *GenColumnUpsertOne) SetColumnName(v string) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetColumnName(v)
+	})
+}
+
+// UpdateColumnName sets the "column_name" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateColumnName() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateColumnName()
+	})
+}
+
+// SetColumnType sets the "column_type" field.
+func (u *GenColumnUpsertOne) SetColumnType(v string) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetColumnType(v)
+	})
+}
+
+// UpdateColumnType sets the "column_type" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateColumnType() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateColumnType()
+	})
+}
+
+// SetColumnComment sets the "column_comment" field.
+func (u *GenColumnUpsertOne) SetColumnComment(v string) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetColumnComment(v)
+	})
+}
+
+// UpdateColumnComment sets the "column_comment" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateColumnComment() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateColumnComment()
+	})
+}
+
+// SetIsNullable sets the "is_nullable" field.
+func (u *GenColumnUpsertOne) SetIsNullable(v bool) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetIsNullable(v)
+	})
+}
+
+// UpdateIsNullable sets the "is_nullable" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateIsNullable() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateIsNullable()
+	})
+}
+
+// SetIsPrimaryKey sets the "is_primary_key" field.
+func (u *GenColumnUpsertOne) SetIsPrimaryKey(v bool) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetIsPrimaryKey(v)
+	})
+}
+
+// UpdateIsPrimaryKey sets the "is_primary_key" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateIsPrimaryKey() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateIsPrimaryKey()
+	})
+}
+
+// SetGoType sets the "go_type" field.
+func (u *GenColumnUpsertOne) SetGoType(v string) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetGoType(v)
+	})
+}
+
+// UpdateGoType sets the "go_type" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateGoType() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateGoType()
+	})
+}
+
+// SetJavaType sets the "java_type" field.
+func (u *GenColumnUpsertOne) SetJavaType(v string) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetJavaType(v)
+	})
+}
+
+// UpdateJavaType sets the "java_type" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateJavaType() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateJavaType()
+	})
+}
+
+// SetFieldName sets the "field_name" field.
+func (u *GenColumnUpsertOne) SetFieldName(v string) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetFieldName(v)
+	})
+}
+
+// UpdateFieldName sets the "field_name" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateFieldName() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateFieldName()
+	})
+}
+
+// SetHideInList sets the "hide_in_list" field.
+func (u *GenColumnUpsertOne) SetHideInList(v bool) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetHideInList(v)
+	})
+}
+
+// UpdateHideInList sets the "hide_in_list" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateHideInList() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateHideInList()
+	})
+}
+
+// SetReadOnly sets the "read_only" field.
+func (u *GenColumnUpsertOne) SetReadOnly(v bool) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetReadOnly(v)
+	})
+}
+
+// UpdateReadOnly sets the "read_only" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateReadOnly() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateReadOnly()
+	})
+}
+
+// SetQueryType sets the "query_type" field.
+func (u *GenColumnUpsertOne) SetQueryType(v string) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetQueryType(v)
+	})
+}
+
+// UpdateQueryType sets the "query_type" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateQueryType() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateQueryType()
+	})
+}
+
+// SetHTMLType sets the "html_type" field.
+func (u *GenColumnUpsertOne) SetHTMLType(v string) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetHTMLType(v)
+	})
+}
+
+// UpdateHTMLType sets the "html_type" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateHTMLType() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateHTMLType()
+	})
+}
+
+// SetDictType sets the "dict_type" field.
+func (u *GenColumnUpsertOne) SetDictType(v string) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetDictType(v)
+	})
+}
+
+// UpdateDictType sets the "dict_type" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateDictType() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateDictType()
+	})
+}
+
+// SetRequired sets the "required" field.
+func (u *GenColumnUpsertOne) SetRequired(v bool) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetRequired(v)
+	})
+}
+
+// UpdateRequired sets the "required" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateRequired() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateRequired()
+	})
+}
+
+// ClearRequired clears the value of the "required" field.
+func (u *GenColumnUpsertOne) ClearRequired() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.ClearRequired()
+	})
+}
+
+// SetFill sets the "fill" field.
+func (u *GenColumnUpsertOne) SetFill(v string) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetFill(v)
+	})
+}
+
+// UpdateFill sets the "fill" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateFill() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateFill()
+	})
+}
+
+// SetLogicDelete sets the "logic_delete" field.
+func (u *GenColumnUpsertOne) SetLogicDelete(v bool) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetLogicDelete(v)
+	})
+}
+
+// UpdateLogicDelete sets the "logic_delete" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateLogicDelete() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateLogicDelete()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *GenColumnUpsertOne) SetVersion(v bool) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateVersion() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetLength sets the "length" field.
+func (u *GenColumnUpsertOne) SetLength(v int) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetLength(v)
+	})
+}
+
+// AddLength adds v to the "length" field.
+func (u *GenColumnUpsertOne) AddLength(v int) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.AddLength(v)
+	})
+}
+
+// UpdateLength sets the "length" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateLength() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateLength()
+	})
+}
+
+// SetSensitive sets the "sensitive" field.
+func (u *GenColumnUpsertOne) SetSensitive(v bool) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetSensitive(v)
+	})
+}
+
+// UpdateSensitive sets the "sensitive" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateSensitive() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateSensitive()
+	})
+}
+
+// SetScale sets the "scale" field.
+func (u *GenColumnUpsertOne) SetScale(v int) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetScale(v)
+	})
+}
+
+// AddScale adds v to the "scale" field.
+func (u *GenColumnUpsertOne) AddScale(v int) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.AddScale(v)
+	})
+}
+
+// UpdateScale sets the "scale" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateScale() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateScale()
+	})
+}
+
+// SetDefaultValue sets the "default_value" field.
+func (u *GenColumnUpsertOne) SetDefaultValue(v string) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetDefaultValue(v)
+	})
+}
+
+// UpdateDefaultValue sets the "default_value" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateDefaultValue() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateDefaultValue()
+	})
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (u *GenColumnUpsertOne) SetEnumValues(v []string) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetEnumValues(v)
+	})
+}
+
+// UpdateEnumValues sets the "enum_values" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateEnumValues() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateEnumValues()
+	})
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (u *GenColumnUpsertOne) ClearEnumValues() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.ClearEnumValues()
+	})
+}
+
+// SetAutoIncrement sets the "auto_increment" field.
+func (u *GenColumnUpsertOne) SetAutoIncrement(v bool) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetAutoIncrement(v)
+	})
+}
+
+// UpdateAutoIncrement sets the "auto_increment" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateAutoIncrement() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateAutoIncrement()
+	})
+}
+
+// SetSort sets the "sort" field.
+func (u *GenColumnUpsertOne) SetSort(v int) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetSort(v)
+	})
+}
+
+// AddSort adds v to the "sort" field.
+func (u *GenColumnUpsertOne) AddSort(v int) *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.AddSort(v)
+	})
+}
+
+// UpdateSort sets the "sort" field to the value that was provided on create.
+func (u *GenColumnUpsertOne) UpdateSort() *GenColumnUpsertOne {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateSort()
+	})
+}
+
+// Exec executes the query.
+func (u *GenColumnUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GenColumnCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GenColumnUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GenColumnUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GenColumnUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GenColumnCreateBulk is the builder for creating many GenColumn entities in bulk.
+type GenColumnCreateBulk struct {
+	config
+	err      error
+	builders []*GenColumnCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GenColumn entities in the database.
+func (_c *GenColumnCreateBulk) Save(ctx context.Context) ([]*GenColumn, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GenColumn, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GenColumnMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GenColumnCreateBulk) SaveX(ctx context.Context) []*GenColumn {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GenColumnCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GenColumnCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GenColumn.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GenColumnUpsert) {
+//			SetTableID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GenColumnCreateBulk) OnConflict(opts ...sql.ConflictOption) *GenColumnUpsertBulk {
+	_c.conflict = opts
+	return &GenColumnUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GenColumn.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GenColumnCreateBulk) OnConflictColumns(columns ...string) *GenColumnUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GenColumnUpsertBulk{
+		create: _c,
+	}
+}
+
+// GenColumnUpsertBulk is the builder for "upsert"-ing
+// a bulk of GenColumn nodes.
+type GenColumnUpsertBulk struct {
+	create *GenColumnCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GenColumn.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(gencolumn.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GenColumnUpsertBulk) UpdateNewValues() *GenColumnUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(gencolumn.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GenColumn.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GenColumnUpsertBulk) Ignore() *GenColumnUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GenColumnUpsertBulk) DoNothing() *GenColumnUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GenColumnCreateBulk.OnConflict
+// documentation for more info.
+func (u *GenColumnUpsertBulk) Update(set func(*GenColumnUpsert)) *GenColumnUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GenColumnUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTableID sets the "table_id" field.
+func (u *GenColumnUpsertBulk) SetTableID(v int64) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetTableID(v)
+	})
+}
+
+// UpdateTableID sets the "table_id" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateTableID() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateTableID()
+	})
+}
+
+// SetColumnName sets the "column_name" field.
+func (u *GenColumnUpsertBulk) SetColumnName(v string) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetColumnName(v)
+	})
+}
+
+// UpdateColumnName sets the "column_name" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateColumnName() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateColumnName()
+	})
+}
+
+// SetColumnType sets the "column_type" field.
+func (u *GenColumnUpsertBulk) SetColumnType(v string) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetColumnType(v)
+	})
+}
+
+// UpdateColumnType sets the "column_type" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateColumnType() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateColumnType()
+	})
+}
+
+// SetColumnComment sets the "column_comment" field.
+func (u *GenColumnUpsertBulk) SetColumnComment(v string) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetColumnComment(v)
+	})
+}
+
+// UpdateColumnComment sets the "column_comment" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateColumnComment() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateColumnComment()
+	})
+}
+
+// SetIsNullable sets the "is_nullable" field.
+func (u *GenColumnUpsertBulk) SetIsNullable(v bool) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetIsNullable(v)
+	})
+}
+
+// UpdateIsNullable sets the "is_nullable" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateIsNullable() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateIsNullable()
+	})
+}
+
+// SetIsPrimaryKey sets the "is_primary_key" field.
+func (u *GenColumnUpsertBulk) SetIsPrimaryKey(v bool) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetIsPrimaryKey(v)
+	})
+}
+
+// UpdateIsPrimaryKey sets the "is_primary_key" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateIsPrimaryKey() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateIsPrimaryKey()
+	})
+}
+
+// SetGoType sets the "go_type" field.
+func (u *GenColumnUpsertBulk) SetGoType(v string) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetGoType(v)
+	})
+}
+
+// UpdateGoType sets the "go_type" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateGoType() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateGoType()
+	})
+}
+
+// SetJavaType sets the "java_type" field.
+func (u *GenColumnUpsertBulk) SetJavaType(v string) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetJavaType(v)
+	})
+}
+
+// UpdateJavaType sets the "java_type" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateJavaType() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateJavaType()
+	})
+}
+
+// SetFieldName sets the "field_name" field.
+func (u *GenColumnUpsertBulk) SetFieldName(v string) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetFieldName(v)
+	})
+}
+
+// UpdateFieldName sets the "field_name" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateFieldName() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateFieldName()
+	})
+}
+
+// SetHideInList sets the "hide_in_list" field.
+func (u *GenColumnUpsertBulk) SetHideInList(v bool) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetHideInList(v)
+	})
+}
+
+// UpdateHideInList sets the "hide_in_list" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateHideInList() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateHideInList()
+	})
+}
+
+// SetReadOnly sets the "read_only" field.
+func (u *GenColumnUpsertBulk) SetReadOnly(v bool) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetReadOnly(v)
+	})
+}
+
+// UpdateReadOnly sets the "read_only" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateReadOnly() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateReadOnly()
+	})
+}
+
+// SetQueryType sets the "query_type" field.
+func (u *GenColumnUpsertBulk) SetQueryType(v string) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetQueryType(v)
+	})
+}
+
+// UpdateQueryType sets the "query_type" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateQueryType() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateQueryType()
+	})
+}
+
+// SetHTMLType sets the "html_type" field.
+func (u *GenColumnUpsertBulk) SetHTMLType(v string) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetHTMLType(v)
+	})
+}
+
+// UpdateHTMLType sets the "html_type" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateHTMLType() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateHTMLType()
+	})
+}
+
+// SetDictType sets the "dict_type" field.
+func (u *GenColumnUpsertBulk) SetDictType(v string) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetDictType(v)
+	})
+}
+
+// UpdateDictType sets the "dict_type" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateDictType() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateDictType()
+	})
+}
+
+// SetRequired sets the "required" field.
+func (u *GenColumnUpsertBulk) SetRequired(v bool) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetRequired(v)
+	})
+}
+
+// UpdateRequired sets the "required" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateRequired() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateRequired()
+	})
+}
+
+// ClearRequired clears the value of the "required" field.
+func (u *GenColumnUpsertBulk) ClearRequired() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.ClearRequired()
+	})
+}
+
+// SetFill sets the "fill" field.
+func (u *GenColumnUpsertBulk) SetFill(v string) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetFill(v)
+	})
+}
+
+// UpdateFill sets the "fill" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateFill() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateFill()
+	})
+}
+
+// SetLogicDelete sets the "logic_delete" field.
+func (u *GenColumnUpsertBulk) SetLogicDelete(v bool) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetLogicDelete(v)
+	})
+}
+
+// UpdateLogicDelete sets the "logic_delete" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateLogicDelete() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateLogicDelete()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *GenColumnUpsertBulk) SetVersion(v bool) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateVersion() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetLength sets the "length" field.
+func (u *GenColumnUpsertBulk) SetLength(v int) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetLength(v)
+	})
+}
+
+// AddLength adds v to the "length" field.
+func (u *GenColumnUpsertBulk) AddLength(v int) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.AddLength(v)
+	})
+}
+
+// UpdateLength sets the "length" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateLength() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateLength()
+	})
+}
+
+// SetSensitive sets the "sensitive" field.
+func (u *GenColumnUpsertBulk) SetSensitive(v bool) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetSensitive(v)
+	})
+}
+
+// UpdateSensitive sets the "sensitive" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateSensitive() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateSensitive()
+	})
+}
+
+// SetScale sets the "scale" field.
+func (u *GenColumnUpsertBulk) SetScale(v int) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetScale(v)
+	})
+}
+
+// AddScale adds v to the "scale" field.
+func (u *GenColumnUpsertBulk) AddScale(v int) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.AddScale(v)
+	})
+}
+
+// UpdateScale sets the "scale" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateScale() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateScale()
+	})
+}
+
+// SetDefaultValue sets the "default_value" field.
+func (u *GenColumnUpsertBulk) SetDefaultValue(v string) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetDefaultValue(v)
+	})
+}
+
+// UpdateDefaultValue sets the "default_value" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateDefaultValue() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateDefaultValue()
+	})
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (u *GenColumnUpsertBulk) SetEnumValues(v []string) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetEnumValues(v)
+	})
+}
+
+// UpdateEnumValues sets the "enum_values" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateEnumValues() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateEnumValues()
+	})
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (u *GenColumnUpsertBulk) ClearEnumValues() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.ClearEnumValues()
+	})
+}
+
+// SetAutoIncrement sets the "auto_increment" field.
+func (u *GenColumnUpsertBulk) SetAutoIncrement(v bool) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetAutoIncrement(v)
+	})
+}
+
+// UpdateAutoIncrement sets the "auto_increment" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateAutoIncrement() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateAutoIncrement()
+	})
+}
+
+// SetSort sets the "sort" field.
+func (u *GenColumnUpsertBulk) SetSort(v int) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.SetSort(v)
+	})
+}
+
+// AddSort adds v to the "sort" field.
+func (u *GenColumnUpsertBulk) AddSort(v int) *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.AddSort(v)
+	})
+}
+
+// UpdateSort sets the "sort" field to the value that was provided on create.
+func (u *GenColumnUpsertBulk) UpdateSort() *GenColumnUpsertBulk {
+	return u.Update(func(s *GenColumnUpsert) {
+		s.UpdateSort()
+	})
+}
+
+// Exec executes the query.
+func (u *GenColumnUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GenColumnCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GenColumnCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GenColumnUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
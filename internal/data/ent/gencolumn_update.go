@@ -0,0 +1,1237 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"gen_code/internal/data/ent/gencolumn"
+	"gen_code/internal/data/ent/gentable"
+	"gen_code/internal/data/ent/predicate"
+)
+
+// GenColumnUpdate is the builder for updating GenColumn entities.
+type GenColumnUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GenColumnMutation
+}
+
+// Where appends a list predicates to the GenColumnUpdate builder.
+func (_u *GenColumnUpdate) Where(ps ...predicate.GenColumn) *GenColumnUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTableID sets the "table_id" field.
+func (_u *GenColumnUpdate) SetTableID(v int64) *GenColumnUpdate {
+	_u.mutation.SetTableID(v)
+	return _u
+}
+
+// SetNillableTableID sets the "table_id" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableTableID(v *int64) *GenColumnUpdate {
+	if v != nil {
+		_u.SetTableID(*v)
+	}
+	return _u
+}
+
+// SetColumnName sets the "column_name" field.
+func (_u *GenColumnUpdate) SetColumnName(v string) *GenColumnUpdate {
+	_u.mutation.SetColumnName(v)
+	return _u
+}
+
+// SetNillableColumnName sets the "column_name" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableColumnName(v *string) *GenColumnUpdate {
+	if v != nil {
+		_u.SetColumnName(*v)
+	}
+	return _u
+}
+
+// SetColumnType sets the "column_type" field.
+func (_u *GenColumnUpdate) SetColumnType(v string) *GenColumnUpdate {
+	_u.mutation.SetColumnType(v)
+	return _u
+}
+
+// SetNillableColumnType sets the "column_type" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableColumnType(v *string) *GenColumnUpdate {
+	if v != nil {
+		_u.SetColumnType(*v)
+	}
+	return _u
+}
+
+// SetColumnComment sets the "column_comment" field.
+func (_u *GenColumnUpdate) SetColumnComment(v string) *GenColumnUpdate {
+	_u.mutation.SetColumnComment(v)
+	return _u
+}
+
+// SetNillableColumnComment sets the "column_comment" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableColumnComment(v *string) *GenColumnUpdate {
+	if v != nil {
+		_u.SetColumnComment(*v)
+	}
+	return _u
+}
+
+// SetIsNullable sets the "is_nullable" field.
+func (_u *GenColumnUpdate) SetIsNullable(v bool) *GenColumnUpdate {
+	_u.mutation.SetIsNullable(v)
+	return _u
+}
+
+// SetNillableIsNullable sets the "is_nullable" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableIsNullable(v *bool) *GenColumnUpdate {
+	if v != nil {
+		_u.SetIsNullable(*v)
+	}
+	return _u
+}
+
+// SetIsPrimaryKey sets the "is_primary_key" field.
+func (_u *GenColumnUpdate) SetIsPrimaryKey(v bool) *GenColumnUpdate {
+	_u.mutation.SetIsPrimaryKey(v)
+	return _u
+}
+
+// SetNillableIsPrimaryKey sets the "is_primary_key" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableIsPrimaryKey(v *bool) *GenColumnUpdate {
+	if v != nil {
+		_u.SetIsPrimaryKey(*v)
+	}
+	return _u
+}
+
+// SetGoType sets the "go_type" field.
+func (_u *GenColumnUpdate) SetGoType(v string) *GenColumnUpdate {
+	_u.mutation.SetGoType(v)
+	return _u
+}
+
+// SetNillableGoType sets the "go_type" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableGoType(v *string) *GenColumnUpdate {
+	if v != nil {
+		_u.SetGoType(*v)
+	}
+	return _u
+}
+
+// SetJavaType sets the "java_type" field.
+func (_u *GenColumnUpdate) SetJavaType(v string) *GenColumnUpdate {
+	_u.mutation.SetJavaType(v)
+	return _u
+}
+
+// SetNillableJavaType sets the "java_type" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableJavaType(v *string) *GenColumnUpdate {
+	if v != nil {
+		_u.SetJavaType(*v)
+	}
+	return _u
+}
+
+// SetFieldName sets the "field_name" field.
+func (_u *GenColumnUpdate) SetFieldName(v string) *GenColumnUpdate {
+	_u.mutation.SetFieldName(v)
+	return _u
+}
+
+// SetNillableFieldName sets the "field_name" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableFieldName(v *string) *GenColumnUpdate {
+	if v != nil {
+		_u.SetFieldName(*v)
+	}
+	return _u
+}
+
+// SetHideInList sets the "hide_in_list" field.
+func (_u *GenColumnUpdate) SetHideInList(v bool) *GenColumnUpdate {
+	_u.mutation.SetHideInList(v)
+	return _u
+}
+
+// SetNillableHideInList sets the "hide_in_list" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableHideInList(v *bool) *GenColumnUpdate {
+	if v != nil {
+		_u.SetHideInList(*v)
+	}
+	return _u
+}
+
+// SetReadOnly sets the "read_only" field.
+func (_u *GenColumnUpdate) SetReadOnly(v bool) *GenColumnUpdate {
+	_u.mutation.SetReadOnly(v)
+	return _u
+}
+
+// SetNillableReadOnly sets the "read_only" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableReadOnly(v *bool) *GenColumnUpdate {
+	if v != nil {
+		_u.SetReadOnly(*v)
+	}
+	return _u
+}
+
+// SetQueryType sets the "query_type" field.
+func (_u *GenColumnUpdate) SetQueryType(v string) *GenColumnUpdate {
+	_u.mutation.SetQueryType(v)
+	return _u
+}
+
+// SetNillableQueryType sets the "query_type" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableQueryType(v *string) *GenColumnUpdate {
+	if v != nil {
+		_u.SetQueryType(*v)
+	}
+	return _u
+}
+
+// SetHTMLType sets the "html_type" field.
+func (_u *GenColumnUpdate) SetHTMLType(v string) *GenColumnUpdate {
+	_u.mutation.SetHTMLType(v)
+	return _u
+}
+
+// SetNillableHTMLType sets the "html_type" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableHTMLType(v *string) *GenColumnUpdate {
+	if v != nil {
+		_u.SetHTMLType(*v)
+	}
+	return _u
+}
+
+// SetDictType sets the "dict_type" field.
+func (_u *GenColumnUpdate) SetDictType(v string) *GenColumnUpdate {
+	_u.mutation.SetDictType(v)
+	return _u
+}
+
+// SetNillableDictType sets the "dict_type" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableDictType(v *string) *GenColumnUpdate {
+	if v != nil {
+		_u.SetDictType(*v)
+	}
+	return _u
+}
+
+// SetRequired sets the "required" field.
+func (_u *GenColumnUpdate) SetRequired(v bool) *GenColumnUpdate {
+	_u.mutation.SetRequired(v)
+	return _u
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableRequired(v *bool) *GenColumnUpdate {
+	if v != nil {
+		_u.SetRequired(*v)
+	}
+	return _u
+}
+
+// ClearRequired clears the value of the "required" field.
+func (_u *GenColumnUpdate) ClearRequired() *GenColumnUpdate {
+	_u.mutation.ClearRequired()
+	return _u
+}
+
+// SetFill sets the "fill" field.
+func (_u *GenColumnUpdate) SetFill(v string) *GenColumnUpdate {
+	_u.mutation.SetFill(v)
+	return _u
+}
+
+// SetNillableFill sets the "fill" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableFill(v *string) *GenColumnUpdate {
+	if v != nil {
+		_u.SetFill(*v)
+	}
+	return _u
+}
+
+// SetLogicDelete sets the "logic_delete" field.
+func (_u *GenColumnUpdate) SetLogicDelete(v bool) *GenColumnUpdate {
+	_u.mutation.SetLogicDelete(v)
+	return _u
+}
+
+// SetNillableLogicDelete sets the "logic_delete" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableLogicDelete(v *bool) *GenColumnUpdate {
+	if v != nil {
+		_u.SetLogicDelete(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *GenColumnUpdate) SetVersion(v bool) *GenColumnUpdate {
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableVersion(v *bool) *GenColumnUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// SetLength sets the "length" field.
+func (_u *GenColumnUpdate) SetLength(v int) *GenColumnUpdate {
+	_u.mutation.ResetLength()
+	_u.mutation.SetLength(v)
+	return _u
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableLength(v *int) *GenColumnUpdate {
+	if v != nil {
+		_u.SetLength(*v)
+	}
+	return _u
+}
+
+// AddLength adds value to the "length" field.
+func (_u *GenColumnUpdate) AddLength(v int) *GenColumnUpdate {
+	_u.mutation.AddLength(v)
+	return _u
+}
+
+// SetSensitive sets the "sensitive" field.
+func (_u *GenColumnUpdate) SetSensitive(v bool) *GenColumnUpdate {
+	_u.mutation.SetSensitive(v)
+	return _u
+}
+
+// SetNillableSensitive sets the "sensitive" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableSensitive(v *bool) *GenColumnUpdate {
+	if v != nil {
+		_u.SetSensitive(*v)
+	}
+	return _u
+}
+
+// SetScale sets the "scale" field.
+func (_u *GenColumnUpdate) SetScale(v int) *GenColumnUpdate {
+	_u.mutation.ResetScale()
+	_u.mutation.SetScale(v)
+	return _u
+}
+
+// SetNillableScale sets the "scale" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableScale(v *int) *GenColumnUpdate {
+	if v != nil {
+		_u.SetScale(*v)
+	}
+	return _u
+}
+
+// AddScale adds value to the "scale" field.
+func (_u *GenColumnUpdate) AddScale(v int) *GenColumnUpdate {
+	_u.mutation.AddScale(v)
+	return _u
+}
+
+// SetDefaultValue sets the "default_value" field.
+func (_u *GenColumnUpdate) SetDefaultValue(v string) *GenColumnUpdate {
+	_u.mutation.SetDefaultValue(v)
+	return _u
+}
+
+// SetNillableDefaultValue sets the "default_value" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableDefaultValue(v *string) *GenColumnUpdate {
+	if v != nil {
+		_u.SetDefaultValue(*v)
+	}
+	return _u
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (_u *GenColumnUpdate) SetEnumValues(v []string) *GenColumnUpdate {
+	_u.mutation.SetEnumValues(v)
+	return _u
+}
+
+// AppendEnumValues appends value to the "enum_values" field.
+func (_u *GenColumnUpdate) AppendEnumValues(v []string) *GenColumnUpdate {
+	_u.mutation.AppendEnumValues(v)
+	return _u
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (_u *GenColumnUpdate) ClearEnumValues() *GenColumnUpdate {
+	_u.mutation.ClearEnumValues()
+	return _u
+}
+
+// SetAutoIncrement sets the "auto_increment" field.
+func (_u *GenColumnUpdate) SetAutoIncrement(v bool) *GenColumnUpdate {
+	_u.mutation.SetAutoIncrement(v)
+	return _u
+}
+
+// SetNillableAutoIncrement sets the "auto_increment" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableAutoIncrement(v *bool) *GenColumnUpdate {
+	if v != nil {
+		_u.SetAutoIncrement(*v)
+	}
+	return _u
+}
+
+// SetSort sets the "sort" field.
+func (_u *GenColumnUpdate) SetSort(v int) *GenColumnUpdate {
+	_u.mutation.ResetSort()
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *GenColumnUpdate) SetNillableSort(v *int) *GenColumnUpdate {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// AddSort adds value to the "sort" field.
+func (_u *GenColumnUpdate) AddSort(v int) *GenColumnUpdate {
+	_u.mutation.AddSort(v)
+	return _u
+}
+
+// SetGenTableID sets the "gen_table" edge to the GenTable entity by ID.
+func (_u *GenColumnUpdate) SetGenTableID(id int64) *GenColumnUpdate {
+	_u.mutation.SetGenTableID(id)
+	return _u
+}
+
+// SetGenTable sets the "gen_table" edge to the GenTable entity.
+func (_u *GenColumnUpdate) SetGenTable(v *GenTable) *GenColumnUpdate {
+	return _u.SetGenTableID(v.ID)
+}
+
+// Mutation returns the GenColumnMutation object of the builder.
+func (_u *GenColumnUpdate) Mutation() *GenColumnMutation {
+	return _u.mutation
+}
+
+// ClearGenTable clears the "gen_table" edge to the GenTable entity.
+func (_u *GenColumnUpdate) ClearGenTable() *GenColumnUpdate {
+	_u.mutation.ClearGenTable()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GenColumnUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GenColumnUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GenColumnUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GenColumnUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GenColumnUpdate) check() error {
+	if _u.mutation.GenTableCleared() && len(_u.mutation.GenTableIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GenColumn.gen_table"`)
+	}
+	return nil
+}
+
+func (_u *GenColumnUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gencolumn.Table, gencolumn.Columns, sqlgraph.NewFieldSpec(gencolumn.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ColumnName(); ok {
+		_spec.SetField(gencolumn.FieldColumnName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ColumnType(); ok {
+		_spec.SetField(gencolumn.FieldColumnType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ColumnComment(); ok {
+		_spec.SetField(gencolumn.FieldColumnComment, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsNullable(); ok {
+		_spec.SetField(gencolumn.FieldIsNullable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IsPrimaryKey(); ok {
+		_spec.SetField(gencolumn.FieldIsPrimaryKey, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.GoType(); ok {
+		_spec.SetField(gencolumn.FieldGoType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.JavaType(); ok {
+		_spec.SetField(gencolumn.FieldJavaType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FieldName(); ok {
+		_spec.SetField(gencolumn.FieldFieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HideInList(); ok {
+		_spec.SetField(gencolumn.FieldHideInList, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ReadOnly(); ok {
+		_spec.SetField(gencolumn.FieldReadOnly, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.QueryType(); ok {
+		_spec.SetField(gencolumn.FieldQueryType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HTMLType(); ok {
+		_spec.SetField(gencolumn.FieldHTMLType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DictType(); ok {
+		_spec.SetField(gencolumn.FieldDictType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Required(); ok {
+		_spec.SetField(gencolumn.FieldRequired, field.TypeBool, value)
+	}
+	if _u.mutation.RequiredCleared() {
+		_spec.ClearField(gencolumn.FieldRequired, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Fill(); ok {
+		_spec.SetField(gencolumn.FieldFill, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LogicDelete(); ok {
+		_spec.SetField(gencolumn.FieldLogicDelete, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(gencolumn.FieldVersion, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Length(); ok {
+		_spec.SetField(gencolumn.FieldLength, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLength(); ok {
+		_spec.AddField(gencolumn.FieldLength, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Sensitive(); ok {
+		_spec.SetField(gencolumn.FieldSensitive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Scale(); ok {
+		_spec.SetField(gencolumn.FieldScale, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScale(); ok {
+		_spec.AddField(gencolumn.FieldScale, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DefaultValue(); ok {
+		_spec.SetField(gencolumn.FieldDefaultValue, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EnumValues(); ok {
+		_spec.SetField(gencolumn.FieldEnumValues, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEnumValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gencolumn.FieldEnumValues, value)
+		})
+	}
+	if _u.mutation.EnumValuesCleared() {
+		_spec.ClearField(gencolumn.FieldEnumValues, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AutoIncrement(); ok {
+		_spec.SetField(gencolumn.FieldAutoIncrement, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(gencolumn.FieldSort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSort(); ok {
+		_spec.AddField(gencolumn.FieldSort, field.TypeInt, value)
+	}
+	if _u.mutation.GenTableCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gencolumn.GenTableTable,
+			Columns: []string{gencolumn.GenTableColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gentable.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GenTableIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gencolumn.GenTableTable,
+			Columns: []string{gencolumn.GenTableColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gentable.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gencolumn.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GenColumnUpdateOne is the builder for updating a single GenColumn entity.
+type GenColumnUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GenColumnMutation
+}
+
+// SetTableID sets the "table_id" field.
+func (_u *GenColumnUpdateOne) SetTableID(v int64) *GenColumnUpdateOne {
+	_u.mutation.SetTableID(v)
+	return _u
+}
+
+// SetNillableTableID sets the "table_id" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableTableID(v *int64) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetTableID(*v)
+	}
+	return _u
+}
+
+// SetColumnName sets the "column_name" field.
+func (_u *GenColumnUpdateOne) SetColumnName(v string) *GenColumnUpdateOne {
+	_u.mutation.SetColumnName(v)
+	return _u
+}
+
+// SetNillableColumnName sets the "column_name" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableColumnName(v *string) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetColumnName(*v)
+	}
+	return _u
+}
+
+// SetColumnType sets the "column_type" field.
+func (_u *GenColumnUpdateOne) SetColumnType(v string) *GenColumnUpdateOne {
+	_u.mutation.SetColumnType(v)
+	return _u
+}
+
+// SetNillableColumnType sets the "column_type" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableColumnType(v *string) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetColumnType(*v)
+	}
+	return _u
+}
+
+// SetColumnComment sets the "column_comment" field.
+func (_u *GenColumnUpdateOne) SetColumnComment(v string) *GenColumnUpdateOne {
+	_u.mutation.SetColumnComment(v)
+	return _u
+}
+
+// SetNillableColumnComment sets the "column_comment" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableColumnComment(v *string) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetColumnComment(*v)
+	}
+	return _u
+}
+
+// SetIsNullable sets the "is_nullable" field.
+func (_u *GenColumnUpdateOne) SetIsNullable(v bool) *GenColumnUpdateOne {
+	_u.mutation.SetIsNullable(v)
+	return _u
+}
+
+// SetNillableIsNullable sets the "is_nullable" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableIsNullable(v *bool) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetIsNullable(*v)
+	}
+	return _u
+}
+
+// SetIsPrimaryKey sets the "is_primary_key" field.
+func (_u *GenColumnUpdateOne) SetIsPrimaryKey(v bool) *GenColumnUpdateOne {
+	_u.mutation.SetIsPrimaryKey(v)
+	return _u
+}
+
+// SetNillableIsPrimaryKey sets the "is_primary_key" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableIsPrimaryKey(v *bool) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetIsPrimaryKey(*v)
+	}
+	return _u
+}
+
+// SetGoType sets the "go_type" field.
+func (_u *GenColumnUpdateOne) SetGoType(v string) *GenColumnUpdateOne {
+	_u.mutation.SetGoType(v)
+	return _u
+}
+
+// SetNillableGoType sets the "go_type" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableGoType(v *string) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetGoType(*v)
+	}
+	return _u
+}
+
+// SetJavaType sets the "java_type" field.
+func (_u *GenColumnUpdateOne) SetJavaType(v string) *GenColumnUpdateOne {
+	_u.mutation.SetJavaType(v)
+	return _u
+}
+
+// SetNillableJavaType sets the "java_type" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableJavaType(v *string) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetJavaType(*v)
+	}
+	return _u
+}
+
+// SetFieldName sets the "field_name" field.
+func (_u *GenColumnUpdateOne) SetFieldName(v string) *GenColumnUpdateOne {
+	_u.mutation.SetFieldName(v)
+	return _u
+}
+
+// SetNillableFieldName sets the "field_name" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableFieldName(v *string) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetFieldName(*v)
+	}
+	return _u
+}
+
+// SetHideInList sets the "hide_in_list" field.
+func (_u *GenColumnUpdateOne) SetHideInList(v bool) *GenColumnUpdateOne {
+	_u.mutation.SetHideInList(v)
+	return _u
+}
+
+// SetNillableHideInList sets the "hide_in_list" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableHideInList(v *bool) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetHideInList(*v)
+	}
+	return _u
+}
+
+// SetReadOnly sets the "read_only" field.
+func (_u *GenColumnUpdateOne) SetReadOnly(v bool) *GenColumnUpdateOne {
+	_u.mutation.SetReadOnly(v)
+	return _u
+}
+
+// SetNillableReadOnly sets the "read_only" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableReadOnly(v *bool) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetReadOnly(*v)
+	}
+	return _u
+}
+
+// SetQueryType sets the "query_type" field.
+func (_u *GenColumnUpdateOne) SetQueryType(v string) *GenColumnUpdateOne {
+	_u.mutation.SetQueryType(v)
+	return _u
+}
+
+// SetNillableQueryType sets the "query_type" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableQueryType(v *string) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetQueryType(*v)
+	}
+	return _u
+}
+
+// SetHTMLType sets the "html_type" field.
+func (_u *GenColumnUpdateOne) SetHTMLType(v string) *GenColumnUpdateOne {
+	_u.mutation.SetHTMLType(v)
+	return _u
+}
+
+// SetNillableHTMLType sets the "html_type" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableHTMLType(v *string) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetHTMLType(*v)
+	}
+	return _u
+}
+
+// SetDictType sets the "dict_type" field.
+func (_u *GenColumnUpdateOne) SetDictType(v string) *GenColumnUpdateOne {
+	_u.mutation.SetDictType(v)
+	return _u
+}
+
+// SetNillableDictType sets the "dict_type" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableDictType(v *string) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetDictType(*v)
+	}
+	return _u
+}
+
+// SetRequired sets the "required" field.
+func (_u *GenColumnUpdateOne) SetRequired(v bool) *GenColumnUpdateOne {
+	_u.mutation.SetRequired(v)
+	return _u
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableRequired(v *bool) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetRequired(*v)
+	}
+	return _u
+}
+
+// ClearRequired clears the value of the "required" field.
+func (_u *GenColumnUpdateOne) ClearRequired() *GenColumnUpdateOne {
+	_u.mutation.ClearRequired()
+	return _u
+}
+
+// SetFill sets the "fill" field.
+func (_u *GenColumnUpdateOne) SetFill(v string) *GenColumnUpdateOne {
+	_u.mutation.SetFill(v)
+	return _u
+}
+
+// SetNillableFill sets the "fill" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableFill(v *string) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetFill(*v)
+	}
+	return _u
+}
+
+// SetLogicDelete sets the "logic_delete" field.
+func (_u *GenColumnUpdateOne) SetLogicDelete(v bool) *GenColumnUpdateOne {
+	_u.mutation.SetLogicDelete(v)
+	return _u
+}
+
+// SetNillableLogicDelete sets the "logic_delete" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableLogicDelete(v *bool) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetLogicDelete(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *GenColumnUpdateOne) SetVersion(v bool) *GenColumnUpdateOne {
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableVersion(v *bool) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// SetLength sets the "length" field.
+func (_u *GenColumnUpdateOne) SetLength(v int) *GenColumnUpdateOne {
+	_u.mutation.ResetLength()
+	_u.mutation.SetLength(v)
+	return _u
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableLength(v *int) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetLength(*v)
+	}
+	return _u
+}
+
+// AddLength adds value to the "length" field.
+func (_u *GenColumnUpdateOne) AddLength(v int) *GenColumnUpdateOne {
+	_u.mutation.AddLength(v)
+	return _u
+}
+
+// SetSensitive sets the "sensitive" field.
+func (_u *GenColumnUpdateOne) SetSensitive(v bool) *GenColumnUpdateOne {
+	_u.mutation.SetSensitive(v)
+	return _u
+}
+
+// SetNillableSensitive sets the "sensitive" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableSensitive(v *bool) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetSensitive(*v)
+	}
+	return _u
+}
+
+// SetScale sets the "scale" field.
+func (_u *GenColumnUpdateOne) SetScale(v int) *GenColumnUpdateOne {
+	_u.mutation.ResetScale()
+	_u.mutation.SetScale(v)
+	return _u
+}
+
+// SetNillableScale sets the "scale" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableScale(v *int) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetScale(*v)
+	}
+	return _u
+}
+
+// AddScale adds value to the "scale" field.
+func (_u *GenColumnUpdateOne) AddScale(v int) *GenColumnUpdateOne {
+	_u.mutation.AddScale(v)
+	return _u
+}
+
+// SetDefaultValue sets the "default_value" field.
+func (_u *GenColumnUpdateOne) SetDefaultValue(v string) *GenColumnUpdateOne {
+	_u.mutation.SetDefaultValue(v)
+	return _u
+}
+
+// SetNillableDefaultValue sets the "default_value" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableDefaultValue(v *string) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetDefaultValue(*v)
+	}
+	return _u
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (_u *GenColumnUpdateOne) SetEnumValues(v []string) *GenColumnUpdateOne {
+	_u.mutation.SetEnumValues(v)
+	return _u
+}
+
+// AppendEnumValues appends value to the "enum_values" field.
+func (_u *GenColumnUpdateOne) AppendEnumValues(v []string) *GenColumnUpdateOne {
+	_u.mutation.AppendEnumValues(v)
+	return _u
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (_u *GenColumnUpdateOne) ClearEnumValues() *GenColumnUpdateOne {
+	_u.mutation.ClearEnumValues()
+	return _u
+}
+
+// SetAutoIncrement sets the "auto_increment" field.
+func (_u *GenColumnUpdateOne) SetAutoIncrement(v bool) *GenColumnUpdateOne {
+	_u.mutation.SetAutoIncrement(v)
+	return _u
+}
+
+// SetNillableAutoIncrement sets the "auto_increment" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableAutoIncrement(v *bool) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetAutoIncrement(*v)
+	}
+	return _u
+}
+
+// SetSort sets the "sort" field.
+func (_u *GenColumnUpdateOne) SetSort(v int) *GenColumnUpdateOne {
+	_u.mutation.ResetSort()
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *GenColumnUpdateOne) SetNillableSort(v *int) *GenColumnUpdateOne {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// AddSort adds value to the "sort" field.
+func (_u *GenColumnUpdateOne) AddSort(v int) *GenColumnUpdateOne {
+	_u.mutation.AddSort(v)
+	return _u
+}
+
+// SetGenTableID sets the "gen_table" edge to the GenTable entity by ID.
+func (_u *GenColumnUpdateOne) SetGenTableID(id int64) *GenColumnUpdateOne {
+	_u.mutation.SetGenTableID(id)
+	return _u
+}
+
+// SetGenTable sets the "gen_table" edge to the GenTable entity.
+func (_u *GenColumnUpdateOne) SetGenTable(v *GenTable) *GenColumnUpdateOne {
+	return _u.SetGenTableID(v.ID)
+}
+
+// Mutation returns the GenColumnMutation object of the builder.
+func (_u *GenColumnUpdateOne) Mutation() *GenColumnMutation {
+	return _u.mutation
+}
+
+// ClearGenTable clears the "gen_table" edge to the GenTable entity.
+func (_u *GenColumnUpdateOne) ClearGenTable() *GenColumnUpdateOne {
+	_u.mutation.ClearGenTable()
+	return _u
+}
+
+// Where appends a list predicates to the GenColumnUpdate builder.
+func (_u *GenColumnUpdateOne) Where(ps ...predicate.GenColumn) *GenColumnUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GenColumnUpdateOne) Select(field string, fields ...string) *GenColumnUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GenColumn entity.
+func (_u *GenColumnUpdateOne) Save(ctx context.Context) (*GenColumn, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GenColumnUpdateOne) SaveX(ctx context.Context) *GenColumn {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GenColumnUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GenColumnUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GenColumnUpdateOne) check() error {
+	if _u.mutation.GenTableCleared() && len(_u.mutation.GenTableIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GenColumn.gen_table"`)
+	}
+	return nil
+}
+
+func (_u *GenColumnUpdateOne) sqlSave(ctx context.Context) (_node *GenColumn, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gencolumn.Table, gencolumn.Columns, sqlgraph.NewFieldSpec(gencolumn.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GenColumn.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gencolumn.FieldID)
+		for _, f := range fields {
+			if !gencolumn.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != gencolumn.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ColumnName(); ok {
+		_spec.SetField(gencolumn.FieldColumnName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ColumnType(); ok {
+		_spec.SetField(gencolumn.FieldColumnType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ColumnComment(); ok {
+		_spec.SetField(gencolumn.FieldColumnComment, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsNullable(); ok {
+		_spec.SetField(gencolumn.FieldIsNullable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IsPrimaryKey(); ok {
+		_spec.SetField(gencolumn.FieldIsPrimaryKey, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.GoType(); ok {
+		_spec.SetField(gencolumn.FieldGoType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.JavaType(); ok {
+		_spec.SetField(gencolumn.FieldJavaType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FieldName(); ok {
+		_spec.SetField(gencolumn.FieldFieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HideInList(); ok {
+		_spec.SetField(gencolumn.FieldHideInList, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ReadOnly(); ok {
+		_spec.SetField(gencolumn.FieldReadOnly, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.QueryType(); ok {
+		_spec.SetField(gencolumn.FieldQueryType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HTMLType(); ok {
+		_spec.SetField(gencolumn.FieldHTMLType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DictType(); ok {
+		_spec.SetField(gencolumn.FieldDictType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Required(); ok {
+		_spec.SetField(gencolumn.FieldRequired, field.TypeBool, value)
+	}
+	if _u.mutation.RequiredCleared() {
+		_spec.ClearField(gencolumn.FieldRequired, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Fill(); ok {
+		_spec.SetField(gencolumn.FieldFill, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LogicDelete(); ok {
+		_spec.SetField(gencolumn.FieldLogicDelete, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(gencolumn.FieldVersion, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Length(); ok {
+		_spec.SetField(gencolumn.FieldLength, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLength(); ok {
+		_spec.AddField(gencolumn.FieldLength, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Sensitive(); ok {
+		_spec.SetField(gencolumn.FieldSensitive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Scale(); ok {
+		_spec.SetField(gencolumn.FieldScale, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScale(); ok {
+		_spec.AddField(gencolumn.FieldScale, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DefaultValue(); ok {
+		_spec.SetField(gencolumn.FieldDefaultValue, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EnumValues(); ok {
+		_spec.SetField(gencolumn.FieldEnumValues, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEnumValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gencolumn.FieldEnumValues, value)
+		})
+	}
+	if _u.mutation.EnumValuesCleared() {
+		_spec.ClearField(gencolumn.FieldEnumValues, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AutoIncrement(); ok {
+		_spec.SetField(gencolumn.FieldAutoIncrement, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(gencolumn.FieldSort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSort(); ok {
+		_spec.AddField(gencolumn.FieldSort, field.TypeInt, value)
+	}
+	if _u.mutation.GenTableCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gencolumn.GenTableTable,
+			Columns: []string{gencolumn.GenTableColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gentable.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GenTableIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gencolumn.GenTableTable,
+			Columns: []string{gencolumn.GenTableColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gentable.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GenColumn{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gencolumn.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gen_code/internal/data/ent/gentable"
+	"gen_code/internal/data/ent/project"
+	"gen_code/pkg/gencode"
+)
+
+// GenTable is the model entity for the GenTable schema.
+type GenTable struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID int64 `json:"project_id,omitempty"`
+	// TableName holds the value of the "table_name" field.
+	TableName string `json:"table_name,omitempty"`
+	// TableComment holds the value of the "table_comment" field.
+	TableComment string `json:"table_comment,omitempty"`
+	// Indexes holds the value of the "indexes" field.
+	Indexes []gencode.Index `json:"indexes,omitempty"`
+	// ForeignKeys holds the value of the "foreign_keys" field.
+	ForeignKeys []gencode.ForeignKey `json:"foreign_keys,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GenTableQuery when eager-loading is set.
+	Edges        GenTableEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GenTableEdges holds the relations/edges for other nodes in the graph.
+type GenTableEdges struct {
+	// Project holds the value of the project edge.
+	Project *Project `json:"project,omitempty"`
+	// Columns holds the value of the columns edge.
+	Columns []*GenColumn `json:"columns,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GenTableEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// ColumnsOrErr returns the Columns value or an error if the edge
+// was not loaded in eager-loading.
+func (e GenTableEdges) ColumnsOrErr() ([]*GenColumn, error) {
+	if e.loadedTypes[1] {
+		return e.Columns, nil
+	}
+	return nil, &NotLoadedError{edge: "columns"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GenTable) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case gentable.FieldIndexes, gentable.FieldForeignKeys:
+			values[i] = new([]byte)
+		case gentable.FieldID, gentable.FieldProjectID:
+			values[i] = new(sql.NullInt64)
+		case gentable.FieldTableName, gentable.FieldTableComment:
+			values[i] = new(sql.NullString)
+		case gentable.FieldCreateTime, gentable.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GenTable fields.
+func (_m *GenTable) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case gentable.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case gentable.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				_m.ProjectID = value.Int64
+			}
+		case gentable.FieldTableName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field table_name", values[i])
+			} else if value.Valid {
+				_m.TableName = value.String
+			}
+		case gentable.FieldTableComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field table_comment", values[i])
+			} else if value.Valid {
+				_m.TableComment = value.String
+			}
+		case gentable.FieldIndexes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field indexes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Indexes); err != nil {
+					return fmt.Errorf("unmarshal field indexes: %w", err)
+				}
+			}
+		case gentable.FieldForeignKeys:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field foreign_keys", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ForeignKeys); err != nil {
+					return fmt.Errorf("unmarshal field foreign_keys: %w", err)
+				}
+			}
+		case gentable.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case gentable.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GenTable.
+// This includes values selected through modifiers, order, etc.
+func (_m *GenTable) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the GenTable entity.
+func (_m *GenTable) QueryProject() *ProjectQuery {
+	return NewGenTableClient(_m.config).QueryProject(_m)
+}
+
+// QueryColumns queries the "columns" edge of the GenTable entity.
+func (_m *GenTable) QueryColumns() *GenColumnQuery {
+	return NewGenTableClient(_m.config).QueryColumns(_m)
+}
+
+// Update returns a builder for updating this GenTable.
+// Note that you need to call GenTable.Unwrap() before calling this method if this GenTable
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GenTable) Update() *GenTableUpdateOne {
+	return NewGenTableClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GenTable entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GenTable) Unwrap() *GenTable {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GenTable is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GenTable) String() string {
+	var builder strings.Builder
+	builder.WriteString("GenTable(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("table_name=")
+	builder.WriteString(_m.TableName)
+	builder.WriteString(", ")
+	builder.WriteString("table_comment=")
+	builder.WriteString(_m.TableComment)
+	builder.WriteString(", ")
+	builder.WriteString("indexes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Indexes))
+	builder.WriteString(", ")
+	builder.WriteString("foreign_keys=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ForeignKeys))
+	builder.WriteString(", ")
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GenTables is a parsable slice of GenTable.
+type GenTables []*GenTable
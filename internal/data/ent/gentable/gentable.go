@@ -0,0 +1,154 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gentable
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the gentable type in the database.
+	Label = "gen_table"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProjectID holds the string denoting the project_id field in the database.
+	FieldProjectID = "project_id"
+	// FieldTableName holds the string denoting the table_name field in the database.
+	FieldTableName = "table_name"
+	// FieldTableComment holds the string denoting the table_comment field in the database.
+	FieldTableComment = "table_comment"
+	// FieldIndexes holds the string denoting the indexes field in the database.
+	FieldIndexes = "indexes"
+	// FieldForeignKeys holds the string denoting the foreign_keys field in the database.
+	FieldForeignKeys = "foreign_keys"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// EdgeProject holds the string denoting the project edge name in mutations.
+	EdgeProject = "project"
+	// EdgeColumns holds the string denoting the columns edge name in mutations.
+	EdgeColumns = "columns"
+	// Table holds the table name of the gentable in the database.
+	Table = "gen_tables"
+	// ProjectTable is the table that holds the project relation/edge.
+	ProjectTable = "gen_tables"
+	// ProjectInverseTable is the table name for the Project entity.
+	// It exists in this package in order to avoid circular dependency with the "project" package.
+	ProjectInverseTable = "projects"
+	// ProjectColumn is the table column denoting the project relation/edge.
+	ProjectColumn = "project_id"
+	// ColumnsTable is the table that holds the columns relation/edge.
+	ColumnsTable = "gen_columns"
+	// ColumnsInverseTable is the table name for the GenColumn entity.
+	// It exists in this package in order to avoid circular dependency with the "gencolumn" package.
+	ColumnsInverseTable = "gen_columns"
+	// ColumnsColumn is the table column denoting the columns relation/edge.
+	ColumnsColumn = "table_id"
+)
+
+// Columns holds all SQL columns for gentable fields.
+var Columns = []string{
+	FieldID,
+	FieldProjectID,
+	FieldTableName,
+	FieldTableComment,
+	FieldIndexes,
+	FieldForeignKeys,
+	FieldCreateTime,
+	FieldUpdateTime,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTableName holds the default value on creation for the "table_name" field.
+	DefaultTableName string
+	// DefaultTableComment holds the default value on creation for the "table_comment" field.
+	DefaultTableComment string
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+)
+
+// OrderOption defines the ordering options for the GenTable queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProjectID orders the results by the project_id field.
+func ByProjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectID, opts...).ToFunc()
+}
+
+// ByTableName orders the results by the table_name field.
+func ByTableName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTableName, opts...).ToFunc()
+}
+
+// ByTableComment orders the results by the table_comment field.
+func ByTableComment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTableComment, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByProjectField orders the results by project field.
+func ByProjectField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProjectStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByColumnsCount orders the results by columns count.
+func ByColumnsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newColumnsStep(), opts...)
+	}
+}
+
+// ByColumns orders the results by columns terms.
+func ByColumns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newColumnsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newProjectStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProjectInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+	)
+}
+func newColumnsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ColumnsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ColumnsTable, ColumnsColumn),
+	)
+}
@@ -0,0 +1,392 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gentable
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"gen_code/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.GenTable {
+	return predicate.GenTable(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.GenTable {
+	return predicate.GenTable(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.GenTable {
+	return predicate.GenTable(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.GenTable {
+	return predicate.GenTable(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.GenTable {
+	return predicate.GenTable(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.GenTable {
+	return predicate.GenTable(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.GenTable {
+	return predicate.GenTable(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.GenTable {
+	return predicate.GenTable(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.GenTable {
+	return predicate.GenTable(sql.FieldLTE(FieldID, id))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v int64) predicate.GenTable {
+	return predicate.GenTable(sql.FieldEQ(FieldProjectID, v))
+}
+
+// TableName applies equality check predicate on the "table_name" field. It's identical to TableNameEQ.
+func TableName(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldEQ(FieldTableName, v))
+}
+
+// TableComment applies equality check predicate on the "table_comment" field. It's identical to TableCommentEQ.
+func TableComment(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldEQ(FieldTableComment, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.GenTable {
+	return predicate.GenTable(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.GenTable {
+	return predicate.GenTable(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v int64) predicate.GenTable {
+	return predicate.GenTable(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v int64) predicate.GenTable {
+	return predicate.GenTable(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...int64) predicate.GenTable {
+	return predicate.GenTable(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...int64) predicate.GenTable {
+	return predicate.GenTable(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// TableNameEQ applies the EQ predicate on the "table_name" field.
+func TableNameEQ(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldEQ(FieldTableName, v))
+}
+
+// TableNameNEQ applies the NEQ predicate on the "table_name" field.
+func TableNameNEQ(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldNEQ(FieldTableName, v))
+}
+
+// TableNameIn applies the In predicate on the "table_name" field.
+func TableNameIn(vs ...string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldIn(FieldTableName, vs...))
+}
+
+// TableNameNotIn applies the NotIn predicate on the "table_name" field.
+func TableNameNotIn(vs ...string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldNotIn(FieldTableName, vs...))
+}
+
+// TableNameGT applies the GT predicate on the "table_name" field.
+func TableNameGT(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldGT(FieldTableName, v))
+}
+
+// TableNameGTE applies the GTE predicate on the "table_name" field.
+func TableNameGTE(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldGTE(FieldTableName, v))
+}
+
+// TableNameLT applies the LT predicate on the "table_name" field.
+func TableNameLT(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldLT(FieldTableName, v))
+}
+
+// TableNameLTE applies the LTE predicate on the "table_name" field.
+func TableNameLTE(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldLTE(FieldTableName, v))
+}
+
+// TableNameContains applies the Contains predicate on the "table_name" field.
+func TableNameContains(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldContains(FieldTableName, v))
+}
+
+// TableNameHasPrefix applies the HasPrefix predicate on the "table_name" field.
+func TableNameHasPrefix(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldHasPrefix(FieldTableName, v))
+}
+
+// TableNameHasSuffix applies the HasSuffix predicate on the "table_name" field.
+func TableNameHasSuffix(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldHasSuffix(FieldTableName, v))
+}
+
+// TableNameEqualFold applies the EqualFold predicate on the "table_name" field.
+func TableNameEqualFold(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldEqualFold(FieldTableName, v))
+}
+
+// TableNameContainsFold applies the ContainsFold predicate on the "table_name" field.
+func TableNameContainsFold(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldContainsFold(FieldTableName, v))
+}
+
+// TableCommentEQ applies the EQ predicate on the "table_comment" field.
+func TableCommentEQ(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldEQ(FieldTableComment, v))
+}
+
+// TableCommentNEQ applies the NEQ predicate on the "table_comment" field.
+func TableCommentNEQ(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldNEQ(FieldTableComment, v))
+}
+
+// TableCommentIn applies the In predicate on the "table_comment" field.
+func TableCommentIn(vs ...string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldIn(FieldTableComment, vs...))
+}
+
+// TableCommentNotIn applies the NotIn predicate on the "table_comment" field.
+func TableCommentNotIn(vs ...string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldNotIn(FieldTableComment, vs...))
+}
+
+// TableCommentGT applies the GT predicate on the "table_comment" field.
+func TableCommentGT(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldGT(FieldTableComment, v))
+}
+
+// TableCommentGTE applies the GTE predicate on the "table_comment" field.
+func TableCommentGTE(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldGTE(FieldTableComment, v))
+}
+
+// TableCommentLT applies the LT predicate on the "table_comment" field.
+func TableCommentLT(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldLT(FieldTableComment, v))
+}
+
+// TableCommentLTE applies the LTE predicate on the "table_comment" field.
+func TableCommentLTE(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldLTE(FieldTableComment, v))
+}
+
+// TableCommentContains applies the Contains predicate on the "table_comment" field.
+func TableCommentContains(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldContains(FieldTableComment, v))
+}
+
+// TableCommentHasPrefix applies the HasPrefix predicate on the "table_comment" field.
+func TableCommentHasPrefix(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldHasPrefix(FieldTableComment, v))
+}
+
+// TableCommentHasSuffix applies the HasSuffix predicate on the "table_comment" field.
+func TableCommentHasSuffix(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldHasSuffix(FieldTableComment, v))
+}
+
+// TableCommentEqualFold applies the EqualFold predicate on the "table_comment" field.
+func TableCommentEqualFold(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldEqualFold(FieldTableComment, v))
+}
+
+// TableCommentContainsFold applies the ContainsFold predicate on the "table_comment" field.
+func TableCommentContainsFold(v string) predicate.GenTable {
+	return predicate.GenTable(sql.FieldContainsFold(FieldTableComment, v))
+}
+
+// IndexesIsNil applies the IsNil predicate on the "indexes" field.
+func IndexesIsNil() predicate.GenTable {
+	return predicate.GenTable(sql.FieldIsNull(FieldIndexes))
+}
+
+// IndexesNotNil applies the NotNil predicate on the "indexes" field.
+func IndexesNotNil() predicate.GenTable {
+	return predicate.GenTable(sql.FieldNotNull(FieldIndexes))
+}
+
+// ForeignKeysIsNil applies the IsNil predicate on the "foreign_keys" field.
+func ForeignKeysIsNil() predicate.GenTable {
+	return predicate.GenTable(sql.FieldIsNull(FieldForeignKeys))
+}
+
+// ForeignKeysNotNil applies the NotNil predicate on the "foreign_keys" field.
+func ForeignKeysNotNil() predicate.GenTable {
+	return predicate.GenTable(sql.FieldNotNull(FieldForeignKeys))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.GenTable {
+	return predicate.GenTable(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.GenTable {
+	return predicate.GenTable(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.GenTable {
+	return predicate.GenTable(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.GenTable {
+	return predicate.GenTable(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.GenTable {
+	return predicate.GenTable(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.GenTable {
+	return predicate.GenTable(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.GenTable {
+	return predicate.GenTable(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.GenTable {
+	return predicate.GenTable(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.GenTable {
+	return predicate.GenTable(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.GenTable {
+	return predicate.GenTable(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.GenTable {
+	return predicate.GenTable(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.GenTable {
+	return predicate.GenTable(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.GenTable {
+	return predicate.GenTable(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.GenTable {
+	return predicate.GenTable(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.GenTable {
+	return predicate.GenTable(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.GenTable {
+	return predicate.GenTable(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.GenTable {
+	return predicate.GenTable(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.GenTable {
+	return predicate.GenTable(func(s *sql.Selector) {
+		step := newProjectStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasColumns applies the HasEdge predicate on the "columns" edge.
+func HasColumns() predicate.GenTable {
+	return predicate.GenTable(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ColumnsTable, ColumnsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasColumnsWith applies the HasEdge predicate on the "columns" edge with a given conditions (other predicates).
+func HasColumnsWith(preds ...predicate.GenColumn) predicate.GenTable {
+	return predicate.GenTable(func(s *sql.Selector) {
+		step := newColumnsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GenTable) predicate.GenTable {
+	return predicate.GenTable(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GenTable) predicate.GenTable {
+	return predicate.GenTable(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GenTable) predicate.GenTable {
+	return predicate.GenTable(sql.NotPredicates(p))
+}
@@ -0,0 +1,912 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gen_code/internal/data/ent/gencolumn"
+	"gen_code/internal/data/ent/gentable"
+	"gen_code/internal/data/ent/project"
+	"gen_code/pkg/gencode"
+)
+
+// GenTableCreate is the builder for creating a GenTable entity.
+type GenTableCreate struct {
+	config
+	mutation *GenTableMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetProjectID sets the "project_id" field.
+func (_c *GenTableCreate) SetProjectID(v int64) *GenTableCreate {
+	_c.mutation.SetProjectID(v)
+	return _c
+}
+
+// SetTableName sets the "table_name" field.
+func (_c *GenTableCreate) SetTableName(v string) *GenTableCreate {
+	_c.mutation.SetTableName(v)
+	return _c
+}
+
+// SetNillableTableName sets the "table_name" field if the given value is not nil.
+func (_c *GenTableCreate) SetNillableTableName(v *string) *GenTableCreate {
+	if v != nil {
+		_c.SetTableName(*v)
+	}
+	return _c
+}
+
+// SetTableComment sets the "table_comment" field.
+func (_c *GenTableCreate) SetTableComment(v string) *GenTableCreate {
+	_c.mutation.SetTableComment(v)
+	return _c
+}
+
+// SetNillableTableComment sets the "table_comment" field if the given value is not nil.
+func (_c *GenTableCreate) SetNillableTableComment(v *string) *GenTableCreate {
+	if v != nil {
+		_c.SetTableComment(*v)
+	}
+	return _c
+}
+
+// SetIndexes sets the "indexes" field.
+func (_c *GenTableCreate) SetIndexes(v []gencode.Index) *GenTableCreate {
+	_c.mutation.SetIndexes(v)
+	return _c
+}
+
+// SetForeignKeys sets the "foreign_keys" field.
+func (_c *GenTableCreate) SetForeignKeys(v []gencode.ForeignKey) *GenTableCreate {
+	_c.mutation.SetForeignKeys(v)
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *GenTableCreate) SetCreateTime(v time.Time) *GenTableCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *GenTableCreate) SetNillableCreateTime(v *time.Time) *GenTableCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *GenTableCreate) SetUpdateTime(v time.Time) *GenTableCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *GenTableCreate) SetNillableUpdateTime(v *time.Time) *GenTableCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GenTableCreate) SetID(v int64) *GenTableCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_c *GenTableCreate) SetProject(v *Project) *GenTableCreate {
+	return _c.SetProjectID(v.ID)
+}
+
+// AddColumnIDs adds the "columns" edge to the GenColumn entity by IDs.
+func (_c *GenTableCreate) AddColumnIDs(ids ...int64) *GenTableCreate {
+	_c.mutation.AddColumnIDs(ids...)
+	return _c
+}
+
+// AddColumns adds the "columns" edges to the GenColumn entity.
+func (_c *GenTableCreate) AddColumns(v ...*GenColumn) *GenTableCreate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddColumnIDs(ids...)
+}
+
+// Mutation returns the GenTableMutation object of the builder.
+func (_c *GenTableCreate) Mutation() *GenTableMutation {
+	return _c.mutation
+}
+
+// Save creates the GenTable in the database.
+func (_c *GenTableCreate) Save(ctx context.Context) (*GenTable, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GenTableCreate) SaveX(ctx context.Context) *GenTable {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GenTableCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GenTableCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GenTableCreate) defaults() {
+	if _, ok := _c.mutation.TableName(); !ok {
+		v := gentable.DefaultTableName
+		_c.mutation.SetTableName(v)
+	}
+	if _, ok := _c.mutation.TableComment(); !ok {
+		v := gentable.DefaultTableComment
+		_c.mutation.SetTableComment(v)
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := gentable.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := gentable.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GenTableCreate) check() error {
+	if _, ok := _c.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "GenTable.project_id"`)}
+	}
+	if _, ok := _c.mutation.TableName(); !ok {
+		return &ValidationError{Name: "table_name", err: errors.New(`ent: missing required field "GenTable.table_name"`)}
+	}
+	if _, ok := _c.mutation.TableComment(); !ok {
+		return &ValidationError{Name: "table_comment", err: errors.New(`ent: missing required field "GenTable.table_comment"`)}
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "GenTable.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "GenTable.update_time"`)}
+	}
+	if len(_c.mutation.ProjectIDs()) == 0 {
+		return &ValidationError{Name: "project", err: errors.New(`ent: missing required edge "GenTable.project"`)}
+	}
+	return nil
+}
+
+func (_c *GenTableCreate) sqlSave(ctx context.Context) (*GenTable, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GenTableCreate) createSpec() (*GenTable, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GenTable{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(gentable.Table, sqlgraph.NewFieldSpec(gentable.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.TableName(); ok {
+		_spec.SetField(gentable.FieldTableName, field.TypeString, value)
+		_node.TableName = value
+	}
+	if value, ok := _c.mutation.TableComment(); ok {
+		_spec.SetField(gentable.FieldTableComment, field.TypeString, value)
+		_node.TableComment = value
+	}
+	if value, ok := _c.mutation.Indexes(); ok {
+		_spec.SetField(gentable.FieldIndexes, field.TypeJSON, value)
+		_node.Indexes = value
+	}
+	if value, ok := _c.mutation.ForeignKeys(); ok {
+		_spec.SetField(gentable.FieldForeignKeys, field.TypeJSON, value)
+		_node.ForeignKeys = value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(gentable.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(gentable.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if nodes := _c.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gentable.ProjectTable,
+			Columns: []string{gentable.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ColumnsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gentable.ColumnsTable,
+			Columns: []string{gentable.ColumnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gencolumn.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GenTable.Create().
+//		SetProjectID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GenTableUpsert) {
+//			SetProjectID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GenTableCreate) OnConflict(opts ...sql.ConflictOption) *GenTableUpsertOne {
+	_c.conflict = opts
+	return &GenTableUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GenTable.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GenTableCreate) OnConflictColumns(columns ...string) *GenTableUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GenTableUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GenTableUpsertOne is the builder for "upsert"-ing
+	//  one GenTable node.
+	GenTableUpsertOne struct {
+		create *GenTableCreate
+	}
+
+	// GenTableUpsert is the "OnConflict" setter.
+	GenTableUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProjectID sets the "project_id" field.
+func (u *GenTableUpsert) SetProjectID(v int64) *GenTableUpsert {
+	u.Set(gentable.FieldProjectID, v)
+	return u
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *GenTableUpsert) UpdateProjectID() *GenTableUpsert {
+	u.SetExcluded(gentable.FieldProjectID)
+	return u
+}
+
+// SetTableName sets the "table_name" field.
+func (u *GenTableUpsert) SetTableName(v string) *GenTableUpsert {
+	u.Set(gentable.FieldTableName, v)
+	return u
+}
+
+// UpdateTableName sets the "table_name" field to the value that was provided on create.
+func (u *GenTableUpsert) UpdateTableName() *GenTableUpsert {
+	u.SetExcluded(gentable.FieldTableName)
+	return u
+}
+
+// SetTableComment sets the "table_comment" field.
+func (u *GenTableUpsert) SetTableComment(v string) *GenTableUpsert {
+	u.Set(gentable.FieldTableComment, v)
+	return u
+}
+
+// UpdateTableComment sets the "table_comment" field to the value that was provided on create.
+func (u *GenTableUpsert) UpdateTableComment() *GenTableUpsert {
+	u.SetExcluded(gentable.FieldTableComment)
+	return u
+}
+
+// SetIndexes sets the "indexes" field.
+func (u *GenTableUpsert) SetIndexes(v []gencode.Index) *GenTableUpsert {
+	u.Set(gentable.FieldIndexes, v)
+	return u
+}
+
+// UpdateIndexes sets the "indexes" field to the value that was provided on create.
+func (u *GenTableUpsert) UpdateIndexes() *GenTableUpsert {
+	u.SetExcluded(gentable.FieldIndexes)
+	return u
+}
+
+// ClearIndexes clears the value of the "indexes" field.
+func (u *GenTableUpsert) ClearIndexes() *GenTableUpsert {
+	u.SetNull(gentable.FieldIndexes)
+	return u
+}
+
+// SetForeignKeys sets the "foreign_keys" field.
+func (u *GenTableUpsert) SetForeignKeys(v []gencode.ForeignKey) *GenTableUpsert {
+	u.Set(gentable.FieldForeignKeys, v)
+	return u
+}
+
+// UpdateForeignKeys sets the "foreign_keys" field to the value that was provided on create.
+func (u *GenTableUpsert) UpdateForeignKeys() *GenTableUpsert {
+	u.SetExcluded(gentable.FieldForeignKeys)
+	return u
+}
+
+// ClearForeignKeys clears the value of the "foreign_keys" field.
+func (u *GenTableUpsert) ClearForeignKeys() *GenTableUpsert {
+	u.SetNull(gentable.FieldForeignKeys)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *GenTableUpsert) SetUpdateTime(v time.Time) *GenTableUpsert {
+	u.Set(gentable.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *GenTableUpsert) UpdateUpdateTime() *GenTableUpsert {
+	u.SetExcluded(gentable.FieldUpdateTime)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GenTable.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(gentable.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GenTableUpsertOne) UpdateNewValues() *GenTableUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(gentable.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(gentable.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GenTable.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GenTableUpsertOne) Ignore() *GenTableUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GenTableUpsertOne) DoNothing() *GenTableUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GenTableCreate.OnConflict
+// documentation for more info.
+func (u *GenTableUpsertOne) Update(set func(*GenTableUpsert)) *GenTableUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GenTableUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *GenTableUpsertOne) SetProjectID(v int64) *GenTableUpsertOne {
+	return u.Update(func(s *GenTableUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *GenTableUpsertOne) UpdateProjectID() *GenTableUpsertOne {
+	return u.Update(func(s *GenTableUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// SetTableName sets the "table_name" field.
+func (u *GenTableUpsertOne) SetTableName(v string) *GenTableUpsertOne {
+	return u.Update(func(s *GenTableUpsert) {
+		s.SetTableName(v)
+	})
+}
+
+// UpdateTableName sets the "table_name" field to the value that was provided on create.
+func (u *GenTableUpsertOne) UpdateTableName() *GenTableUpsertOne {
+	return u.Update(func(s *GenTableUpsert) {
+		s.UpdateTableName()
+	})
+}
+
+// SetTableComment sets the "table_comment" field.
+func (u *GenTableUpsertOne) SetTableComment(v string) *GenTableUpsertOne {
+	return u.Update(func(s *GenTableUpsert) {
+		s.SetTableComment(v)
+	})
+}
+
+// UpdateTableComment sets the "table_comment" field to the value that was provided on create.
+func (u *GenTableUpsertOne) UpdateTableComment() *GenTableUpsertOne {
+	return u.Update(func(s *GenTableUpsert) {
+		s.UpdateTableComment()
+	})
+}
+
+// SetIndexes sets the "indexes" field.
+func (u *GenTableUpsertOne) SetIndexes(v []gencode.Index) *GenTableUpsertOne {
+	return u.Update(func(s *GenTableUpsert) {
+		s.SetIndexes(v)
+	})
+}
+
+// UpdateIndexes sets the "indexes" field to the value that was provided on create.
+func (u *GenTableUpsertOne) UpdateIndexes() *GenTableUpsertOne {
+	return u.Update(func(s *GenTableUpsert) {
+		s.UpdateIndexes()
+	})
+}
+
+// ClearIndexes clears the value of the "indexes" field.
+func (u *GenTableUpsertOne) ClearIndexes() *GenTableUpsertOne {
+	return u.Update(func(s *GenTableUpsert) {
+		s.ClearIndexes()
+	})
+}
+
+// SetForeignKeys sets the "foreign_keys" field.
+func (u *GenTableUpsertOne) SetForeignKeys(v []gencode.ForeignKey) *GenTableUpsertOne {
+	return u.Update(func(s *GenTableUpsert) {
+		s.SetForeignKeys(v)
+	})
+}
+
+// UpdateForeignKeys sets the "foreign_keys" field to the value that was provided on create.
+func (u *GenTableUpsertOne) UpdateForeignKeys() *GenTableUpsertOne {
+	return u.Update(func(s *GenTableUpsert) {
+		s.UpdateForeignKeys()
+	})
+}
+
+// ClearForeignKeys clears the value of the "foreign_keys" field.
+func (u *GenTableUpsertOne) ClearForeignKeys() *GenTableUpsertOne {
+	return u.Update(func(s *GenTableUpsert) {
+		s.ClearForeignKeys()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *GenTableUpsertOne) SetUpdateTime(v time.Time) *GenTableUpsertOne {
+	return u.Update(func(s *GenTableUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *GenTableUpsertOne) UpdateUpdateTime() *GenTableUpsertOne {
+	return u.Update(func(s *GenTableUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// Exec executes the query.
+func (u *GenTableUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GenTableCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GenTableUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GenTableUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GenTableUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GenTableCreateBulk is the builder for creating many GenTable entities in bulk.
+type GenTableCreateBulk struct {
+	config
+	err      error
+	builders []*GenTableCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GenTable entities in the database.
+func (_c *GenTableCreateBulk) Save(ctx context.Context) ([]*GenTable, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GenTable, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GenTableMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GenTableCreateBulk) SaveX(ctx context.Context) []*GenTable {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GenTableCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GenTableCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GenTable.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GenTableUpsert) {
+//			SetProjectID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GenTableCreateBulk) OnConflict(opts ...sql.ConflictOption) *GenTableUpsertBulk {
+	_c.conflict = opts
+	return &GenTableUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GenTable.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GenTableCreateBulk) OnConflictColumns(columns ...string) *GenTableUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GenTableUpsertBulk{
+		create: _c,
+	}
+}
+
+// GenTableUpsertBulk is the builder for "upsert"-ing
+// a bulk of GenTable nodes.
+type GenTableUpsertBulk struct {
+	create *GenTableCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GenTable.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(gentable.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GenTableUpsertBulk) UpdateNewValues() *GenTableUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(gentable.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(gentable.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GenTable.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GenTableUpsertBulk) Ignore() *GenTableUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GenTableUpsertBulk) DoNothing() *GenTableUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GenTableCreateBulk.OnConflict
+// documentation for more info.
+func (u *GenTableUpsertBulk) Update(set func(*GenTableUpsert)) *GenTableUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GenTableUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *GenTableUpsertBulk) SetProjectID(v int64) *GenTableUpsertBulk {
+	return u.Update(func(s *GenTableUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *GenTableUpsertBulk) UpdateProjectID() *GenTableUpsertBulk {
+	return u.Update(func(s *GenTableUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// SetTableName sets the "table_name" field.
+func (u *GenTableUpsertBulk) SetTableName(v string) *GenTableUpsertBulk {
+	return u.Update(func(s *GenTableUpsert) {
+		s.SetTableName(v)
+	})
+}
+
+// UpdateTableName sets the "table_name" field to the value that was provided on create.
+func (u *GenTableUpsertBulk) UpdateTableName() *GenTableUpsertBulk {
+	return u.Update(func(s *GenTableUpsert) {
+		s.UpdateTableName()
+	})
+}
+
+// SetTableComment sets the "table_comment" field.
+func (u *GenTableUpsertBulk) SetTableComment(v string) *GenTableUpsertBulk {
+	return u.Update(func(s *GenTableUpsert) {
+		s.SetTableComment(v)
+	})
+}
+
+// UpdateTableComment sets the "table_comment" field to the value that was provided on create.
+func (u *GenTableUpsertBulk) UpdateTableComment() *GenTableUpsertBulk {
+	return u.Update(func(s *GenTableUpsert) {
+		s.UpdateTableComment()
+	})
+}
+
+// SetIndexes sets the "indexes" field.
+func (u *GenTableUpsertBulk) SetIndexes(v []gencode.Index) *GenTableUpsertBulk {
+	return u.Update(func(s *GenTableUpsert) {
+		s.SetIndexes(v)
+	})
+}
+
+// UpdateIndexes sets the "indexes" field to the value that was provided on create.
+func (u *GenTableUpsertBulk) UpdateIndexes() *GenTableUpsertBulk {
+	return u.Update(func(s *GenTableUpsert) {
+		s.UpdateIndexes()
+	})
+}
+
+// ClearIndexes clears the value of the "indexes" field.
+func (u *GenTableUpsertBulk) ClearIndexes() *GenTableUpsertBulk {
+	return u.Update(func(s *GenTableUpsert) {
+		s.ClearIndexes()
+	})
+}
+
+// SetForeignKeys sets the "foreign_keys" field.
+func (u *GenTableUpsertBulk) SetForeignKeys(v []gencode.ForeignKey) *GenTableUpsertBulk {
+	return u.Update(func(s *GenTableUpsert) {
+		s.SetForeignKeys(v)
+	})
+}
+
+// UpdateForeignKeys sets the "foreign_keys" field to the value that was provided on create.
+func (u *GenTableUpsertBulk) UpdateForeignKeys() *GenTableUpsertBulk {
+	return u.Update(func(s *GenTableUpsert) {
+		s.UpdateForeignKeys()
+	})
+}
+
+// ClearForeignKeys clears the value of the "foreign_keys" field.
+func (u *GenTableUpsertBulk) ClearForeignKeys() *GenTableUpsertBulk {
+	return u.Update(func(s *GenTableUpsert) {
+		s.ClearForeignKeys()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *GenTableUpsertBulk) SetUpdateTime(v time.Time) *GenTableUpsertBulk {
+	return u.Update(func(s *GenTableUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *GenTableUpsertBulk) UpdateUpdateTime() *GenTableUpsertBulk {
+	return u.Update(func(s *GenTableUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// Exec executes the query.
+func (u *GenTableUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GenTableCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GenTableCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GenTableUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
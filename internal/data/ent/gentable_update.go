@@ -0,0 +1,692 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"gen_code/internal/data/ent/gencolumn"
+	"gen_code/internal/data/ent/gentable"
+	"gen_code/internal/data/ent/predicate"
+	"gen_code/internal/data/ent/project"
+	"gen_code/pkg/gencode"
+)
+
+// GenTableUpdate is the builder for updating GenTable entities.
+type GenTableUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GenTableMutation
+}
+
+// Where appends a list predicates to the GenTableUpdate builder.
+func (_u *GenTableUpdate) Where(ps ...predicate.GenTable) *GenTableUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetProjectID sets the "project_id" field.
+func (_u *GenTableUpdate) SetProjectID(v int64) *GenTableUpdate {
+	_u.mutation.SetProjectID(v)
+	return _u
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_u *GenTableUpdate) SetNillableProjectID(v *int64) *GenTableUpdate {
+	if v != nil {
+		_u.SetProjectID(*v)
+	}
+	return _u
+}
+
+// SetTableName sets the "table_name" field.
+func (_u *GenTableUpdate) SetTableName(v string) *GenTableUpdate {
+	_u.mutation.SetTableName(v)
+	return _u
+}
+
+// SetNillableTableName sets the "table_name" field if the given value is not nil.
+func (_u *GenTableUpdate) SetNillableTableName(v *string) *GenTableUpdate {
+	if v != nil {
+		_u.SetTableName(*v)
+	}
+	return _u
+}
+
+// SetTableComment sets the "table_comment" field.
+func (_u *GenTableUpdate) SetTableComment(v string) *GenTableUpdate {
+	_u.mutation.SetTableComment(v)
+	return _u
+}
+
+// SetNillableTableComment sets the "table_comment" field if the given value is not nil.
+func (_u *GenTableUpdate) SetNillableTableComment(v *string) *GenTableUpdate {
+	if v != nil {
+		_u.SetTableComment(*v)
+	}
+	return _u
+}
+
+// SetIndexes sets the "indexes" field.
+func (_u *GenTableUpdate) SetIndexes(v []gencode.Index) *GenTableUpdate {
+	_u.mutation.SetIndexes(v)
+	return _u
+}
+
+// AppendIndexes appends value to the "indexes" field.
+func (_u *GenTableUpdate) AppendIndexes(v []gencode.Index) *GenTableUpdate {
+	_u.mutation.AppendIndexes(v)
+	return _u
+}
+
+// ClearIndexes clears the value of the "indexes" field.
+func (_u *GenTableUpdate) ClearIndexes() *GenTableUpdate {
+	_u.mutation.ClearIndexes()
+	return _u
+}
+
+// SetForeignKeys sets the "foreign_keys" field.
+func (_u *GenTableUpdate) SetForeignKeys(v []gencode.ForeignKey) *GenTableUpdate {
+	_u.mutation.SetForeignKeys(v)
+	return _u
+}
+
+// AppendForeignKeys appends value to the "foreign_keys" field.
+func (_u *GenTableUpdate) AppendForeignKeys(v []gencode.ForeignKey) *GenTableUpdate {
+	_u.mutation.AppendForeignKeys(v)
+	return _u
+}
+
+// ClearForeignKeys clears the value of the "foreign_keys" field.
+func (_u *GenTableUpdate) ClearForeignKeys() *GenTableUpdate {
+	_u.mutation.ClearForeignKeys()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *GenTableUpdate) SetUpdateTime(v time.Time) *GenTableUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_u *GenTableUpdate) SetProject(v *Project) *GenTableUpdate {
+	return _u.SetProjectID(v.ID)
+}
+
+// AddColumnIDs adds the "columns" edge to the GenColumn entity by IDs.
+func (_u *GenTableUpdate) AddColumnIDs(ids ...int64) *GenTableUpdate {
+	_u.mutation.AddColumnIDs(ids...)
+	return _u
+}
+
+// AddColumns adds the "columns" edges to the GenColumn entity.
+func (_u *GenTableUpdate) AddColumns(v ...*GenColumn) *GenTableUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddColumnIDs(ids...)
+}
+
+// Mutation returns the GenTableMutation object of the builder.
+func (_u *GenTableUpdate) Mutation() *GenTableMutation {
+	return _u.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (_u *GenTableUpdate) ClearProject() *GenTableUpdate {
+	_u.mutation.ClearProject()
+	return _u
+}
+
+// ClearColumns clears all "columns" edges to the GenColumn entity.
+func (_u *GenTableUpdate) ClearColumns() *GenTableUpdate {
+	_u.mutation.ClearColumns()
+	return _u
+}
+
+// RemoveColumnIDs removes the "columns" edge to GenColumn entities by IDs.
+func (_u *GenTableUpdate) RemoveColumnIDs(ids ...int64) *GenTableUpdate {
+	_u.mutation.RemoveColumnIDs(ids...)
+	return _u
+}
+
+// RemoveColumns removes "columns" edges to GenColumn entities.
+func (_u *GenTableUpdate) RemoveColumns(v ...*GenColumn) *GenTableUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveColumnIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GenTableUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GenTableUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GenTableUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GenTableUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GenTableUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := gentable.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GenTableUpdate) check() error {
+	if _u.mutation.ProjectCleared() && len(_u.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GenTable.project"`)
+	}
+	return nil
+}
+
+func (_u *GenTableUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gentable.Table, gentable.Columns, sqlgraph.NewFieldSpec(gentable.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TableName(); ok {
+		_spec.SetField(gentable.FieldTableName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TableComment(); ok {
+		_spec.SetField(gentable.FieldTableComment, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Indexes(); ok {
+		_spec.SetField(gentable.FieldIndexes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIndexes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gentable.FieldIndexes, value)
+		})
+	}
+	if _u.mutation.IndexesCleared() {
+		_spec.ClearField(gentable.FieldIndexes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ForeignKeys(); ok {
+		_spec.SetField(gentable.FieldForeignKeys, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedForeignKeys(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gentable.FieldForeignKeys, value)
+		})
+	}
+	if _u.mutation.ForeignKeysCleared() {
+		_spec.ClearField(gentable.FieldForeignKeys, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(gentable.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gentable.ProjectTable,
+			Columns: []string{gentable.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gentable.ProjectTable,
+			Columns: []string{gentable.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ColumnsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gentable.ColumnsTable,
+			Columns: []string{gentable.ColumnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gencolumn.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedColumnsIDs(); len(nodes) > 0 && !_u.mutation.ColumnsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gentable.ColumnsTable,
+			Columns: []string{gentable.ColumnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gencolumn.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ColumnsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gentable.ColumnsTable,
+			Columns: []string{gentable.ColumnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gencolumn.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gentable.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GenTableUpdateOne is the builder for updating a single GenTable entity.
+type GenTableUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GenTableMutation
+}
+
+// SetProjectID sets the "project_id" field.
+func (_u *GenTableUpdateOne) SetProjectID(v int64) *GenTableUpdateOne {
+	_u.mutation.SetProjectID(v)
+	return _u
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_u *GenTableUpdateOne) SetNillableProjectID(v *int64) *GenTableUpdateOne {
+	if v != nil {
+		_u.SetProjectID(*v)
+	}
+	return _u
+}
+
+// SetTableName sets the "table_name" field.
+func (_u *GenTableUpdateOne) SetTableName(v string) *GenTableUpdateOne {
+	_u.mutation.SetTableName(v)
+	return _u
+}
+
+// SetNillableTableName sets the "table_name" field if the given value is not nil.
+func (_u *GenTableUpdateOne) SetNillableTableName(v *string) *GenTableUpdateOne {
+	if v != nil {
+		_u.SetTableName(*v)
+	}
+	return _u
+}
+
+// SetTableComment sets the "table_comment" field.
+func (_u *GenTableUpdateOne) SetTableComment(v string) *GenTableUpdateOne {
+	_u.mutation.SetTableComment(v)
+	return _u
+}
+
+// SetNillableTableComment sets the "table_comment" field if the given value is not nil.
+func (_u *GenTableUpdateOne) SetNillableTableComment(v *string) *GenTableUpdateOne {
+	if v != nil {
+		_u.SetTableComment(*v)
+	}
+	return _u
+}
+
+// SetIndexes sets the "indexes" field.
+func (_u *GenTableUpdateOne) SetIndexes(v []gencode.Index) *GenTableUpdateOne {
+	_u.mutation.SetIndexes(v)
+	return _u
+}
+
+// AppendIndexes appends value to the "indexes" field.
+func (_u *GenTableUpdateOne) AppendIndexes(v []gencode.Index) *GenTableUpdateOne {
+	_u.mutation.AppendIndexes(v)
+	return _u
+}
+
+// ClearIndexes clears the value of the "indexes" field.
+func (_u *GenTableUpdateOne) ClearIndexes() *GenTableUpdateOne {
+	_u.mutation.ClearIndexes()
+	return _u
+}
+
+// SetForeignKeys sets the "foreign_keys" field.
+func (_u *GenTableUpdateOne) SetForeignKeys(v []gencode.ForeignKey) *GenTableUpdateOne {
+	_u.mutation.SetForeignKeys(v)
+	return _u
+}
+
+// AppendForeignKeys appends value to the "foreign_keys" field.
+func (_u *GenTableUpdateOne) AppendForeignKeys(v []gencode.ForeignKey) *GenTableUpdateOne {
+	_u.mutation.AppendForeignKeys(v)
+	return _u
+}
+
+// ClearForeignKeys clears the value of the "foreign_keys" field.
+func (_u *GenTableUpdateOne) ClearForeignKeys() *GenTableUpdateOne {
+	_u.mutation.ClearForeignKeys()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *GenTableUpdateOne) SetUpdateTime(v time.Time) *GenTableUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_u *GenTableUpdateOne) SetProject(v *Project) *GenTableUpdateOne {
+	return _u.SetProjectID(v.ID)
+}
+
+// AddColumnIDs adds the "columns" edge to the GenColumn entity by IDs.
+func (_u *GenTableUpdateOne) AddColumnIDs(ids ...int64) *GenTableUpdateOne {
+	_u.mutation.AddColumnIDs(ids...)
+	return _u
+}
+
+// AddColumns adds the "columns" edges to the GenColumn entity.
+func (_u *GenTableUpdateOne) AddColumns(v ...*GenColumn) *GenTableUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddColumnIDs(ids...)
+}
+
+// Mutation returns the GenTableMutation object of the builder.
+func (_u *GenTableUpdateOne) Mutation() *GenTableMutation {
+	return _u.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (_u *GenTableUpdateOne) ClearProject() *GenTableUpdateOne {
+	_u.mutation.ClearProject()
+	return _u
+}
+
+// ClearColumns clears all "columns" edges to the GenColumn entity.
+func (_u *GenTableUpdateOne) ClearColumns() *GenTableUpdateOne {
+	_u.mutation.ClearColumns()
+	return _u
+}
+
+// RemoveColumnIDs removes the "columns" edge to GenColumn entities by IDs.
+func (_u *GenTableUpdateOne) RemoveColumnIDs(ids ...int64) *GenTableUpdateOne {
+	_u.mutation.RemoveColumnIDs(ids...)
+	return _u
+}
+
+// RemoveColumns removes "columns" edges to GenColumn entities.
+func (_u *GenTableUpdateOne) RemoveColumns(v ...*GenColumn) *GenTableUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveColumnIDs(ids...)
+}
+
+// Where appends a list predicates to the GenTableUpdate builder.
+func (_u *GenTableUpdateOne) Where(ps ...predicate.GenTable) *GenTableUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GenTableUpdateOne) Select(field string, fields ...string) *GenTableUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GenTable entity.
+func (_u *GenTableUpdateOne) Save(ctx context.Context) (*GenTable, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GenTableUpdateOne) SaveX(ctx context.Context) *GenTable {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GenTableUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GenTableUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GenTableUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := gentable.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GenTableUpdateOne) check() error {
+	if _u.mutation.ProjectCleared() && len(_u.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GenTable.project"`)
+	}
+	return nil
+}
+
+func (_u *GenTableUpdateOne) sqlSave(ctx context.Context) (_node *GenTable, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gentable.Table, gentable.Columns, sqlgraph.NewFieldSpec(gentable.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GenTable.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gentable.FieldID)
+		for _, f := range fields {
+			if !gentable.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != gentable.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TableName(); ok {
+		_spec.SetField(gentable.FieldTableName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TableComment(); ok {
+		_spec.SetField(gentable.FieldTableComment, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Indexes(); ok {
+		_spec.SetField(gentable.FieldIndexes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIndexes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gentable.FieldIndexes, value)
+		})
+	}
+	if _u.mutation.IndexesCleared() {
+		_spec.ClearField(gentable.FieldIndexes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ForeignKeys(); ok {
+		_spec.SetField(gentable.FieldForeignKeys, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedForeignKeys(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gentable.FieldForeignKeys, value)
+		})
+	}
+	if _u.mutation.ForeignKeysCleared() {
+		_spec.ClearField(gentable.FieldForeignKeys, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(gentable.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gentable.ProjectTable,
+			Columns: []string{gentable.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gentable.ProjectTable,
+			Columns: []string{gentable.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ColumnsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gentable.ColumnsTable,
+			Columns: []string{gentable.ColumnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gencolumn.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedColumnsIDs(); len(nodes) > 0 && !_u.mutation.ColumnsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gentable.ColumnsTable,
+			Columns: []string{gentable.ColumnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gencolumn.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ColumnsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   gentable.ColumnsTable,
+			Columns: []string{gentable.ColumnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gencolumn.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GenTable{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gentable.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
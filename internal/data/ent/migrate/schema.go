@@ -25,11 +25,109 @@ var (
 		Columns:    AdminsColumns,
 		PrimaryKey: []*schema.Column{AdminsColumns[0]},
 	}
+	// GenColumnsColumns holds the columns for the "gen_columns" table.
+	GenColumnsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "column_name", Type: field.TypeString, Default: ""},
+		{Name: "column_type", Type: field.TypeString, Default: ""},
+		{Name: "column_comment", Type: field.TypeString, Default: ""},
+		{Name: "is_nullable", Type: field.TypeBool, Default: false},
+		{Name: "is_primary_key", Type: field.TypeBool, Default: false},
+		{Name: "go_type", Type: field.TypeString, Default: ""},
+		{Name: "java_type", Type: field.TypeString, Default: ""},
+		{Name: "field_name", Type: field.TypeString, Default: ""},
+		{Name: "hide_in_list", Type: field.TypeBool, Default: false},
+		{Name: "read_only", Type: field.TypeBool, Default: false},
+		{Name: "query_type", Type: field.TypeString, Default: ""},
+		{Name: "html_type", Type: field.TypeString, Default: ""},
+		{Name: "dict_type", Type: field.TypeString, Default: ""},
+		{Name: "required", Type: field.TypeBool, Nullable: true},
+		{Name: "fill", Type: field.TypeString, Default: ""},
+		{Name: "logic_delete", Type: field.TypeBool, Default: false},
+		{Name: "version", Type: field.TypeBool, Default: false},
+		{Name: "length", Type: field.TypeInt, Default: 0},
+		{Name: "sensitive", Type: field.TypeBool, Default: false},
+		{Name: "scale", Type: field.TypeInt, Default: 0},
+		{Name: "default_value", Type: field.TypeString, Default: ""},
+		{Name: "enum_values", Type: field.TypeJSON, Nullable: true},
+		{Name: "auto_increment", Type: field.TypeBool, Default: false},
+		{Name: "sort", Type: field.TypeInt, Default: 0},
+		{Name: "table_id", Type: field.TypeInt64},
+	}
+	// GenColumnsTable holds the schema information for the "gen_columns" table.
+	GenColumnsTable = &schema.Table{
+		Name:       "gen_columns",
+		Columns:    GenColumnsColumns,
+		PrimaryKey: []*schema.Column{GenColumnsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "gen_columns_gen_tables_columns",
+				Columns:    []*schema.Column{GenColumnsColumns[25]},
+				RefColumns: []*schema.Column{GenTablesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// GenTablesColumns holds the columns for the "gen_tables" table.
+	GenTablesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "table_name", Type: field.TypeString, Default: ""},
+		{Name: "table_comment", Type: field.TypeString, Default: ""},
+		{Name: "indexes", Type: field.TypeJSON, Nullable: true},
+		{Name: "foreign_keys", Type: field.TypeJSON, Nullable: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "project_id", Type: field.TypeInt64},
+	}
+	// GenTablesTable holds the schema information for the "gen_tables" table.
+	GenTablesTable = &schema.Table{
+		Name:       "gen_tables",
+		Columns:    GenTablesColumns,
+		PrimaryKey: []*schema.Column{GenTablesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "gen_tables_projects_tables",
+				Columns:    []*schema.Column{GenTablesColumns[7]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "gentable_project_id_table_name",
+				Unique:  true,
+				Columns: []*schema.Column{GenTablesColumns[7], GenTablesColumns[1]},
+			},
+		},
+	}
+	// ProjectsColumns holds the columns for the "projects" table.
+	ProjectsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "name", Type: field.TypeString, Default: ""},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "gen_config", Type: field.TypeJSON, Nullable: true},
+		{Name: "package_config", Type: field.TypeJSON, Nullable: true},
+		{Name: "deploy_config", Type: field.TypeJSON, Nullable: true},
+		{Name: "vars", Type: field.TypeJSON, Nullable: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+	}
+	// ProjectsTable holds the schema information for the "projects" table.
+	ProjectsTable = &schema.Table{
+		Name:       "projects",
+		Columns:    ProjectsColumns,
+		PrimaryKey: []*schema.Column{ProjectsColumns[0]},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AdminsTable,
+		GenColumnsTable,
+		GenTablesTable,
+		ProjectsTable,
 	}
 )
 
 func init() {
+	GenColumnsTable.ForeignKeys[0].RefTable = GenTablesTable
+	GenTablesTable.ForeignKeys[0].RefTable = ProjectsTable
 }
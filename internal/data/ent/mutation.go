@@ -12,7 +12,11 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"gen_code/internal/data/ent/admin"
+	"gen_code/internal/data/ent/gencolumn"
+	"gen_code/internal/data/ent/gentable"
 	"gen_code/internal/data/ent/predicate"
+	"gen_code/internal/data/ent/project"
+	"gen_code/pkg/gencode"
 )
 
 const (
@@ -24,7 +28,10 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAdmin = "Admin"
+	TypeAdmin     = "Admin"
+	TypeGenColumn = "GenColumn"
+	TypeGenTable  = "GenTable"
+	TypeProject   = "Project"
 )
 
 // AdminMutation represents an operation that mutates the Admin nodes in the graph.
@@ -682,3 +689,3613 @@ func (m *AdminMutation) ClearEdge(name string) error {
 func (m *AdminMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Admin edge %s", name)
 }
+
+// GenColumnMutation represents an operation that mutates the GenColumn nodes in the graph.
+type GenColumnMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int64
+	column_name       *string
+	column_type       *string
+	column_comment    *string
+	is_nullable       *bool
+	is_primary_key    *bool
+	go_type           *string
+	java_type         *string
+	field_name        *string
+	hide_in_list      *bool
+	read_only         *bool
+	query_type        *string
+	html_type         *string
+	dict_type         *string
+	required          *bool
+	fill              *string
+	logic_delete      *bool
+	version           *bool
+	length            *int
+	addlength         *int
+	sensitive         *bool
+	scale             *int
+	addscale          *int
+	default_value     *string
+	enum_values       *[]string
+	appendenum_values []string
+	auto_increment    *bool
+	sort              *int
+	addsort           *int
+	clearedFields     map[string]struct{}
+	gen_table         *int64
+	clearedgen_table  bool
+	done              bool
+	oldValue          func(context.Context) (*GenColumn, error)
+	predicates        []predicate.GenColumn
+}
+
+var _ ent.Mutation = (*GenColumnMutation)(nil)
+
+// gencolumnOption allows management of the mutation configuration using functional options.
+type gencolumnOption func(*GenColumnMutation)
+
+// newGenColumnMutation creates new mutation for the GenColumn entity.
+func newGenColumnMutation(c config, op Op, opts ...gencolumnOption) *GenColumnMutation {
+	m := &GenColumnMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGenColumn,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGenColumnID sets the ID field of the mutation.
+func withGenColumnID(id int64) gencolumnOption {
+	return func(m *GenColumnMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GenColumn
+		)
+		m.oldValue = func(ctx context.Context) (*GenColumn, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GenColumn.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGenColumn sets the old GenColumn of the mutation.
+func withGenColumn(node *GenColumn) gencolumnOption {
+	return func(m *GenColumnMutation) {
+		m.oldValue = func(context.Context) (*GenColumn, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GenColumnMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GenColumnMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GenColumn entities.
+func (m *GenColumnMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GenColumnMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GenColumnMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GenColumn.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTableID sets the "table_id" field.
+func (m *GenColumnMutation) SetTableID(i int64) {
+	m.gen_table = &i
+}
+
+// TableID returns the value of the "table_id" field in the mutation.
+func (m *GenColumnMutation) TableID() (r int64, exists bool) {
+	v := m.gen_table
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTableID returns the old "table_id" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldTableID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTableID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTableID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTableID: %w", err)
+	}
+	return oldValue.TableID, nil
+}
+
+// ResetTableID resets all changes to the "table_id" field.
+func (m *GenColumnMutation) ResetTableID() {
+	m.gen_table = nil
+}
+
+// SetColumnName sets the "column_name" field.
+func (m *GenColumnMutation) SetColumnName(s string) {
+	m.column_name = &s
+}
+
+// ColumnName returns the value of the "column_name" field in the mutation.
+func (m *GenColumnMutation) ColumnName() (r string, exists bool) {
+	v := m.column_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumnName returns the old "column_name" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldColumnName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumnName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumnName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumnName: %w", err)
+	}
+	return oldValue.ColumnName, nil
+}
+
+// ResetColumnName resets all changes to the "column_name" field.
+func (m *GenColumnMutation) ResetColumnName() {
+	m.column_name = nil
+}
+
+// SetColumnType sets the "column_type" field.
+func (m *GenColumnMutation) SetColumnType(s string) {
+	m.column_type = &s
+}
+
+// ColumnType returns the value of the "column_type" field in the mutation.
+func (m *GenColumnMutation) ColumnType() (r string, exists bool) {
+	v := m.column_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumnType returns the old "column_type" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldColumnType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumnType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumnType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumnType: %w", err)
+	}
+	return oldValue.ColumnType, nil
+}
+
+// ResetColumnType resets all changes to the "column_type" field.
+func (m *GenColumnMutation) ResetColumnType() {
+	m.column_type = nil
+}
+
+// SetColumnComment sets the "column_comment" field.
+func (m *GenColumnMutation) SetColumnComment(s string) {
+	m.column_comment = &s
+}
+
+// ColumnComment returns the value of the "column_comment" field in the mutation.
+func (m *GenColumnMutation) ColumnComment() (r string, exists bool) {
+	v := m.column_comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumnComment returns the old "column_comment" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldColumnComment(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumnComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumnComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumnComment: %w", err)
+	}
+	return oldValue.ColumnComment, nil
+}
+
+// ResetColumnComment resets all changes to the "column_comment" field.
+func (m *GenColumnMutation) ResetColumnComment() {
+	m.column_comment = nil
+}
+
+// SetIsNullable sets the "is_nullable" field.
+func (m *GenColumnMutation) SetIsNullable(b bool) {
+	m.is_nullable = &b
+}
+
+// IsNullable returns the value of the "is_nullable" field in the mutation.
+func (m *GenColumnMutation) IsNullable() (r bool, exists bool) {
+	v := m.is_nullable
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsNullable returns the old "is_nullable" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldIsNullable(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsNullable is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsNullable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsNullable: %w", err)
+	}
+	return oldValue.IsNullable, nil
+}
+
+// ResetIsNullable resets all changes to the "is_nullable" field.
+func (m *GenColumnMutation) ResetIsNullable() {
+	m.is_nullable = nil
+}
+
+// SetIsPrimaryKey sets the "is_primary_key" field.
+func (m *GenColumnMutation) SetIsPrimaryKey(b bool) {
+	m.is_primary_key = &b
+}
+
+// IsPrimaryKey returns the value of the "is_primary_key" field in the mutation.
+func (m *GenColumnMutation) IsPrimaryKey() (r bool, exists bool) {
+	v := m.is_primary_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsPrimaryKey returns the old "is_primary_key" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldIsPrimaryKey(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPrimaryKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPrimaryKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPrimaryKey: %w", err)
+	}
+	return oldValue.IsPrimaryKey, nil
+}
+
+// ResetIsPrimaryKey resets all changes to the "is_primary_key" field.
+func (m *GenColumnMutation) ResetIsPrimaryKey() {
+	m.is_primary_key = nil
+}
+
+// SetGoType sets the "go_type" field.
+func (m *GenColumnMutation) SetGoType(s string) {
+	m.go_type = &s
+}
+
+// GoType returns the value of the "go_type" field in the mutation.
+func (m *GenColumnMutation) GoType() (r string, exists bool) {
+	v := m.go_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGoType returns the old "go_type" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldGoType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGoType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGoType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGoType: %w", err)
+	}
+	return oldValue.GoType, nil
+}
+
+// ResetGoType resets all changes to the "go_type" field.
+func (m *GenColumnMutation) ResetGoType() {
+	m.go_type = nil
+}
+
+// SetJavaType sets the "java_type" field.
+func (m *GenColumnMutation) SetJavaType(s string) {
+	m.java_type = &s
+}
+
+// JavaType returns the value of the "java_type" field in the mutation.
+func (m *GenColumnMutation) JavaType() (r string, exists bool) {
+	v := m.java_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJavaType returns the old "java_type" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldJavaType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJavaType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJavaType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJavaType: %w", err)
+	}
+	return oldValue.JavaType, nil
+}
+
+// ResetJavaType resets all changes to the "java_type" field.
+func (m *GenColumnMutation) ResetJavaType() {
+	m.java_type = nil
+}
+
+// SetFieldName sets the "field_name" field.
+func (m *GenColumnMutation) SetFieldName(s string) {
+	m.field_name = &s
+}
+
+// FieldName returns the value of the "field_name" field in the mutation.
+func (m *GenColumnMutation) FieldName() (r string, exists bool) {
+	v := m.field_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFieldName returns the old "field_name" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldFieldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFieldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFieldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFieldName: %w", err)
+	}
+	return oldValue.FieldName, nil
+}
+
+// ResetFieldName resets all changes to the "field_name" field.
+func (m *GenColumnMutation) ResetFieldName() {
+	m.field_name = nil
+}
+
+// SetHideInList sets the "hide_in_list" field.
+func (m *GenColumnMutation) SetHideInList(b bool) {
+	m.hide_in_list = &b
+}
+
+// HideInList returns the value of the "hide_in_list" field in the mutation.
+func (m *GenColumnMutation) HideInList() (r bool, exists bool) {
+	v := m.hide_in_list
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHideInList returns the old "hide_in_list" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldHideInList(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHideInList is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHideInList requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHideInList: %w", err)
+	}
+	return oldValue.HideInList, nil
+}
+
+// ResetHideInList resets all changes to the "hide_in_list" field.
+func (m *GenColumnMutation) ResetHideInList() {
+	m.hide_in_list = nil
+}
+
+// SetReadOnly sets the "read_only" field.
+func (m *GenColumnMutation) SetReadOnly(b bool) {
+	m.read_only = &b
+}
+
+// ReadOnly returns the value of the "read_only" field in the mutation.
+func (m *GenColumnMutation) ReadOnly() (r bool, exists bool) {
+	v := m.read_only
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadOnly returns the old "read_only" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldReadOnly(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadOnly is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadOnly requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadOnly: %w", err)
+	}
+	return oldValue.ReadOnly, nil
+}
+
+// ResetReadOnly resets all changes to the "read_only" field.
+func (m *GenColumnMutation) ResetReadOnly() {
+	m.read_only = nil
+}
+
+// SetQueryType sets the "query_type" field.
+func (m *GenColumnMutation) SetQueryType(s string) {
+	m.query_type = &s
+}
+
+// QueryType returns the value of the "query_type" field in the mutation.
+func (m *GenColumnMutation) QueryType() (r string, exists bool) {
+	v := m.query_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQueryType returns the old "query_type" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldQueryType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQueryType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQueryType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQueryType: %w", err)
+	}
+	return oldValue.QueryType, nil
+}
+
+// ResetQueryType resets all changes to the "query_type" field.
+func (m *GenColumnMutation) ResetQueryType() {
+	m.query_type = nil
+}
+
+// SetHTMLType sets the "html_type" field.
+func (m *GenColumnMutation) SetHTMLType(s string) {
+	m.html_type = &s
+}
+
+// HTMLType returns the value of the "html_type" field in the mutation.
+func (m *GenColumnMutation) HTMLType() (r string, exists bool) {
+	v := m.html_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHTMLType returns the old "html_type" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldHTMLType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHTMLType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHTMLType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHTMLType: %w", err)
+	}
+	return oldValue.HTMLType, nil
+}
+
+// ResetHTMLType resets all changes to the "html_type" field.
+func (m *GenColumnMutation) ResetHTMLType() {
+	m.html_type = nil
+}
+
+// SetDictType sets the "dict_type" field.
+func (m *GenColumnMutation) SetDictType(s string) {
+	m.dict_type = &s
+}
+
+// DictType returns the value of the "dict_type" field in the mutation.
+func (m *GenColumnMutation) DictType() (r string, exists bool) {
+	v := m.dict_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDictType returns the old "dict_type" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldDictType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDictType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDictType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDictType: %w", err)
+	}
+	return oldValue.DictType, nil
+}
+
+// ResetDictType resets all changes to the "dict_type" field.
+func (m *GenColumnMutation) ResetDictType() {
+	m.dict_type = nil
+}
+
+// SetRequired sets the "required" field.
+func (m *GenColumnMutation) SetRequired(b bool) {
+	m.required = &b
+}
+
+// Required returns the value of the "required" field in the mutation.
+func (m *GenColumnMutation) Required() (r bool, exists bool) {
+	v := m.required
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequired returns the old "required" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldRequired(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequired is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequired requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequired: %w", err)
+	}
+	return oldValue.Required, nil
+}
+
+// ClearRequired clears the value of the "required" field.
+func (m *GenColumnMutation) ClearRequired() {
+	m.required = nil
+	m.clearedFields[gencolumn.FieldRequired] = struct{}{}
+}
+
+// RequiredCleared returns if the "required" field was cleared in this mutation.
+func (m *GenColumnMutation) RequiredCleared() bool {
+	_, ok := m.clearedFields[gencolumn.FieldRequired]
+	return ok
+}
+
+// ResetRequired resets all changes to the "required" field.
+func (m *GenColumnMutation) ResetRequired() {
+	m.required = nil
+	delete(m.clearedFields, gencolumn.FieldRequired)
+}
+
+// SetFill sets the "fill" field.
+func (m *GenColumnMutation) SetFill(s string) {
+	m.fill = &s
+}
+
+// Fill returns the value of the "fill" field in the mutation.
+func (m *GenColumnMutation) Fill() (r string, exists bool) {
+	v := m.fill
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFill returns the old "fill" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldFill(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFill is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFill requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFill: %w", err)
+	}
+	return oldValue.Fill, nil
+}
+
+// ResetFill resets all changes to the "fill" field.
+func (m *GenColumnMutation) ResetFill() {
+	m.fill = nil
+}
+
+// SetLogicDelete sets the "logic_delete" field.
+func (m *GenColumnMutation) SetLogicDelete(b bool) {
+	m.logic_delete = &b
+}
+
+// LogicDelete returns the value of the "logic_delete" field in the mutation.
+func (m *GenColumnMutation) LogicDelete() (r bool, exists bool) {
+	v := m.logic_delete
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogicDelete returns the old "logic_delete" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldLogicDelete(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogicDelete is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogicDelete requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogicDelete: %w", err)
+	}
+	return oldValue.LogicDelete, nil
+}
+
+// ResetLogicDelete resets all changes to the "logic_delete" field.
+func (m *GenColumnMutation) ResetLogicDelete() {
+	m.logic_delete = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *GenColumnMutation) SetVersion(b bool) {
+	m.version = &b
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *GenColumnMutation) Version() (r bool, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldVersion(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *GenColumnMutation) ResetVersion() {
+	m.version = nil
+}
+
+// SetLength sets the "length" field.
+func (m *GenColumnMutation) SetLength(i int) {
+	m.length = &i
+	m.addlength = nil
+}
+
+// Length returns the value of the "length" field in the mutation.
+func (m *GenColumnMutation) Length() (r int, exists bool) {
+	v := m.length
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLength returns the old "length" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldLength(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLength is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLength requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLength: %w", err)
+	}
+	return oldValue.Length, nil
+}
+
+// AddLength adds i to the "length" field.
+func (m *GenColumnMutation) AddLength(i int) {
+	if m.addlength != nil {
+		*m.addlength += i
+	} else {
+		m.addlength = &i
+	}
+}
+
+// AddedLength returns the value that was added to the "length" field in this mutation.
+func (m *GenColumnMutation) AddedLength() (r int, exists bool) {
+	v := m.addlength
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLength resets all changes to the "length" field.
+func (m *GenColumnMutation) ResetLength() {
+	m.length = nil
+	m.addlength = nil
+}
+
+// SetSensitive sets the "sensitive" field.
+func (m *GenColumnMutation) SetSensitive(b bool) {
+	m.sensitive = &b
+}
+
+// Sensitive returns the value of the "sensitive" field in the mutation.
+func (m *GenColumnMutation) Sensitive() (r bool, exists bool) {
+	v := m.sensitive
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSensitive returns the old "sensitive" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldSensitive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSensitive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSensitive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSensitive: %w", err)
+	}
+	return oldValue.Sensitive, nil
+}
+
+// ResetSensitive resets all changes to the "sensitive" field.
+func (m *GenColumnMutation) ResetSensitive() {
+	m.sensitive = nil
+}
+
+// SetScale sets the "scale" field.
+func (m *GenColumnMutation) SetScale(i int) {
+	m.scale = &i
+	m.addscale = nil
+}
+
+// Scale returns the value of the "scale" field in the mutation.
+func (m *GenColumnMutation) Scale() (r int, exists bool) {
+	v := m.scale
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScale returns the old "scale" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldScale(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScale is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScale requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScale: %w", err)
+	}
+	return oldValue.Scale, nil
+}
+
+// AddScale adds i to the "scale" field.
+func (m *GenColumnMutation) AddScale(i int) {
+	if m.addscale != nil {
+		*m.addscale += i
+	} else {
+		m.addscale = &i
+	}
+}
+
+// AddedScale returns the value that was added to the "scale" field in this mutation.
+func (m *GenColumnMutation) AddedScale() (r int, exists bool) {
+	v := m.addscale
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScale resets all changes to the "scale" field.
+func (m *GenColumnMutation) ResetScale() {
+	m.scale = nil
+	m.addscale = nil
+}
+
+// SetDefaultValue sets the "default_value" field.
+func (m *GenColumnMutation) SetDefaultValue(s string) {
+	m.default_value = &s
+}
+
+// DefaultValue returns the value of the "default_value" field in the mutation.
+func (m *GenColumnMutation) DefaultValue() (r string, exists bool) {
+	v := m.default_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultValue returns the old "default_value" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldDefaultValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultValue: %w", err)
+	}
+	return oldValue.DefaultValue, nil
+}
+
+// ResetDefaultValue resets all changes to the "default_value" field.
+func (m *GenColumnMutation) ResetDefaultValue() {
+	m.default_value = nil
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (m *GenColumnMutation) SetEnumValues(s []string) {
+	m.enum_values = &s
+	m.appendenum_values = nil
+}
+
+// EnumValues returns the value of the "enum_values" field in the mutation.
+func (m *GenColumnMutation) EnumValues() (r []string, exists bool) {
+	v := m.enum_values
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnumValues returns the old "enum_values" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldEnumValues(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnumValues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnumValues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnumValues: %w", err)
+	}
+	return oldValue.EnumValues, nil
+}
+
+// AppendEnumValues adds s to the "enum_values" field.
+func (m *GenColumnMutation) AppendEnumValues(s []string) {
+	m.appendenum_values = append(m.appendenum_values, s...)
+}
+
+// AppendedEnumValues returns the list of values that were appended to the "enum_values" field in this mutation.
+func (m *GenColumnMutation) AppendedEnumValues() ([]string, bool) {
+	if len(m.appendenum_values) == 0 {
+		return nil, false
+	}
+	return m.appendenum_values, true
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (m *GenColumnMutation) ClearEnumValues() {
+	m.enum_values = nil
+	m.appendenum_values = nil
+	m.clearedFields[gencolumn.FieldEnumValues] = struct{}{}
+}
+
+// EnumValuesCleared returns if the "enum_values" field was cleared in this mutation.
+func (m *GenColumnMutation) EnumValuesCleared() bool {
+	_, ok := m.clearedFields[gencolumn.FieldEnumValues]
+	return ok
+}
+
+// ResetEnumValues resets all changes to the "enum_values" field.
+func (m *GenColumnMutation) ResetEnumValues() {
+	m.enum_values = nil
+	m.appendenum_values = nil
+	delete(m.clearedFields, gencolumn.FieldEnumValues)
+}
+
+// SetAutoIncrement sets the "auto_increment" field.
+func (m *GenColumnMutation) SetAutoIncrement(b bool) {
+	m.auto_increment = &b
+}
+
+// AutoIncrement returns the value of the "auto_increment" field in the mutation.
+func (m *GenColumnMutation) AutoIncrement() (r bool, exists bool) {
+	v := m.auto_increment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutoIncrement returns the old "auto_increment" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldAutoIncrement(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutoIncrement is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutoIncrement requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutoIncrement: %w", err)
+	}
+	return oldValue.AutoIncrement, nil
+}
+
+// ResetAutoIncrement resets all changes to the "auto_increment" field.
+func (m *GenColumnMutation) ResetAutoIncrement() {
+	m.auto_increment = nil
+}
+
+// SetSort sets the "sort" field.
+func (m *GenColumnMutation) SetSort(i int) {
+	m.sort = &i
+	m.addsort = nil
+}
+
+// Sort returns the value of the "sort" field in the mutation.
+func (m *GenColumnMutation) Sort() (r int, exists bool) {
+	v := m.sort
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSort returns the old "sort" field's value of the GenColumn entity.
+// If the GenColumn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenColumnMutation) OldSort(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSort: %w", err)
+	}
+	return oldValue.Sort, nil
+}
+
+// AddSort adds i to the "sort" field.
+func (m *GenColumnMutation) AddSort(i int) {
+	if m.addsort != nil {
+		*m.addsort += i
+	} else {
+		m.addsort = &i
+	}
+}
+
+// AddedSort returns the value that was added to the "sort" field in this mutation.
+func (m *GenColumnMutation) AddedSort() (r int, exists bool) {
+	v := m.addsort
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSort resets all changes to the "sort" field.
+func (m *GenColumnMutation) ResetSort() {
+	m.sort = nil
+	m.addsort = nil
+}
+
+// SetGenTableID sets the "gen_table" edge to the GenTable entity by id.
+func (m *GenColumnMutation) SetGenTableID(id int64) {
+	m.gen_table = &id
+}
+
+// ClearGenTable clears the "gen_table" edge to the GenTable entity.
+func (m *GenColumnMutation) ClearGenTable() {
+	m.clearedgen_table = true
+	m.clearedFields[gencolumn.FieldTableID] = struct{}{}
+}
+
+// GenTableCleared reports if the "gen_table" edge to the GenTable entity was cleared.
+func (m *GenColumnMutation) GenTableCleared() bool {
+	return m.clearedgen_table
+}
+
+// GenTableID returns the "gen_table" edge ID in the mutation.
+func (m *GenColumnMutation) GenTableID() (id int64, exists bool) {
+	if m.gen_table != nil {
+		return *m.gen_table, true
+	}
+	return
+}
+
+// GenTableIDs returns the "gen_table" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GenTableID instead. It exists only for internal usage by the builders.
+func (m *GenColumnMutation) GenTableIDs() (ids []int64) {
+	if id := m.gen_table; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGenTable resets all changes to the "gen_table" edge.
+func (m *GenColumnMutation) ResetGenTable() {
+	m.gen_table = nil
+	m.clearedgen_table = false
+}
+
+// Where appends a list predicates to the GenColumnMutation builder.
+func (m *GenColumnMutation) Where(ps ...predicate.GenColumn) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GenColumnMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GenColumnMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GenColumn, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GenColumnMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GenColumnMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GenColumn).
+func (m *GenColumnMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GenColumnMutation) Fields() []string {
+	fields := make([]string, 0, 25)
+	if m.gen_table != nil {
+		fields = append(fields, gencolumn.FieldTableID)
+	}
+	if m.column_name != nil {
+		fields = append(fields, gencolumn.FieldColumnName)
+	}
+	if m.column_type != nil {
+		fields = append(fields, gencolumn.FieldColumnType)
+	}
+	if m.column_comment != nil {
+		fields = append(fields, gencolumn.FieldColumnComment)
+	}
+	if m.is_nullable != nil {
+		fields = append(fields, gencolumn.FieldIsNullable)
+	}
+	if m.is_primary_key != nil {
+		fields = append(fields, gencolumn.FieldIsPrimaryKey)
+	}
+	if m.go_type != nil {
+		fields = append(fields, gencolumn.FieldGoType)
+	}
+	if m.java_type != nil {
+		fields = append(fields, gencolumn.FieldJavaType)
+	}
+	if m.field_name != nil {
+		fields = append(fields, gencolumn.FieldFieldName)
+	}
+	if m.hide_in_list != nil {
+		fields = append(fields, gencolumn.FieldHideInList)
+	}
+	if m.read_only != nil {
+		fields = append(fields, gencolumn.FieldReadOnly)
+	}
+	if m.query_type != nil {
+		fields = append(fields, gencolumn.FieldQueryType)
+	}
+	if m.html_type != nil {
+		fields = append(fields, gencolumn.FieldHTMLType)
+	}
+	if m.dict_type != nil {
+		fields = append(fields, gencolumn.FieldDictType)
+	}
+	if m.required != nil {
+		fields = append(fields, gencolumn.FieldRequired)
+	}
+	if m.fill != nil {
+		fields = append(fields, gencolumn.FieldFill)
+	}
+	if m.logic_delete != nil {
+		fields = append(fields, gencolumn.FieldLogicDelete)
+	}
+	if m.version != nil {
+		fields = append(fields, gencolumn.FieldVersion)
+	}
+	if m.length != nil {
+		fields = append(fields, gencolumn.FieldLength)
+	}
+	if m.sensitive != nil {
+		fields = append(fields, gencolumn.FieldSensitive)
+	}
+	if m.scale != nil {
+		fields = append(fields, gencolumn.FieldScale)
+	}
+	if m.default_value != nil {
+		fields = append(fields, gencolumn.FieldDefaultValue)
+	}
+	if m.enum_values != nil {
+		fields = append(fields, gencolumn.FieldEnumValues)
+	}
+	if m.auto_increment != nil {
+		fields = append(fields, gencolumn.FieldAutoIncrement)
+	}
+	if m.sort != nil {
+		fields = append(fields, gencolumn.FieldSort)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GenColumnMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case gencolumn.FieldTableID:
+		return m.TableID()
+	case gencolumn.FieldColumnName:
+		return m.ColumnName()
+	case gencolumn.FieldColumnType:
+		return m.ColumnType()
+	case gencolumn.FieldColumnComment:
+		return m.ColumnComment()
+	case gencolumn.FieldIsNullable:
+		return m.IsNullable()
+	case gencolumn.FieldIsPrimaryKey:
+		return m.IsPrimaryKey()
+	case gencolumn.FieldGoType:
+		return m.GoType()
+	case gencolumn.FieldJavaType:
+		return m.JavaType()
+	case gencolumn.FieldFieldName:
+		return m.FieldName()
+	case gencolumn.FieldHideInList:
+		return m.HideInList()
+	case gencolumn.FieldReadOnly:
+		return m.ReadOnly()
+	case gencolumn.FieldQueryType:
+		return m.QueryType()
+	case gencolumn.FieldHTMLType:
+		return m.HTMLType()
+	case gencolumn.FieldDictType:
+		return m.DictType()
+	case gencolumn.FieldRequired:
+		return m.Required()
+	case gencolumn.FieldFill:
+		return m.Fill()
+	case gencolumn.FieldLogicDelete:
+		return m.LogicDelete()
+	case gencolumn.FieldVersion:
+		return m.Version()
+	case gencolumn.FieldLength:
+		return m.Length()
+	case gencolumn.FieldSensitive:
+		return m.Sensitive()
+	case gencolumn.FieldScale:
+		return m.Scale()
+	case gencolumn.FieldDefaultValue:
+		return m.DefaultValue()
+	case gencolumn.FieldEnumValues:
+		return m.EnumValues()
+	case gencolumn.FieldAutoIncrement:
+		return m.AutoIncrement()
+	case gencolumn.FieldSort:
+		return m.Sort()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GenColumnMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case gencolumn.FieldTableID:
+		return m.OldTableID(ctx)
+	case gencolumn.FieldColumnName:
+		return m.OldColumnName(ctx)
+	case gencolumn.FieldColumnType:
+		return m.OldColumnType(ctx)
+	case gencolumn.FieldColumnComment:
+		return m.OldColumnComment(ctx)
+	case gencolumn.FieldIsNullable:
+		return m.OldIsNullable(ctx)
+	case gencolumn.FieldIsPrimaryKey:
+		return m.OldIsPrimaryKey(ctx)
+	case gencolumn.FieldGoType:
+		return m.OldGoType(ctx)
+	case gencolumn.FieldJavaType:
+		return m.OldJavaType(ctx)
+	case gencolumn.FieldFieldName:
+		return m.OldFieldName(ctx)
+	case gencolumn.FieldHideInList:
+		return m.OldHideInList(ctx)
+	case gencolumn.FieldReadOnly:
+		return m.OldReadOnly(ctx)
+	case gencolumn.FieldQueryType:
+		return m.OldQueryType(ctx)
+	case gencolumn.FieldHTMLType:
+		return m.OldHTMLType(ctx)
+	case gencolumn.FieldDictType:
+		return m.OldDictType(ctx)
+	case gencolumn.FieldRequired:
+		return m.OldRequired(ctx)
+	case gencolumn.FieldFill:
+		return m.OldFill(ctx)
+	case gencolumn.FieldLogicDelete:
+		return m.OldLogicDelete(ctx)
+	case gencolumn.FieldVersion:
+		return m.OldVersion(ctx)
+	case gencolumn.FieldLength:
+		return m.OldLength(ctx)
+	case gencolumn.FieldSensitive:
+		return m.OldSensitive(ctx)
+	case gencolumn.FieldScale:
+		return m.OldScale(ctx)
+	case gencolumn.FieldDefaultValue:
+		return m.OldDefaultValue(ctx)
+	case gencolumn.FieldEnumValues:
+		return m.OldEnumValues(ctx)
+	case gencolumn.FieldAutoIncrement:
+		return m.OldAutoIncrement(ctx)
+	case gencolumn.FieldSort:
+		return m.OldSort(ctx)
+	}
+	return nil, fmt.Errorf("unknown GenColumn field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GenColumnMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case gencolumn.FieldTableID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTableID(v)
+		return nil
+	case gencolumn.FieldColumnName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumnName(v)
+		return nil
+	case gencolumn.FieldColumnType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumnType(v)
+		return nil
+	case gencolumn.FieldColumnComment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumnComment(v)
+		return nil
+	case gencolumn.FieldIsNullable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsNullable(v)
+		return nil
+	case gencolumn.FieldIsPrimaryKey:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPrimaryKey(v)
+		return nil
+	case gencolumn.FieldGoType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGoType(v)
+		return nil
+	case gencolumn.FieldJavaType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJavaType(v)
+		return nil
+	case gencolumn.FieldFieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFieldName(v)
+		return nil
+	case gencolumn.FieldHideInList:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHideInList(v)
+		return nil
+	case gencolumn.FieldReadOnly:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadOnly(v)
+		return nil
+	case gencolumn.FieldQueryType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQueryType(v)
+		return nil
+	case gencolumn.FieldHTMLType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHTMLType(v)
+		return nil
+	case gencolumn.FieldDictType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDictType(v)
+		return nil
+	case gencolumn.FieldRequired:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequired(v)
+		return nil
+	case gencolumn.FieldFill:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFill(v)
+		return nil
+	case gencolumn.FieldLogicDelete:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogicDelete(v)
+		return nil
+	case gencolumn.FieldVersion:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case gencolumn.FieldLength:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLength(v)
+		return nil
+	case gencolumn.FieldSensitive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSensitive(v)
+		return nil
+	case gencolumn.FieldScale:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScale(v)
+		return nil
+	case gencolumn.FieldDefaultValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultValue(v)
+		return nil
+	case gencolumn.FieldEnumValues:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnumValues(v)
+		return nil
+	case gencolumn.FieldAutoIncrement:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutoIncrement(v)
+		return nil
+	case gencolumn.FieldSort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSort(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GenColumn field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GenColumnMutation) AddedFields() []string {
+	var fields []string
+	if m.addlength != nil {
+		fields = append(fields, gencolumn.FieldLength)
+	}
+	if m.addscale != nil {
+		fields = append(fields, gencolumn.FieldScale)
+	}
+	if m.addsort != nil {
+		fields = append(fields, gencolumn.FieldSort)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GenColumnMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case gencolumn.FieldLength:
+		return m.AddedLength()
+	case gencolumn.FieldScale:
+		return m.AddedScale()
+	case gencolumn.FieldSort:
+		return m.AddedSort()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GenColumnMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case gencolumn.FieldLength:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLength(v)
+		return nil
+	case gencolumn.FieldScale:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScale(v)
+		return nil
+	case gencolumn.FieldSort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSort(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GenColumn numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GenColumnMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(gencolumn.FieldRequired) {
+		fields = append(fields, gencolumn.FieldRequired)
+	}
+	if m.FieldCleared(gencolumn.FieldEnumValues) {
+		fields = append(fields, gencolumn.FieldEnumValues)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GenColumnMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GenColumnMutation) ClearField(name string) error {
+	switch name {
+	case gencolumn.FieldRequired:
+		m.ClearRequired()
+		return nil
+	case gencolumn.FieldEnumValues:
+		m.ClearEnumValues()
+		return nil
+	}
+	return fmt.Errorf("unknown GenColumn nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GenColumnMutation) ResetField(name string) error {
+	switch name {
+	case gencolumn.FieldTableID:
+		m.ResetTableID()
+		return nil
+	case gencolumn.FieldColumnName:
+		m.ResetColumnName()
+		return nil
+	case gencolumn.FieldColumnType:
+		m.ResetColumnType()
+		return nil
+	case gencolumn.FieldColumnComment:
+		m.ResetColumnComment()
+		return nil
+	case gencolumn.FieldIsNullable:
+		m.ResetIsNullable()
+		return nil
+	case gencolumn.FieldIsPrimaryKey:
+		m.ResetIsPrimaryKey()
+		return nil
+	case gencolumn.FieldGoType:
+		m.ResetGoType()
+		return nil
+	case gencolumn.FieldJavaType:
+		m.ResetJavaType()
+		return nil
+	case gencolumn.FieldFieldName:
+		m.ResetFieldName()
+		return nil
+	case gencolumn.FieldHideInList:
+		m.ResetHideInList()
+		return nil
+	case gencolumn.FieldReadOnly:
+		m.ResetReadOnly()
+		return nil
+	case gencolumn.FieldQueryType:
+		m.ResetQueryType()
+		return nil
+	case gencolumn.FieldHTMLType:
+		m.ResetHTMLType()
+		return nil
+	case gencolumn.FieldDictType:
+		m.ResetDictType()
+		return nil
+	case gencolumn.FieldRequired:
+		m.ResetRequired()
+		return nil
+	case gencolumn.FieldFill:
+		m.ResetFill()
+		return nil
+	case gencolumn.FieldLogicDelete:
+		m.ResetLogicDelete()
+		return nil
+	case gencolumn.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case gencolumn.FieldLength:
+		m.ResetLength()
+		return nil
+	case gencolumn.FieldSensitive:
+		m.ResetSensitive()
+		return nil
+	case gencolumn.FieldScale:
+		m.ResetScale()
+		return nil
+	case gencolumn.FieldDefaultValue:
+		m.ResetDefaultValue()
+		return nil
+	case gencolumn.FieldEnumValues:
+		m.ResetEnumValues()
+		return nil
+	case gencolumn.FieldAutoIncrement:
+		m.ResetAutoIncrement()
+		return nil
+	case gencolumn.FieldSort:
+		m.ResetSort()
+		return nil
+	}
+	return fmt.Errorf("unknown GenColumn field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GenColumnMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.gen_table != nil {
+		edges = append(edges, gencolumn.EdgeGenTable)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GenColumnMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case gencolumn.EdgeGenTable:
+		if id := m.gen_table; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GenColumnMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GenColumnMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GenColumnMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedgen_table {
+		edges = append(edges, gencolumn.EdgeGenTable)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GenColumnMutation) EdgeCleared(name string) bool {
+	switch name {
+	case gencolumn.EdgeGenTable:
+		return m.clearedgen_table
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GenColumnMutation) ClearEdge(name string) error {
+	switch name {
+	case gencolumn.EdgeGenTable:
+		m.ClearGenTable()
+		return nil
+	}
+	return fmt.Errorf("unknown GenColumn unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GenColumnMutation) ResetEdge(name string) error {
+	switch name {
+	case gencolumn.EdgeGenTable:
+		m.ResetGenTable()
+		return nil
+	}
+	return fmt.Errorf("unknown GenColumn edge %s", name)
+}
+
+// GenTableMutation represents an operation that mutates the GenTable nodes in the graph.
+type GenTableMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int64
+	table_name         *string
+	table_comment      *string
+	indexes            *[]gencode.Index
+	appendindexes      []gencode.Index
+	foreign_keys       *[]gencode.ForeignKey
+	appendforeign_keys []gencode.ForeignKey
+	create_time        *time.Time
+	update_time        *time.Time
+	clearedFields      map[string]struct{}
+	project            *int64
+	clearedproject     bool
+	columns            map[int64]struct{}
+	removedcolumns     map[int64]struct{}
+	clearedcolumns     bool
+	done               bool
+	oldValue           func(context.Context) (*GenTable, error)
+	predicates         []predicate.GenTable
+}
+
+var _ ent.Mutation = (*GenTableMutation)(nil)
+
+// gentableOption allows management of the mutation configuration using functional options.
+type gentableOption func(*GenTableMutation)
+
+// newGenTableMutation creates new mutation for the GenTable entity.
+func newGenTableMutation(c config, op Op, opts ...gentableOption) *GenTableMutation {
+	m := &GenTableMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGenTable,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGenTableID sets the ID field of the mutation.
+func withGenTableID(id int64) gentableOption {
+	return func(m *GenTableMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GenTable
+		)
+		m.oldValue = func(ctx context.Context) (*GenTable, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GenTable.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGenTable sets the old GenTable of the mutation.
+func withGenTable(node *GenTable) gentableOption {
+	return func(m *GenTableMutation) {
+		m.oldValue = func(context.Context) (*GenTable, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GenTableMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GenTableMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GenTable entities.
+func (m *GenTableMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GenTableMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GenTableMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GenTable.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProjectID sets the "project_id" field.
+func (m *GenTableMutation) SetProjectID(i int64) {
+	m.project = &i
+}
+
+// ProjectID returns the value of the "project_id" field in the mutation.
+func (m *GenTableMutation) ProjectID() (r int64, exists bool) {
+	v := m.project
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProjectID returns the old "project_id" field's value of the GenTable entity.
+// If the GenTable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenTableMutation) OldProjectID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectID: %w", err)
+	}
+	return oldValue.ProjectID, nil
+}
+
+// ResetProjectID resets all changes to the "project_id" field.
+func (m *GenTableMutation) ResetProjectID() {
+	m.project = nil
+}
+
+// SetTableName sets the "table_name" field.
+func (m *GenTableMutation) SetTableName(s string) {
+	m.table_name = &s
+}
+
+// TableName returns the value of the "table_name" field in the mutation.
+func (m *GenTableMutation) TableName() (r string, exists bool) {
+	v := m.table_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTableName returns the old "table_name" field's value of the GenTable entity.
+// If the GenTable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenTableMutation) OldTableName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTableName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTableName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTableName: %w", err)
+	}
+	return oldValue.TableName, nil
+}
+
+// ResetTableName resets all changes to the "table_name" field.
+func (m *GenTableMutation) ResetTableName() {
+	m.table_name = nil
+}
+
+// SetTableComment sets the "table_comment" field.
+func (m *GenTableMutation) SetTableComment(s string) {
+	m.table_comment = &s
+}
+
+// TableComment returns the value of the "table_comment" field in the mutation.
+func (m *GenTableMutation) TableComment() (r string, exists bool) {
+	v := m.table_comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTableComment returns the old "table_comment" field's value of the GenTable entity.
+// If the GenTable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenTableMutation) OldTableComment(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTableComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTableComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTableComment: %w", err)
+	}
+	return oldValue.TableComment, nil
+}
+
+// ResetTableComment resets all changes to the "table_comment" field.
+func (m *GenTableMutation) ResetTableComment() {
+	m.table_comment = nil
+}
+
+// SetIndexes sets the "indexes" field.
+func (m *GenTableMutation) SetIndexes(ge []gencode.Index) {
+	m.indexes = &ge
+	m.appendindexes = nil
+}
+
+// Indexes returns the value of the "indexes" field in the mutation.
+func (m *GenTableMutation) Indexes() (r []gencode.Index, exists bool) {
+	v := m.indexes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIndexes returns the old "indexes" field's value of the GenTable entity.
+// If the GenTable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenTableMutation) OldIndexes(ctx context.Context) (v []gencode.Index, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIndexes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIndexes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIndexes: %w", err)
+	}
+	return oldValue.Indexes, nil
+}
+
+// AppendIndexes adds ge to the "indexes" field.
+func (m *GenTableMutation) AppendIndexes(ge []gencode.Index) {
+	m.appendindexes = append(m.appendindexes, ge...)
+}
+
+// AppendedIndexes returns the list of values that were appended to the "indexes" field in this mutation.
+func (m *GenTableMutation) AppendedIndexes() ([]gencode.Index, bool) {
+	if len(m.appendindexes) == 0 {
+		return nil, false
+	}
+	return m.appendindexes, true
+}
+
+// ClearIndexes clears the value of the "indexes" field.
+func (m *GenTableMutation) ClearIndexes() {
+	m.indexes = nil
+	m.appendindexes = nil
+	m.clearedFields[gentable.FieldIndexes] = struct{}{}
+}
+
+// IndexesCleared returns if the "indexes" field was cleared in this mutation.
+func (m *GenTableMutation) IndexesCleared() bool {
+	_, ok := m.clearedFields[gentable.FieldIndexes]
+	return ok
+}
+
+// ResetIndexes resets all changes to the "indexes" field.
+func (m *GenTableMutation) ResetIndexes() {
+	m.indexes = nil
+	m.appendindexes = nil
+	delete(m.clearedFields, gentable.FieldIndexes)
+}
+
+// SetForeignKeys sets the "foreign_keys" field.
+func (m *GenTableMutation) SetForeignKeys(gk []gencode.ForeignKey) {
+	m.foreign_keys = &gk
+	m.appendforeign_keys = nil
+}
+
+// ForeignKeys returns the value of the "foreign_keys" field in the mutation.
+func (m *GenTableMutation) ForeignKeys() (r []gencode.ForeignKey, exists bool) {
+	v := m.foreign_keys
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldForeignKeys returns the old "foreign_keys" field's value of the GenTable entity.
+// If the GenTable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenTableMutation) OldForeignKeys(ctx context.Context) (v []gencode.ForeignKey, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldForeignKeys is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldForeignKeys requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldForeignKeys: %w", err)
+	}
+	return oldValue.ForeignKeys, nil
+}
+
+// AppendForeignKeys adds gk to the "foreign_keys" field.
+func (m *GenTableMutation) AppendForeignKeys(gk []gencode.ForeignKey) {
+	m.appendforeign_keys = append(m.appendforeign_keys, gk...)
+}
+
+// AppendedForeignKeys returns the list of values that were appended to the "foreign_keys" field in this mutation.
+func (m *GenTableMutation) AppendedForeignKeys() ([]gencode.ForeignKey, bool) {
+	if len(m.appendforeign_keys) == 0 {
+		return nil, false
+	}
+	return m.appendforeign_keys, true
+}
+
+// ClearForeignKeys clears the value of the "foreign_keys" field.
+func (m *GenTableMutation) ClearForeignKeys() {
+	m.foreign_keys = nil
+	m.appendforeign_keys = nil
+	m.clearedFields[gentable.FieldForeignKeys] = struct{}{}
+}
+
+// ForeignKeysCleared returns if the "foreign_keys" field was cleared in this mutation.
+func (m *GenTableMutation) ForeignKeysCleared() bool {
+	_, ok := m.clearedFields[gentable.FieldForeignKeys]
+	return ok
+}
+
+// ResetForeignKeys resets all changes to the "foreign_keys" field.
+func (m *GenTableMutation) ResetForeignKeys() {
+	m.foreign_keys = nil
+	m.appendforeign_keys = nil
+	delete(m.clearedFields, gentable.FieldForeignKeys)
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *GenTableMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *GenTableMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the GenTable entity.
+// If the GenTable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenTableMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *GenTableMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *GenTableMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *GenTableMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the GenTable entity.
+// If the GenTable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GenTableMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *GenTableMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (m *GenTableMutation) ClearProject() {
+	m.clearedproject = true
+	m.clearedFields[gentable.FieldProjectID] = struct{}{}
+}
+
+// ProjectCleared reports if the "project" edge to the Project entity was cleared.
+func (m *GenTableMutation) ProjectCleared() bool {
+	return m.clearedproject
+}
+
+// ProjectIDs returns the "project" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProjectID instead. It exists only for internal usage by the builders.
+func (m *GenTableMutation) ProjectIDs() (ids []int64) {
+	if id := m.project; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProject resets all changes to the "project" edge.
+func (m *GenTableMutation) ResetProject() {
+	m.project = nil
+	m.clearedproject = false
+}
+
+// AddColumnIDs adds the "columns" edge to the GenColumn entity by ids.
+func (m *GenTableMutation) AddColumnIDs(ids ...int64) {
+	if m.columns == nil {
+		m.columns = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.columns[ids[i]] = struct{}{}
+	}
+}
+
+// ClearColumns clears the "columns" edge to the GenColumn entity.
+func (m *GenTableMutation) ClearColumns() {
+	m.clearedcolumns = true
+}
+
+// ColumnsCleared reports if the "columns" edge to the GenColumn entity was cleared.
+func (m *GenTableMutation) ColumnsCleared() bool {
+	return m.clearedcolumns
+}
+
+// RemoveColumnIDs removes the "columns" edge to the GenColumn entity by IDs.
+func (m *GenTableMutation) RemoveColumnIDs(ids ...int64) {
+	if m.removedcolumns == nil {
+		m.removedcolumns = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.columns, ids[i])
+		m.removedcolumns[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedColumns returns the removed IDs of the "columns" edge to the GenColumn entity.
+func (m *GenTableMutation) RemovedColumnsIDs() (ids []int64) {
+	for id := range m.removedcolumns {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ColumnsIDs returns the "columns" edge IDs in the mutation.
+func (m *GenTableMutation) ColumnsIDs() (ids []int64) {
+	for id := range m.columns {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetColumns resets all changes to the "columns" edge.
+func (m *GenTableMutation) ResetColumns() {
+	m.columns = nil
+	m.clearedcolumns = false
+	m.removedcolumns = nil
+}
+
+// Where appends a list predicates to the GenTableMutation builder.
+func (m *GenTableMutation) Where(ps ...predicate.GenTable) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GenTableMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GenTableMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GenTable, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GenTableMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GenTableMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GenTable).
+func (m *GenTableMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GenTableMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.project != nil {
+		fields = append(fields, gentable.FieldProjectID)
+	}
+	if m.table_name != nil {
+		fields = append(fields, gentable.FieldTableName)
+	}
+	if m.table_comment != nil {
+		fields = append(fields, gentable.FieldTableComment)
+	}
+	if m.indexes != nil {
+		fields = append(fields, gentable.FieldIndexes)
+	}
+	if m.foreign_keys != nil {
+		fields = append(fields, gentable.FieldForeignKeys)
+	}
+	if m.create_time != nil {
+		fields = append(fields, gentable.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, gentable.FieldUpdateTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GenTableMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case gentable.FieldProjectID:
+		return m.ProjectID()
+	case gentable.FieldTableName:
+		return m.TableName()
+	case gentable.FieldTableComment:
+		return m.TableComment()
+	case gentable.FieldIndexes:
+		return m.Indexes()
+	case gentable.FieldForeignKeys:
+		return m.ForeignKeys()
+	case gentable.FieldCreateTime:
+		return m.CreateTime()
+	case gentable.FieldUpdateTime:
+		return m.UpdateTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GenTableMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case gentable.FieldProjectID:
+		return m.OldProjectID(ctx)
+	case gentable.FieldTableName:
+		return m.OldTableName(ctx)
+	case gentable.FieldTableComment:
+		return m.OldTableComment(ctx)
+	case gentable.FieldIndexes:
+		return m.OldIndexes(ctx)
+	case gentable.FieldForeignKeys:
+		return m.OldForeignKeys(ctx)
+	case gentable.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case gentable.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown GenTable field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GenTableMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case gentable.FieldProjectID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectID(v)
+		return nil
+	case gentable.FieldTableName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTableName(v)
+		return nil
+	case gentable.FieldTableComment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTableComment(v)
+		return nil
+	case gentable.FieldIndexes:
+		v, ok := value.([]gencode.Index)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIndexes(v)
+		return nil
+	case gentable.FieldForeignKeys:
+		v, ok := value.([]gencode.ForeignKey)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetForeignKeys(v)
+		return nil
+	case gentable.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case gentable.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GenTable field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GenTableMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GenTableMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GenTableMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown GenTable numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GenTableMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(gentable.FieldIndexes) {
+		fields = append(fields, gentable.FieldIndexes)
+	}
+	if m.FieldCleared(gentable.FieldForeignKeys) {
+		fields = append(fields, gentable.FieldForeignKeys)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GenTableMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GenTableMutation) ClearField(name string) error {
+	switch name {
+	case gentable.FieldIndexes:
+		m.ClearIndexes()
+		return nil
+	case gentable.FieldForeignKeys:
+		m.ClearForeignKeys()
+		return nil
+	}
+	return fmt.Errorf("unknown GenTable nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GenTableMutation) ResetField(name string) error {
+	switch name {
+	case gentable.FieldProjectID:
+		m.ResetProjectID()
+		return nil
+	case gentable.FieldTableName:
+		m.ResetTableName()
+		return nil
+	case gentable.FieldTableComment:
+		m.ResetTableComment()
+		return nil
+	case gentable.FieldIndexes:
+		m.ResetIndexes()
+		return nil
+	case gentable.FieldForeignKeys:
+		m.ResetForeignKeys()
+		return nil
+	case gentable.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case gentable.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	}
+	return fmt.Errorf("unknown GenTable field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GenTableMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.project != nil {
+		edges = append(edges, gentable.EdgeProject)
+	}
+	if m.columns != nil {
+		edges = append(edges, gentable.EdgeColumns)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GenTableMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case gentable.EdgeProject:
+		if id := m.project; id != nil {
+			return []ent.Value{*id}
+		}
+	case gentable.EdgeColumns:
+		ids := make([]ent.Value, 0, len(m.columns))
+		for id := range m.columns {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GenTableMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedcolumns != nil {
+		edges = append(edges, gentable.EdgeColumns)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GenTableMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case gentable.EdgeColumns:
+		ids := make([]ent.Value, 0, len(m.removedcolumns))
+		for id := range m.removedcolumns {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GenTableMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedproject {
+		edges = append(edges, gentable.EdgeProject)
+	}
+	if m.clearedcolumns {
+		edges = append(edges, gentable.EdgeColumns)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GenTableMutation) EdgeCleared(name string) bool {
+	switch name {
+	case gentable.EdgeProject:
+		return m.clearedproject
+	case gentable.EdgeColumns:
+		return m.clearedcolumns
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GenTableMutation) ClearEdge(name string) error {
+	switch name {
+	case gentable.EdgeProject:
+		m.ClearProject()
+		return nil
+	}
+	return fmt.Errorf("unknown GenTable unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GenTableMutation) ResetEdge(name string) error {
+	switch name {
+	case gentable.EdgeProject:
+		m.ResetProject()
+		return nil
+	case gentable.EdgeColumns:
+		m.ResetColumns()
+		return nil
+	}
+	return fmt.Errorf("unknown GenTable edge %s", name)
+}
+
+// ProjectMutation represents an operation that mutates the Project nodes in the graph.
+type ProjectMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int64
+	name           *string
+	description    *string
+	gen_config     *gencode.GenConfig
+	package_config *gencode.PackageConfig
+	deploy_config  *gencode.DeployConfig
+	vars           *map[string]interface{}
+	create_time    *time.Time
+	update_time    *time.Time
+	clearedFields  map[string]struct{}
+	tables         map[int64]struct{}
+	removedtables  map[int64]struct{}
+	clearedtables  bool
+	done           bool
+	oldValue       func(context.Context) (*Project, error)
+	predicates     []predicate.Project
+}
+
+var _ ent.Mutation = (*ProjectMutation)(nil)
+
+// projectOption allows management of the mutation configuration using functional options.
+type projectOption func(*ProjectMutation)
+
+// newProjectMutation creates new mutation for the Project entity.
+func newProjectMutation(c config, op Op, opts ...projectOption) *ProjectMutation {
+	m := &ProjectMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProject,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProjectID sets the ID field of the mutation.
+func withProjectID(id int64) projectOption {
+	return func(m *ProjectMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Project
+		)
+		m.oldValue = func(ctx context.Context) (*Project, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Project.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProject sets the old Project of the mutation.
+func withProject(node *Project) projectOption {
+	return func(m *ProjectMutation) {
+		m.oldValue = func(context.Context) (*Project, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProjectMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProjectMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Project entities.
+func (m *ProjectMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProjectMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProjectMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Project.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ProjectMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ProjectMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ProjectMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ProjectMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ProjectMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ProjectMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetGenConfig sets the "gen_config" field.
+func (m *ProjectMutation) SetGenConfig(gc gencode.GenConfig) {
+	m.gen_config = &gc
+}
+
+// GenConfig returns the value of the "gen_config" field in the mutation.
+func (m *ProjectMutation) GenConfig() (r gencode.GenConfig, exists bool) {
+	v := m.gen_config
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGenConfig returns the old "gen_config" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldGenConfig(ctx context.Context) (v gencode.GenConfig, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGenConfig is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGenConfig requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGenConfig: %w", err)
+	}
+	return oldValue.GenConfig, nil
+}
+
+// ClearGenConfig clears the value of the "gen_config" field.
+func (m *ProjectMutation) ClearGenConfig() {
+	m.gen_config = nil
+	m.clearedFields[project.FieldGenConfig] = struct{}{}
+}
+
+// GenConfigCleared returns if the "gen_config" field was cleared in this mutation.
+func (m *ProjectMutation) GenConfigCleared() bool {
+	_, ok := m.clearedFields[project.FieldGenConfig]
+	return ok
+}
+
+// ResetGenConfig resets all changes to the "gen_config" field.
+func (m *ProjectMutation) ResetGenConfig() {
+	m.gen_config = nil
+	delete(m.clearedFields, project.FieldGenConfig)
+}
+
+// SetPackageConfig sets the "package_config" field.
+func (m *ProjectMutation) SetPackageConfig(gc gencode.PackageConfig) {
+	m.package_config = &gc
+}
+
+// PackageConfig returns the value of the "package_config" field in the mutation.
+func (m *ProjectMutation) PackageConfig() (r gencode.PackageConfig, exists bool) {
+	v := m.package_config
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageConfig returns the old "package_config" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldPackageConfig(ctx context.Context) (v gencode.PackageConfig, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageConfig is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageConfig requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageConfig: %w", err)
+	}
+	return oldValue.PackageConfig, nil
+}
+
+// ClearPackageConfig clears the value of the "package_config" field.
+func (m *ProjectMutation) ClearPackageConfig() {
+	m.package_config = nil
+	m.clearedFields[project.FieldPackageConfig] = struct{}{}
+}
+
+// PackageConfigCleared returns if the "package_config" field was cleared in this mutation.
+func (m *ProjectMutation) PackageConfigCleared() bool {
+	_, ok := m.clearedFields[project.FieldPackageConfig]
+	return ok
+}
+
+// ResetPackageConfig resets all changes to the "package_config" field.
+func (m *ProjectMutation) ResetPackageConfig() {
+	m.package_config = nil
+	delete(m.clearedFields, project.FieldPackageConfig)
+}
+
+// SetDeployConfig sets the "deploy_config" field.
+func (m *ProjectMutation) SetDeployConfig(gc gencode.DeployConfig) {
+	m.deploy_config = &gc
+}
+
+// DeployConfig returns the value of the "deploy_config" field in the mutation.
+func (m *ProjectMutation) DeployConfig() (r gencode.DeployConfig, exists bool) {
+	v := m.deploy_config
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeployConfig returns the old "deploy_config" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldDeployConfig(ctx context.Context) (v gencode.DeployConfig, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeployConfig is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeployConfig requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeployConfig: %w", err)
+	}
+	return oldValue.DeployConfig, nil
+}
+
+// ClearDeployConfig clears the value of the "deploy_config" field.
+func (m *ProjectMutation) ClearDeployConfig() {
+	m.deploy_config = nil
+	m.clearedFields[project.FieldDeployConfig] = struct{}{}
+}
+
+// DeployConfigCleared returns if the "deploy_config" field was cleared in this mutation.
+func (m *ProjectMutation) DeployConfigCleared() bool {
+	_, ok := m.clearedFields[project.FieldDeployConfig]
+	return ok
+}
+
+// ResetDeployConfig resets all changes to the "deploy_config" field.
+func (m *ProjectMutation) ResetDeployConfig() {
+	m.deploy_config = nil
+	delete(m.clearedFields, project.FieldDeployConfig)
+}
+
+// SetVars sets the "vars" field.
+func (m *ProjectMutation) SetVars(value map[string]interface{}) {
+	m.vars = &value
+}
+
+// Vars returns the value of the "vars" field in the mutation.
+func (m *ProjectMutation) Vars() (r map[string]interface{}, exists bool) {
+	v := m.vars
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVars returns the old "vars" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldVars(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVars is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVars requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVars: %w", err)
+	}
+	return oldValue.Vars, nil
+}
+
+// ClearVars clears the value of the "vars" field.
+func (m *ProjectMutation) ClearVars() {
+	m.vars = nil
+	m.clearedFields[project.FieldVars] = struct{}{}
+}
+
+// VarsCleared returns if the "vars" field was cleared in this mutation.
+func (m *ProjectMutation) VarsCleared() bool {
+	_, ok := m.clearedFields[project.FieldVars]
+	return ok
+}
+
+// ResetVars resets all changes to the "vars" field.
+func (m *ProjectMutation) ResetVars() {
+	m.vars = nil
+	delete(m.clearedFields, project.FieldVars)
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ProjectMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ProjectMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ProjectMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ProjectMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ProjectMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ProjectMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// AddTableIDs adds the "tables" edge to the GenTable entity by ids.
+func (m *ProjectMutation) AddTableIDs(ids ...int64) {
+	if m.tables == nil {
+		m.tables = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.tables[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTables clears the "tables" edge to the GenTable entity.
+func (m *ProjectMutation) ClearTables() {
+	m.clearedtables = true
+}
+
+// TablesCleared reports if the "tables" edge to the GenTable entity was cleared.
+func (m *ProjectMutation) TablesCleared() bool {
+	return m.clearedtables
+}
+
+// RemoveTableIDs removes the "tables" edge to the GenTable entity by IDs.
+func (m *ProjectMutation) RemoveTableIDs(ids ...int64) {
+	if m.removedtables == nil {
+		m.removedtables = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.tables, ids[i])
+		m.removedtables[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTables returns the removed IDs of the "tables" edge to the GenTable entity.
+func (m *ProjectMutation) RemovedTablesIDs() (ids []int64) {
+	for id := range m.removedtables {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TablesIDs returns the "tables" edge IDs in the mutation.
+func (m *ProjectMutation) TablesIDs() (ids []int64) {
+	for id := range m.tables {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTables resets all changes to the "tables" edge.
+func (m *ProjectMutation) ResetTables() {
+	m.tables = nil
+	m.clearedtables = false
+	m.removedtables = nil
+}
+
+// Where appends a list predicates to the ProjectMutation builder.
+func (m *ProjectMutation) Where(ps ...predicate.Project) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProjectMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProjectMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Project, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProjectMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProjectMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Project).
+func (m *ProjectMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProjectMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, project.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, project.FieldDescription)
+	}
+	if m.gen_config != nil {
+		fields = append(fields, project.FieldGenConfig)
+	}
+	if m.package_config != nil {
+		fields = append(fields, project.FieldPackageConfig)
+	}
+	if m.deploy_config != nil {
+		fields = append(fields, project.FieldDeployConfig)
+	}
+	if m.vars != nil {
+		fields = append(fields, project.FieldVars)
+	}
+	if m.create_time != nil {
+		fields = append(fields, project.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, project.FieldUpdateTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProjectMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case project.FieldName:
+		return m.Name()
+	case project.FieldDescription:
+		return m.Description()
+	case project.FieldGenConfig:
+		return m.GenConfig()
+	case project.FieldPackageConfig:
+		return m.PackageConfig()
+	case project.FieldDeployConfig:
+		return m.DeployConfig()
+	case project.FieldVars:
+		return m.Vars()
+	case project.FieldCreateTime:
+		return m.CreateTime()
+	case project.FieldUpdateTime:
+		return m.UpdateTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProjectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case project.FieldName:
+		return m.OldName(ctx)
+	case project.FieldDescription:
+		return m.OldDescription(ctx)
+	case project.FieldGenConfig:
+		return m.OldGenConfig(ctx)
+	case project.FieldPackageConfig:
+		return m.OldPackageConfig(ctx)
+	case project.FieldDeployConfig:
+		return m.OldDeployConfig(ctx)
+	case project.FieldVars:
+		return m.OldVars(ctx)
+	case project.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case project.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown Project field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case project.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case project.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case project.FieldGenConfig:
+		v, ok := value.(gencode.GenConfig)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGenConfig(v)
+		return nil
+	case project.FieldPackageConfig:
+		v, ok := value.(gencode.PackageConfig)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageConfig(v)
+		return nil
+	case project.FieldDeployConfig:
+		v, ok := value.(gencode.DeployConfig)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeployConfig(v)
+		return nil
+	case project.FieldVars:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVars(v)
+		return nil
+	case project.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case project.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Project field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProjectMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProjectMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Project numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProjectMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(project.FieldGenConfig) {
+		fields = append(fields, project.FieldGenConfig)
+	}
+	if m.FieldCleared(project.FieldPackageConfig) {
+		fields = append(fields, project.FieldPackageConfig)
+	}
+	if m.FieldCleared(project.FieldDeployConfig) {
+		fields = append(fields, project.FieldDeployConfig)
+	}
+	if m.FieldCleared(project.FieldVars) {
+		fields = append(fields, project.FieldVars)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProjectMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProjectMutation) ClearField(name string) error {
+	switch name {
+	case project.FieldGenConfig:
+		m.ClearGenConfig()
+		return nil
+	case project.FieldPackageConfig:
+		m.ClearPackageConfig()
+		return nil
+	case project.FieldDeployConfig:
+		m.ClearDeployConfig()
+		return nil
+	case project.FieldVars:
+		m.ClearVars()
+		return nil
+	}
+	return fmt.Errorf("unknown Project nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProjectMutation) ResetField(name string) error {
+	switch name {
+	case project.FieldName:
+		m.ResetName()
+		return nil
+	case project.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case project.FieldGenConfig:
+		m.ResetGenConfig()
+		return nil
+	case project.FieldPackageConfig:
+		m.ResetPackageConfig()
+		return nil
+	case project.FieldDeployConfig:
+		m.ResetDeployConfig()
+		return nil
+	case project.FieldVars:
+		m.ResetVars()
+		return nil
+	case project.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case project.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	}
+	return fmt.Errorf("unknown Project field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProjectMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.tables != nil {
+		edges = append(edges, project.EdgeTables)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProjectMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case project.EdgeTables:
+		ids := make([]ent.Value, 0, len(m.tables))
+		for id := range m.tables {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProjectMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedtables != nil {
+		edges = append(edges, project.EdgeTables)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProjectMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case project.EdgeTables:
+		ids := make([]ent.Value, 0, len(m.removedtables))
+		for id := range m.removedtables {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProjectMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtables {
+		edges = append(edges, project.EdgeTables)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProjectMutation) EdgeCleared(name string) bool {
+	switch name {
+	case project.EdgeTables:
+		return m.clearedtables
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProjectMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Project unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProjectMutation) ResetEdge(name string) error {
+	switch name {
+	case project.EdgeTables:
+		m.ResetTables()
+		return nil
+	}
+	return fmt.Errorf("unknown Project edge %s", name)
+}
@@ -8,3 +8,12 @@ import (
 
 // Admin is the predicate function for admin builders.
 type Admin func(*sql.Selector)
+
+// GenColumn is the predicate function for gencolumn builders.
+type GenColumn func(*sql.Selector)
+
+// GenTable is the predicate function for gentable builders.
+type GenTable func(*sql.Selector)
+
+// Project is the predicate function for project builders.
+type Project func(*sql.Selector)
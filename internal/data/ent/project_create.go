@@ -0,0 +1,1001 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gen_code/internal/data/ent/gentable"
+	"gen_code/internal/data/ent/project"
+	"gen_code/pkg/gencode"
+)
+
+// ProjectCreate is the builder for creating a Project entity.
+type ProjectCreate struct {
+	config
+	mutation *ProjectMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (_c *ProjectCreate) SetName(v string) *ProjectCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableName(v *string) *ProjectCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *ProjectCreate) SetDescription(v string) *ProjectCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableDescription(v *string) *ProjectCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetGenConfig sets the "gen_config" field.
+func (_c *ProjectCreate) SetGenConfig(v gencode.GenConfig) *ProjectCreate {
+	_c.mutation.SetGenConfig(v)
+	return _c
+}
+
+// SetNillableGenConfig sets the "gen_config" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableGenConfig(v *gencode.GenConfig) *ProjectCreate {
+	if v != nil {
+		_c.SetGenConfig(*v)
+	}
+	return _c
+}
+
+// SetPackageConfig sets the "package_config" field.
+func (_c *ProjectCreate) SetPackageConfig(v gencode.PackageConfig) *ProjectCreate {
+	_c.mutation.SetPackageConfig(v)
+	return _c
+}
+
+// SetNillablePackageConfig sets the "package_config" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillablePackageConfig(v *gencode.PackageConfig) *ProjectCreate {
+	if v != nil {
+		_c.SetPackageConfig(*v)
+	}
+	return _c
+}
+
+// SetDeployConfig sets the "deploy_config" field.
+func (_c *ProjectCreate) SetDeployConfig(v gencode.DeployConfig) *ProjectCreate {
+	_c.mutation.SetDeployConfig(v)
+	return _c
+}
+
+// SetNillableDeployConfig sets the "deploy_config" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableDeployConfig(v *gencode.DeployConfig) *ProjectCreate {
+	if v != nil {
+		_c.SetDeployConfig(*v)
+	}
+	return _c
+}
+
+// SetVars sets the "vars" field.
+func (_c *ProjectCreate) SetVars(v map[string]interface{}) *ProjectCreate {
+	_c.mutation.SetVars(v)
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ProjectCreate) SetCreateTime(v time.Time) *ProjectCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableCreateTime(v *time.Time) *ProjectCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ProjectCreate) SetUpdateTime(v time.Time) *ProjectCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableUpdateTime(v *time.Time) *ProjectCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ProjectCreate) SetID(v int64) *ProjectCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddTableIDs adds the "tables" edge to the GenTable entity by IDs.
+func (_c *ProjectCreate) AddTableIDs(ids ...int64) *ProjectCreate {
+	_c.mutation.AddTableIDs(ids...)
+	return _c
+}
+
+// AddTables adds the "tables" edges to the GenTable entity.
+func (_c *ProjectCreate) AddTables(v ...*GenTable) *ProjectCreate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTableIDs(ids...)
+}
+
+// Mutation returns the ProjectMutation object of the builder.
+func (_c *ProjectCreate) Mutation() *ProjectMutation {
+	return _c.mutation
+}
+
+// Save creates the Project in the database.
+func (_c *ProjectCreate) Save(ctx context.Context) (*Project, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ProjectCreate) SaveX(ctx context.Context) *Project {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProjectCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProjectCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ProjectCreate) defaults() {
+	if _, ok := _c.mutation.Name(); !ok {
+		v := project.DefaultName
+		_c.mutation.SetName(v)
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		v := project.DefaultDescription
+		_c.mutation.SetDescription(v)
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := project.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := project.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ProjectCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Project.name"`)}
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "Project.description"`)}
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Project.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Project.update_time"`)}
+	}
+	return nil
+}
+
+func (_c *ProjectCreate) sqlSave(ctx context.Context) (*Project, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Project{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(project.Table, sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(project.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(project.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.GenConfig(); ok {
+		_spec.SetField(project.FieldGenConfig, field.TypeJSON, value)
+		_node.GenConfig = value
+	}
+	if value, ok := _c.mutation.PackageConfig(); ok {
+		_spec.SetField(project.FieldPackageConfig, field.TypeJSON, value)
+		_node.PackageConfig = value
+	}
+	if value, ok := _c.mutation.DeployConfig(); ok {
+		_spec.SetField(project.FieldDeployConfig, field.TypeJSON, value)
+		_node.DeployConfig = value
+	}
+	if value, ok := _c.mutation.Vars(); ok {
+		_spec.SetField(project.FieldVars, field.TypeJSON, value)
+		_node.Vars = value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(project.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(project.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if nodes := _c.mutation.TablesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TablesTable,
+			Columns: []string{project.TablesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gentable.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Project.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProjectUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ProjectCreate) OnConflict(opts ...sql.ConflictOption) *ProjectUpsertOne {
+	_c.conflict = opts
+	return &ProjectUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Project.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ProjectCreate) OnConflictColumns(columns ...string) *ProjectUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ProjectUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ProjectUpsertOne is the builder for "upsert"-ing
+	//  one Project node.
+	ProjectUpsertOne struct {
+		create *ProjectCreate
+	}
+
+	// ProjectUpsert is the "OnConflict" setter.
+	ProjectUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *ProjectUpsert) SetName(v string) *ProjectUpsert {
+	u.Set(project.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ProjectUpsert) UpdateName() *ProjectUpsert {
+	u.SetExcluded(project.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ProjectUpsert) SetDescription(v string) *ProjectUpsert {
+	u.Set(project.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ProjectUpsert) UpdateDescription() *ProjectUpsert {
+	u.SetExcluded(project.FieldDescription)
+	return u
+}
+
+// SetGenConfig sets the "gen_config" field.
+func (u *ProjectUpsert) SetGenConfig(v gencode.GenConfig) *ProjectUpsert {
+	u.Set(project.FieldGenConfig, v)
+	return u
+}
+
+// UpdateGenConfig sets the "gen_config" field to the value that was provided on create.
+func (u *ProjectUpsert) UpdateGenConfig() *ProjectUpsert {
+	u.SetExcluded(project.FieldGenConfig)
+	return u
+}
+
+// ClearGenConfig clears the value of the "gen_config" field.
+func (u *ProjectUpsert) ClearGenConfig() *ProjectUpsert {
+	u.SetNull(project.FieldGenConfig)
+	return u
+}
+
+// SetPackageConfig sets the "package_config" field.
+func (u *ProjectUpsert) SetPackageConfig(v gencode.PackageConfig) *ProjectUpsert {
+	u.Set(project.FieldPackageConfig, v)
+	return u
+}
+
+// UpdatePackageConfig sets the "package_config" field to the value that was provided on create.
+func (u *ProjectUpsert) UpdatePackageConfig() *ProjectUpsert {
+	u.SetExcluded(project.FieldPackageConfig)
+	return u
+}
+
+// ClearPackageConfig clears the value of the "package_config" field.
+func (u *ProjectUpsert) ClearPackageConfig() *ProjectUpsert {
+	u.SetNull(project.FieldPackageConfig)
+	return u
+}
+
+// SetDeployConfig sets the "deploy_config" field.
+func (u *ProjectUpsert) SetDeployConfig(v gencode.DeployConfig) *ProjectUpsert {
+	u.Set(project.FieldDeployConfig, v)
+	return u
+}
+
+// UpdateDeployConfig sets the "deploy_config" field to the value that was provided on create.
+func (u *ProjectUpsert) UpdateDeployConfig() *ProjectUpsert {
+	u.SetExcluded(project.FieldDeployConfig)
+	return u
+}
+
+// ClearDeployConfig clears the value of the "deploy_config" field.
+func (u *ProjectUpsert) ClearDeployConfig() *ProjectUpsert {
+	u.SetNull(project.FieldDeployConfig)
+	return u
+}
+
+// SetVars sets the "vars" field.
+func (u *ProjectUpsert) SetVars(v map[string]interface{}) *ProjectUpsert {
+	u.Set(project.FieldVars, v)
+	return u
+}
+
+// UpdateVars sets the "vars" field to the value that was provided on create.
+func (u *ProjectUpsert) UpdateVars() *ProjectUpsert {
+	u.SetExcluded(project.FieldVars)
+	return u
+}
+
+// ClearVars clears the value of the "vars" field.
+func (u *ProjectUpsert) ClearVars() *ProjectUpsert {
+	u.SetNull(project.FieldVars)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ProjectUpsert) SetUpdateTime(v time.Time) *ProjectUpsert {
+	u.Set(project.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ProjectUpsert) UpdateUpdateTime() *ProjectUpsert {
+	u.SetExcluded(project.FieldUpdateTime)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Project.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(project.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProjectUpsertOne) UpdateNewValues() *ProjectUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(project.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(project.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Project.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProjectUpsertOne) Ignore() *ProjectUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProjectUpsertOne) DoNothing() *ProjectUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProjectCreate.OnConflict
+// documentation for more info.
+func (u *ProjectUpsertOne) Update(set func(*ProjectUpsert)) *ProjectUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProjectUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ProjectUpsertOne) SetName(v string) *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ProjectUpsertOne) UpdateName() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ProjectUpsertOne) SetDescription(v string) *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ProjectUpsertOne) UpdateDescription() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetGenConfig sets the "gen_config" field.
+func (u *ProjectUpsertOne) SetGenConfig(v gencode.GenConfig) *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetGenConfig(v)
+	})
+}
+
+// UpdateGenConfig sets the "gen_config" field to the value that was provided on create.
+func (u *ProjectUpsertOne) UpdateGenConfig() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateGenConfig()
+	})
+}
+
+// ClearGenConfig clears the value of the "gen_config" field.
+func (u *ProjectUpsertOne) ClearGenConfig() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.ClearGenConfig()
+	})
+}
+
+// SetPackageConfig sets the "package_config" field.
+func (u *ProjectUpsertOne) SetPackageConfig(v gencode.PackageConfig) *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetPackageConfig(v)
+	})
+}
+
+// UpdatePackageConfig sets the "package_config" field to the value that was provided on create.
+func (u *ProjectUpsertOne) UpdatePackageConfig() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdatePackageConfig()
+	})
+}
+
+// ClearPackageConfig clears the value of the "package_config" field.
+func (u *ProjectUpsertOne) ClearPackageConfig() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.ClearPackageConfig()
+	})
+}
+
+// SetDeployConfig sets the "deploy_config" field.
+func (u *ProjectUpsertOne) SetDeployConfig(v gencode.DeployConfig) *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetDeployConfig(v)
+	})
+}
+
+// UpdateDeployConfig sets the "deploy_config" field to the value that was provided on create.
+func (u *ProjectUpsertOne) UpdateDeployConfig() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateDeployConfig()
+	})
+}
+
+// ClearDeployConfig clears the value of the "deploy_config" field.
+func (u *ProjectUpsertOne) ClearDeployConfig() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.ClearDeployConfig()
+	})
+}
+
+// SetVars sets the "vars" field.
+func (u *ProjectUpsertOne) SetVars(v map[string]interface{}) *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetVars(v)
+	})
+}
+
+// UpdateVars sets the "vars" field to the value that was provided on create.
+func (u *ProjectUpsertOne) UpdateVars() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateVars()
+	})
+}
+
+// ClearVars clears the value of the "vars" field.
+func (u *ProjectUpsertOne) ClearVars() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.ClearVars()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ProjectUpsertOne) SetUpdateTime(v time.Time) *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ProjectUpsertOne) UpdateUpdateTime() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// Exec executes the query.
+func (u *ProjectUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProjectCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProjectUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProjectUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProjectUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProjectCreateBulk is the builder for creating many Project entities in bulk.
+type ProjectCreateBulk struct {
+	config
+	err      error
+	builders []*ProjectCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Project entities in the database.
+func (_c *ProjectCreateBulk) Save(ctx context.Context) ([]*Project, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Project, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProjectMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ProjectCreateBulk) SaveX(ctx context.Context) []*Project {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProjectCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProjectCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Project.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProjectUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ProjectCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProjectUpsertBulk {
+	_c.conflict = opts
+	return &ProjectUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Project.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ProjectCreateBulk) OnConflictColumns(columns ...string) *ProjectUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ProjectUpsertBulk{
+		create: _c,
+	}
+}
+
+// ProjectUpsertBulk is the builder for "upsert"-ing
+// a bulk of Project nodes.
+type ProjectUpsertBulk struct {
+	create *ProjectCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Project.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(project.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProjectUpsertBulk) UpdateNewValues() *ProjectUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(project.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(project.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Project.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProjectUpsertBulk) Ignore() *ProjectUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProjectUpsertBulk) DoNothing() *ProjectUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProjectCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProjectUpsertBulk) Update(set func(*ProjectUpsert)) *ProjectUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProjectUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ProjectUpsertBulk) SetName(v string) *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ProjectUpsertBulk) UpdateName() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ProjectUpsertBulk) SetDescription(v string) *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ProjectUpsertBulk) UpdateDescription() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetGenConfig sets the "gen_config" field.
+func (u *ProjectUpsertBulk) SetGenConfig(v gencode.GenConfig) *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetGenConfig(v)
+	})
+}
+
+// UpdateGenConfig sets the "gen_config" field to the value that was provided on create.
+func (u *ProjectUpsertBulk) UpdateGenConfig() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateGenConfig()
+	})
+}
+
+// ClearGenConfig clears the value of the "gen_config" field.
+func (u *ProjectUpsertBulk) ClearGenConfig() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.ClearGenConfig()
+	})
+}
+
+// SetPackageConfig sets the "package_config" field.
+func (u *ProjectUpsertBulk) SetPackageConfig(v gencode.PackageConfig) *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetPackageConfig(v)
+	})
+}
+
+// UpdatePackageConfig sets the "package_config" field to the value that was provided on create.
+func (u *ProjectUpsertBulk) UpdatePackageConfig() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdatePackageConfig()
+	})
+}
+
+// ClearPackageConfig clears the value of the "package_config" field.
+func (u *ProjectUpsertBulk) ClearPackageConfig() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.ClearPackageConfig()
+	})
+}
+
+// SetDeployConfig sets the "deploy_config" field.
+func (u *ProjectUpsertBulk) SetDeployConfig(v gencode.DeployConfig) *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetDeployConfig(v)
+	})
+}
+
+// UpdateDeployConfig sets the "deploy_config" field to the value that was provided on create.
+func (u *ProjectUpsertBulk) UpdateDeployConfig() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateDeployConfig()
+	})
+}
+
+// ClearDeployConfig clears the value of the "deploy_config" field.
+func (u *ProjectUpsertBulk) ClearDeployConfig() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.ClearDeployConfig()
+	})
+}
+
+// SetVars sets the "vars" field.
+func (u *ProjectUpsertBulk) SetVars(v map[string]interface{}) *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetVars(v)
+	})
+}
+
+// UpdateVars sets the "vars" field to the value that was provided on create.
+func (u *ProjectUpsertBulk) UpdateVars() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateVars()
+	})
+}
+
+// ClearVars clears the value of the "vars" field.
+func (u *ProjectUpsertBulk) ClearVars() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.ClearVars()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ProjectUpsertBulk) SetUpdateTime(v time.Time) *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ProjectUpsertBulk) UpdateUpdateTime() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// Exec executes the query.
+func (u *ProjectUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProjectCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProjectCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProjectUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
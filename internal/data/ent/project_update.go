@@ -0,0 +1,636 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gen_code/internal/data/ent/gentable"
+	"gen_code/internal/data/ent/predicate"
+	"gen_code/internal/data/ent/project"
+	"gen_code/pkg/gencode"
+)
+
+// ProjectUpdate is the builder for updating Project entities.
+type ProjectUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProjectMutation
+}
+
+// Where appends a list predicates to the ProjectUpdate builder.
+func (_u *ProjectUpdate) Where(ps ...predicate.Project) *ProjectUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ProjectUpdate) SetName(v string) *ProjectUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableName(v *string) *ProjectUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ProjectUpdate) SetDescription(v string) *ProjectUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableDescription(v *string) *ProjectUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetGenConfig sets the "gen_config" field.
+func (_u *ProjectUpdate) SetGenConfig(v gencode.GenConfig) *ProjectUpdate {
+	_u.mutation.SetGenConfig(v)
+	return _u
+}
+
+// SetNillableGenConfig sets the "gen_config" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableGenConfig(v *gencode.GenConfig) *ProjectUpdate {
+	if v != nil {
+		_u.SetGenConfig(*v)
+	}
+	return _u
+}
+
+// ClearGenConfig clears the value of the "gen_config" field.
+func (_u *ProjectUpdate) ClearGenConfig() *ProjectUpdate {
+	_u.mutation.ClearGenConfig()
+	return _u
+}
+
+// SetPackageConfig sets the "package_config" field.
+func (_u *ProjectUpdate) SetPackageConfig(v gencode.PackageConfig) *ProjectUpdate {
+	_u.mutation.SetPackageConfig(v)
+	return _u
+}
+
+// SetNillablePackageConfig sets the "package_config" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillablePackageConfig(v *gencode.PackageConfig) *ProjectUpdate {
+	if v != nil {
+		_u.SetPackageConfig(*v)
+	}
+	return _u
+}
+
+// ClearPackageConfig clears the value of the "package_config" field.
+func (_u *ProjectUpdate) ClearPackageConfig() *ProjectUpdate {
+	_u.mutation.ClearPackageConfig()
+	return _u
+}
+
+// SetDeployConfig sets the "deploy_config" field.
+func (_u *ProjectUpdate) SetDeployConfig(v gencode.DeployConfig) *ProjectUpdate {
+	_u.mutation.SetDeployConfig(v)
+	return _u
+}
+
+// SetNillableDeployConfig sets the "deploy_config" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableDeployConfig(v *gencode.DeployConfig) *ProjectUpdate {
+	if v != nil {
+		_u.SetDeployConfig(*v)
+	}
+	return _u
+}
+
+// ClearDeployConfig clears the value of the "deploy_config" field.
+func (_u *ProjectUpdate) ClearDeployConfig() *ProjectUpdate {
+	_u.mutation.ClearDeployConfig()
+	return _u
+}
+
+// SetVars sets the "vars" field.
+func (_u *ProjectUpdate) SetVars(v map[string]interface{}) *ProjectUpdate {
+	_u.mutation.SetVars(v)
+	return _u
+}
+
+// ClearVars clears the value of the "vars" field.
+func (_u *ProjectUpdate) ClearVars() *ProjectUpdate {
+	_u.mutation.ClearVars()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ProjectUpdate) SetUpdateTime(v time.Time) *ProjectUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// AddTableIDs adds the "tables" edge to the GenTable entity by IDs.
+func (_u *ProjectUpdate) AddTableIDs(ids ...int64) *ProjectUpdate {
+	_u.mutation.AddTableIDs(ids...)
+	return _u
+}
+
+// AddTables adds the "tables" edges to the GenTable entity.
+func (_u *ProjectUpdate) AddTables(v ...*GenTable) *ProjectUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTableIDs(ids...)
+}
+
+// Mutation returns the ProjectMutation object of the builder.
+func (_u *ProjectUpdate) Mutation() *ProjectMutation {
+	return _u.mutation
+}
+
+// ClearTables clears all "tables" edges to the GenTable entity.
+func (_u *ProjectUpdate) ClearTables() *ProjectUpdate {
+	_u.mutation.ClearTables()
+	return _u
+}
+
+// RemoveTableIDs removes the "tables" edge to GenTable entities by IDs.
+func (_u *ProjectUpdate) RemoveTableIDs(ids ...int64) *ProjectUpdate {
+	_u.mutation.RemoveTableIDs(ids...)
+	return _u
+}
+
+// RemoveTables removes "tables" edges to GenTable entities.
+func (_u *ProjectUpdate) RemoveTables(v ...*GenTable) *ProjectUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTableIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ProjectUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProjectUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ProjectUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProjectUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProjectUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := project.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+func (_u *ProjectUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(project.Table, project.Columns, sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(project.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(project.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GenConfig(); ok {
+		_spec.SetField(project.FieldGenConfig, field.TypeJSON, value)
+	}
+	if _u.mutation.GenConfigCleared() {
+		_spec.ClearField(project.FieldGenConfig, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PackageConfig(); ok {
+		_spec.SetField(project.FieldPackageConfig, field.TypeJSON, value)
+	}
+	if _u.mutation.PackageConfigCleared() {
+		_spec.ClearField(project.FieldPackageConfig, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DeployConfig(); ok {
+		_spec.SetField(project.FieldDeployConfig, field.TypeJSON, value)
+	}
+	if _u.mutation.DeployConfigCleared() {
+		_spec.ClearField(project.FieldDeployConfig, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Vars(); ok {
+		_spec.SetField(project.FieldVars, field.TypeJSON, value)
+	}
+	if _u.mutation.VarsCleared() {
+		_spec.ClearField(project.FieldVars, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(project.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.TablesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TablesTable,
+			Columns: []string{project.TablesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gentable.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTablesIDs(); len(nodes) > 0 && !_u.mutation.TablesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TablesTable,
+			Columns: []string{project.TablesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gentable.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TablesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TablesTable,
+			Columns: []string{project.TablesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gentable.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{project.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProjectUpdateOne is the builder for updating a single Project entity.
+type ProjectUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProjectMutation
+}
+
+// SetName sets the "name" field.
+func (_u *ProjectUpdateOne) SetName(v string) *ProjectUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableName(v *string) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ProjectUpdateOne) SetDescription(v string) *ProjectUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableDescription(v *string) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetGenConfig sets the "gen_config" field.
+func (_u *ProjectUpdateOne) SetGenConfig(v gencode.GenConfig) *ProjectUpdateOne {
+	_u.mutation.SetGenConfig(v)
+	return _u
+}
+
+// SetNillableGenConfig sets the "gen_config" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableGenConfig(v *gencode.GenConfig) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetGenConfig(*v)
+	}
+	return _u
+}
+
+// ClearGenConfig clears the value of the "gen_config" field.
+func (_u *ProjectUpdateOne) ClearGenConfig() *ProjectUpdateOne {
+	_u.mutation.ClearGenConfig()
+	return _u
+}
+
+// SetPackageConfig sets the "package_config" field.
+func (_u *ProjectUpdateOne) SetPackageConfig(v gencode.PackageConfig) *ProjectUpdateOne {
+	_u.mutation.SetPackageConfig(v)
+	return _u
+}
+
+// SetNillablePackageConfig sets the "package_config" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillablePackageConfig(v *gencode.PackageConfig) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetPackageConfig(*v)
+	}
+	return _u
+}
+
+// ClearPackageConfig clears the value of the "package_config" field.
+func (_u *ProjectUpdateOne) ClearPackageConfig() *ProjectUpdateOne {
+	_u.mutation.ClearPackageConfig()
+	return _u
+}
+
+// SetDeployConfig sets the "deploy_config" field.
+func (_u *ProjectUpdateOne) SetDeployConfig(v gencode.DeployConfig) *ProjectUpdateOne {
+	_u.mutation.SetDeployConfig(v)
+	return _u
+}
+
+// SetNillableDeployConfig sets the "deploy_config" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableDeployConfig(v *gencode.DeployConfig) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetDeployConfig(*v)
+	}
+	return _u
+}
+
+// ClearDeployConfig clears the value of the "deploy_config" field.
+func (_u *ProjectUpdateOne) ClearDeployConfig() *ProjectUpdateOne {
+	_u.mutation.ClearDeployConfig()
+	return _u
+}
+
+// SetVars sets the "vars" field.
+func (_u *ProjectUpdateOne) SetVars(v map[string]interface{}) *ProjectUpdateOne {
+	_u.mutation.SetVars(v)
+	return _u
+}
+
+// ClearVars clears the value of the "vars" field.
+func (_u *ProjectUpdateOne) ClearVars() *ProjectUpdateOne {
+	_u.mutation.ClearVars()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ProjectUpdateOne) SetUpdateTime(v time.Time) *ProjectUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// AddTableIDs adds the "tables" edge to the GenTable entity by IDs.
+func (_u *ProjectUpdateOne) AddTableIDs(ids ...int64) *ProjectUpdateOne {
+	_u.mutation.AddTableIDs(ids...)
+	return _u
+}
+
+// AddTables adds the "tables" edges to the GenTable entity.
+func (_u *ProjectUpdateOne) AddTables(v ...*GenTable) *ProjectUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTableIDs(ids...)
+}
+
+// Mutation returns the ProjectMutation object of the builder.
+func (_u *ProjectUpdateOne) Mutation() *ProjectMutation {
+	return _u.mutation
+}
+
+// ClearTables clears all "tables" edges to the GenTable entity.
+func (_u *ProjectUpdateOne) ClearTables() *ProjectUpdateOne {
+	_u.mutation.ClearTables()
+	return _u
+}
+
+// RemoveTableIDs removes the "tables" edge to GenTable entities by IDs.
+func (_u *ProjectUpdateOne) RemoveTableIDs(ids ...int64) *ProjectUpdateOne {
+	_u.mutation.RemoveTableIDs(ids...)
+	return _u
+}
+
+// RemoveTables removes "tables" edges to GenTable entities.
+func (_u *ProjectUpdateOne) RemoveTables(v ...*GenTable) *ProjectUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTableIDs(ids...)
+}
+
+// Where appends a list predicates to the ProjectUpdate builder.
+func (_u *ProjectUpdateOne) Where(ps ...predicate.Project) *ProjectUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ProjectUpdateOne) Select(field string, fields ...string) *ProjectUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Project entity.
+func (_u *ProjectUpdateOne) Save(ctx context.Context) (*Project, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProjectUpdateOne) SaveX(ctx context.Context) *Project {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ProjectUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProjectUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProjectUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := project.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+func (_u *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err error) {
+	_spec := sqlgraph.NewUpdateSpec(project.Table, project.Columns, sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Project.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, project.FieldID)
+		for _, f := range fields {
+			if !project.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != project.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(project.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(project.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GenConfig(); ok {
+		_spec.SetField(project.FieldGenConfig, field.TypeJSON, value)
+	}
+	if _u.mutation.GenConfigCleared() {
+		_spec.ClearField(project.FieldGenConfig, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PackageConfig(); ok {
+		_spec.SetField(project.FieldPackageConfig, field.TypeJSON, value)
+	}
+	if _u.mutation.PackageConfigCleared() {
+		_spec.ClearField(project.FieldPackageConfig, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DeployConfig(); ok {
+		_spec.SetField(project.FieldDeployConfig, field.TypeJSON, value)
+	}
+	if _u.mutation.DeployConfigCleared() {
+		_spec.ClearField(project.FieldDeployConfig, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Vars(); ok {
+		_spec.SetField(project.FieldVars, field.TypeJSON, value)
+	}
+	if _u.mutation.VarsCleared() {
+		_spec.ClearField(project.FieldVars, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(project.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.TablesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TablesTable,
+			Columns: []string{project.TablesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gentable.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTablesIDs(); len(nodes) > 0 && !_u.mutation.TablesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TablesTable,
+			Columns: []string{project.TablesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gentable.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TablesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TablesTable,
+			Columns: []string{project.TablesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gentable.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Project{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{project.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
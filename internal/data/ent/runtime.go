@@ -6,6 +6,9 @@ import (
 	"time"
 
 	"gen_code/internal/data/ent/admin"
+	"gen_code/internal/data/ent/gencolumn"
+	"gen_code/internal/data/ent/gentable"
+	"gen_code/internal/data/ent/project"
 	"gen_code/internal/data/ent/schema"
 )
 
@@ -45,4 +48,134 @@ func init() {
 	admin.DefaultUpdateTime = adminDescUpdateTime.Default.(func() time.Time)
 	// admin.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
 	admin.UpdateDefaultUpdateTime = adminDescUpdateTime.UpdateDefault.(func() time.Time)
+	gencolumnFields := schema.GenColumn{}.Fields()
+	_ = gencolumnFields
+	// gencolumnDescColumnName is the schema descriptor for column_name field.
+	gencolumnDescColumnName := gencolumnFields[2].Descriptor()
+	// gencolumn.DefaultColumnName holds the default value on creation for the column_name field.
+	gencolumn.DefaultColumnName = gencolumnDescColumnName.Default.(string)
+	// gencolumnDescColumnType is the schema descriptor for column_type field.
+	gencolumnDescColumnType := gencolumnFields[3].Descriptor()
+	// gencolumn.DefaultColumnType holds the default value on creation for the column_type field.
+	gencolumn.DefaultColumnType = gencolumnDescColumnType.Default.(string)
+	// gencolumnDescColumnComment is the schema descriptor for column_comment field.
+	gencolumnDescColumnComment := gencolumnFields[4].Descriptor()
+	// gencolumn.DefaultColumnComment holds the default value on creation for the column_comment field.
+	gencolumn.DefaultColumnComment = gencolumnDescColumnComment.Default.(string)
+	// gencolumnDescIsNullable is the schema descriptor for is_nullable field.
+	gencolumnDescIsNullable := gencolumnFields[5].Descriptor()
+	// gencolumn.DefaultIsNullable holds the default value on creation for the is_nullable field.
+	gencolumn.DefaultIsNullable = gencolumnDescIsNullable.Default.(bool)
+	// gencolumnDescIsPrimaryKey is the schema descriptor for is_primary_key field.
+	gencolumnDescIsPrimaryKey := gencolumnFields[6].Descriptor()
+	// gencolumn.DefaultIsPrimaryKey holds the default value on creation for the is_primary_key field.
+	gencolumn.DefaultIsPrimaryKey = gencolumnDescIsPrimaryKey.Default.(bool)
+	// gencolumnDescGoType is the schema descriptor for go_type field.
+	gencolumnDescGoType := gencolumnFields[7].Descriptor()
+	// gencolumn.DefaultGoType holds the default value on creation for the go_type field.
+	gencolumn.DefaultGoType = gencolumnDescGoType.Default.(string)
+	// gencolumnDescJavaType is the schema descriptor for java_type field.
+	gencolumnDescJavaType := gencolumnFields[8].Descriptor()
+	// gencolumn.DefaultJavaType holds the default value on creation for the java_type field.
+	gencolumn.DefaultJavaType = gencolumnDescJavaType.Default.(string)
+	// gencolumnDescFieldName is the schema descriptor for field_name field.
+	gencolumnDescFieldName := gencolumnFields[9].Descriptor()
+	// gencolumn.DefaultFieldName holds the default value on creation for the field_name field.
+	gencolumn.DefaultFieldName = gencolumnDescFieldName.Default.(string)
+	// gencolumnDescHideInList is the schema descriptor for hide_in_list field.
+	gencolumnDescHideInList := gencolumnFields[10].Descriptor()
+	// gencolumn.DefaultHideInList holds the default value on creation for the hide_in_list field.
+	gencolumn.DefaultHideInList = gencolumnDescHideInList.Default.(bool)
+	// gencolumnDescReadOnly is the schema descriptor for read_only field.
+	gencolumnDescReadOnly := gencolumnFields[11].Descriptor()
+	// gencolumn.DefaultReadOnly holds the default value on creation for the read_only field.
+	gencolumn.DefaultReadOnly = gencolumnDescReadOnly.Default.(bool)
+	// gencolumnDescQueryType is the schema descriptor for query_type field.
+	gencolumnDescQueryType := gencolumnFields[12].Descriptor()
+	// gencolumn.DefaultQueryType holds the default value on creation for the query_type field.
+	gencolumn.DefaultQueryType = gencolumnDescQueryType.Default.(string)
+	// gencolumnDescHTMLType is the schema descriptor for html_type field.
+	gencolumnDescHTMLType := gencolumnFields[13].Descriptor()
+	// gencolumn.DefaultHTMLType holds the default value on creation for the html_type field.
+	gencolumn.DefaultHTMLType = gencolumnDescHTMLType.Default.(string)
+	// gencolumnDescDictType is the schema descriptor for dict_type field.
+	gencolumnDescDictType := gencolumnFields[14].Descriptor()
+	// gencolumn.DefaultDictType holds the default value on creation for the dict_type field.
+	gencolumn.DefaultDictType = gencolumnDescDictType.Default.(string)
+	// gencolumnDescFill is the schema descriptor for fill field.
+	gencolumnDescFill := gencolumnFields[16].Descriptor()
+	// gencolumn.DefaultFill holds the default value on creation for the fill field.
+	gencolumn.DefaultFill = gencolumnDescFill.Default.(string)
+	// gencolumnDescLogicDelete is the schema descriptor for logic_delete field.
+	gencolumnDescLogicDelete := gencolumnFields[17].Descriptor()
+	// gencolumn.DefaultLogicDelete holds the default value on creation for the logic_delete field.
+	gencolumn.DefaultLogicDelete = gencolumnDescLogicDelete.Default.(bool)
+	// gencolumnDescVersion is the schema descriptor for version field.
+	gencolumnDescVersion := gencolumnFields[18].Descriptor()
+	// gencolumn.DefaultVersion holds the default value on creation for the version field.
+	gencolumn.DefaultVersion = gencolumnDescVersion.Default.(bool)
+	// gencolumnDescLength is the schema descriptor for length field.
+	gencolumnDescLength := gencolumnFields[19].Descriptor()
+	// gencolumn.DefaultLength holds the default value on creation for the length field.
+	gencolumn.DefaultLength = gencolumnDescLength.Default.(int)
+	// gencolumnDescSensitive is the schema descriptor for sensitive field.
+	gencolumnDescSensitive := gencolumnFields[20].Descriptor()
+	// gencolumn.DefaultSensitive holds the default value on creation for the sensitive field.
+	gencolumn.DefaultSensitive = gencolumnDescSensitive.Default.(bool)
+	// gencolumnDescScale is the schema descriptor for scale field.
+	gencolumnDescScale := gencolumnFields[21].Descriptor()
+	// gencolumn.DefaultScale holds the default value on creation for the scale field.
+	gencolumn.DefaultScale = gencolumnDescScale.Default.(int)
+	// gencolumnDescDefaultValue is the schema descriptor for default_value field.
+	gencolumnDescDefaultValue := gencolumnFields[22].Descriptor()
+	// gencolumn.DefaultDefaultValue holds the default value on creation for the default_value field.
+	gencolumn.DefaultDefaultValue = gencolumnDescDefaultValue.Default.(string)
+	// gencolumnDescAutoIncrement is the schema descriptor for auto_increment field.
+	gencolumnDescAutoIncrement := gencolumnFields[24].Descriptor()
+	// gencolumn.DefaultAutoIncrement holds the default value on creation for the auto_increment field.
+	gencolumn.DefaultAutoIncrement = gencolumnDescAutoIncrement.Default.(bool)
+	// gencolumnDescSort is the schema descriptor for sort field.
+	gencolumnDescSort := gencolumnFields[25].Descriptor()
+	// gencolumn.DefaultSort holds the default value on creation for the sort field.
+	gencolumn.DefaultSort = gencolumnDescSort.Default.(int)
+	gentableFields := schema.GenTable{}.Fields()
+	_ = gentableFields
+	// gentableDescTableName is the schema descriptor for table_name field.
+	gentableDescTableName := gentableFields[2].Descriptor()
+	// gentable.DefaultTableName holds the default value on creation for the table_name field.
+	gentable.DefaultTableName = gentableDescTableName.Default.(string)
+	// gentableDescTableComment is the schema descriptor for table_comment field.
+	gentableDescTableComment := gentableFields[3].Descriptor()
+	// gentable.DefaultTableComment holds the default value on creation for the table_comment field.
+	gentable.DefaultTableComment = gentableDescTableComment.Default.(string)
+	// gentableDescCreateTime is the schema descriptor for create_time field.
+	gentableDescCreateTime := gentableFields[6].Descriptor()
+	// gentable.DefaultCreateTime holds the default value on creation for the create_time field.
+	gentable.DefaultCreateTime = gentableDescCreateTime.Default.(func() time.Time)
+	// gentableDescUpdateTime is the schema descriptor for update_time field.
+	gentableDescUpdateTime := gentableFields[7].Descriptor()
+	// gentable.DefaultUpdateTime holds the default value on creation for the update_time field.
+	gentable.DefaultUpdateTime = gentableDescUpdateTime.Default.(func() time.Time)
+	// gentable.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	gentable.UpdateDefaultUpdateTime = gentableDescUpdateTime.UpdateDefault.(func() time.Time)
+	projectFields := schema.Project{}.Fields()
+	_ = projectFields
+	// projectDescName is the schema descriptor for name field.
+	projectDescName := projectFields[1].Descriptor()
+	// project.DefaultName holds the default value on creation for the name field.
+	project.DefaultName = projectDescName.Default.(string)
+	// projectDescDescription is the schema descriptor for description field.
+	projectDescDescription := projectFields[2].Descriptor()
+	// project.DefaultDescription holds the default value on creation for the description field.
+	project.DefaultDescription = projectDescDescription.Default.(string)
+	// projectDescCreateTime is the schema descriptor for create_time field.
+	projectDescCreateTime := projectFields[7].Descriptor()
+	// project.DefaultCreateTime holds the default value on creation for the create_time field.
+	project.DefaultCreateTime = projectDescCreateTime.Default.(func() time.Time)
+	// projectDescUpdateTime is the schema descriptor for update_time field.
+	projectDescUpdateTime := projectFields[8].Descriptor()
+	// project.DefaultUpdateTime holds the default value on creation for the update_time field.
+	project.DefaultUpdateTime = projectDescUpdateTime.Default.(func() time.Time)
+	// project.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	project.UpdateDefaultUpdateTime = projectDescUpdateTime.UpdateDefault.(func() time.Time)
 }
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// GenColumn holds the schema definition for the GenColumn entity.
+type GenColumn struct {
+	ent.Schema
+}
+
+// Fields of the GenColumn.
+func (GenColumn) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable(),
+		field.Int64("table_id"),
+		field.String("column_name").Default(""),
+		field.String("column_type").Default(""),
+		field.String("column_comment").Default(""),
+		field.Bool("is_nullable").Default(false),
+		field.Bool("is_primary_key").Default(false),
+		field.String("go_type").Default(""),
+		field.String("java_type").Default(""),
+		field.String("field_name").Default(""),
+		field.Bool("hide_in_list").Default(false),
+		field.Bool("read_only").Default(false),
+		field.String("query_type").Default(""),
+		field.String("html_type").Default(""),
+		field.String("dict_type").Default(""),
+		field.Bool("required").Optional().Nillable(),
+		field.String("fill").Default(""),
+		field.Bool("logic_delete").Default(false),
+		field.Bool("version").Default(false),
+		field.Int("length").Default(0),
+		field.Bool("sensitive").Default(false),
+		field.Int("scale").Default(0),
+		field.String("default_value").Default(""),
+		field.JSON("enum_values", []string{}).Optional(),
+		field.Bool("auto_increment").Default(false),
+		field.Int("sort").Default(0),
+	}
+}
+
+// Edges of the GenColumn.
+func (GenColumn) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("gen_table", GenTable.Type).
+			Ref("columns").
+			Field("table_id").
+			Unique().
+			Required(),
+	}
+}
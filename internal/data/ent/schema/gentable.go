@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"gen_code/pkg/gencode"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GenTable holds the schema definition for the GenTable entity.
+type GenTable struct {
+	ent.Schema
+}
+
+// Fields of the GenTable.
+func (GenTable) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable(),
+		field.Int64("project_id"),
+		field.String("table_name").Default(""),
+		field.String("table_comment").Default(""),
+		field.JSON("indexes", []gencode.Index{}).Optional(),
+		field.JSON("foreign_keys", []gencode.ForeignKey{}).Optional(),
+		field.Time("create_time").Default(time.Now).Immutable(),
+		field.Time("update_time").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the GenTable.
+func (GenTable) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("tables").
+			Field("project_id").
+			Unique().
+			Required(),
+		edge.To("columns", GenColumn.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the GenTable.
+func (GenTable) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "table_name").Unique(),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"gen_code/pkg/gencode"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Project holds the schema definition for the Project entity.
+type Project struct {
+	ent.Schema
+}
+
+// Fields of the Project.
+func (Project) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable(),
+		field.String("name").Default(""),
+		field.String("description").Default(""),
+		field.JSON("gen_config", gencode.GenConfig{}).Optional(),
+		field.JSON("package_config", gencode.PackageConfig{}).Optional(),
+		field.JSON("deploy_config", gencode.DeployConfig{}).Optional(),
+		field.JSON("vars", map[string]any{}).Optional(),
+		field.Time("create_time").Default(time.Now).Immutable(),
+		field.Time("update_time").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Project.
+func (Project) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("tables", GenTable.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
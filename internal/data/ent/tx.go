@@ -16,6 +16,12 @@ type Tx struct {
 	config
 	// Admin is the client for interacting with the Admin builders.
 	Admin *AdminClient
+	// GenColumn is the client for interacting with the GenColumn builders.
+	GenColumn *GenColumnClient
+	// GenTable is the client for interacting with the GenTable builders.
+	GenTable *GenTableClient
+	// Project is the client for interacting with the Project builders.
+	Project *ProjectClient
 
 	// lazily loaded.
 	client     *Client
@@ -148,6 +154,9 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Admin = NewAdminClient(tx.config)
+	tx.GenColumn = NewGenColumnClient(tx.config)
+	tx.GenTable = NewGenTableClient(tx.config)
+	tx.Project = NewProjectClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
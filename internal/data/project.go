@@ -0,0 +1,330 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"gen_code/internal/biz"
+	"gen_code/internal/data/ent"
+	"gen_code/internal/data/ent/gencolumn"
+	"gen_code/internal/data/ent/gentable"
+	"gen_code/pkg/gencode"
+
+	"github.com/go-kratos/aip-go/ents"
+)
+
+func convertProject(po *ent.Project) *biz.Project {
+	return &biz.Project{
+		ID:          po.ID,
+		Name:        po.Name,
+		Description: po.Description,
+		Config: gencode.Config{
+			ProjectName:   po.Name,
+			GenConfig:     po.GenConfig,
+			PackageConfig: po.PackageConfig,
+			DeployConfig:  po.DeployConfig,
+			Vars:          po.Vars,
+		},
+		CreateTime: po.CreateTime,
+		UpdateTime: po.UpdateTime,
+	}
+}
+
+func convertProjectTable(po *ent.GenTable) *biz.ProjectTable {
+	table := gencode.Table{
+		TableName:    po.TableName,
+		TableComment: po.TableComment,
+		Fields:       make([]gencode.Field, 0, len(po.Edges.Columns)),
+		Indexes:      po.Indexes,
+		ForeignKeys:  po.ForeignKeys,
+	}
+	for _, c := range po.Edges.Columns {
+		table.Fields = append(table.Fields, gencode.Field{
+			ColumnName:    c.ColumnName,
+			ColumnType:    c.ColumnType,
+			ColumnComment: c.ColumnComment,
+			IsNullable:    c.IsNullable,
+			IsPrimaryKey:  c.IsPrimaryKey,
+			GoType:        c.GoType,
+			JavaType:      c.JavaType,
+			FieldName:     c.FieldName,
+			HideInList:    c.HideInList,
+			ReadOnly:      c.ReadOnly,
+			QueryType:     c.QueryType,
+			HtmlType:      c.HTMLType,
+			DictType:      c.DictType,
+			Required:      c.Required,
+			Fill:          c.Fill,
+			LogicDelete:   c.LogicDelete,
+			Version:       c.Version,
+			Length:        c.Length,
+			Sensitive:     c.Sensitive,
+			Scale:         c.Scale,
+			DefaultValue:  c.DefaultValue,
+			EnumValues:    c.EnumValues,
+			AutoIncrement: c.AutoIncrement,
+		})
+	}
+	return &biz.ProjectTable{
+		ID:         po.ID,
+		ProjectID:  po.ProjectID,
+		Table:      gencode.CompleteTable(table),
+		CreateTime: po.CreateTime,
+		UpdateTime: po.UpdateTime,
+	}
+}
+
+type projectRepo struct {
+	data *Data
+}
+
+// NewProjectRepo creates a new ProjectRepo instance.
+func NewProjectRepo(data *Data) biz.ProjectRepo {
+	return &projectRepo{
+		data: data,
+	}
+}
+
+func (r *projectRepo) FindByID(ctx context.Context, id int64) (*biz.Project, error) {
+	po, err := r.data.db.Project.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrProjectNotFound
+		}
+		return nil, err
+	}
+	return convertProject(po), nil
+}
+
+func (r *projectRepo) ListProjects(ctx context.Context, opts ...biz.ListOption) ([]*biz.Project, error) {
+	o := biz.ListOptions{Limit: 20}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	pos, err := r.data.db.Project.Query().
+		Where(ents.ApplyFilter(o.Filter)).
+		Order(ents.ApplyOrderBy(o.OrderBy)).
+		Offset(o.Offset).
+		Limit(o.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var projects []*biz.Project
+	for _, po := range pos {
+		projects = append(projects, convertProject(po))
+	}
+	return projects, nil
+}
+
+func (r *projectRepo) CreateProject(ctx context.Context, project *biz.Project) (*biz.Project, error) {
+	po, err := r.data.db.Project.Create().
+		SetName(project.Name).
+		SetDescription(project.Description).
+		SetGenConfig(project.Config.GenConfig).
+		SetPackageConfig(project.Config.PackageConfig).
+		SetDeployConfig(project.Config.DeployConfig).
+		SetVars(project.Config.Vars).
+		SetCreateTime(time.Now()).
+		SetUpdateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertProject(po), nil
+}
+
+func (r *projectRepo) UpdateProject(ctx context.Context, project *biz.Project) (*biz.Project, error) {
+	po, err := r.data.db.Project.UpdateOneID(project.ID).
+		SetName(project.Name).
+		SetDescription(project.Description).
+		SetGenConfig(project.Config.GenConfig).
+		SetPackageConfig(project.Config.PackageConfig).
+		SetDeployConfig(project.Config.DeployConfig).
+		SetVars(project.Config.Vars).
+		SetUpdateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrProjectNotFound
+		}
+		return nil, err
+	}
+	return convertProject(po), nil
+}
+
+func (r *projectRepo) DeleteProject(ctx context.Context, id int64) error {
+	err := r.data.db.Project.DeleteOneID(id).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return biz.ErrProjectNotFound
+	}
+	return err
+}
+
+func (r *projectRepo) FindTableByID(ctx context.Context, id int64) (*biz.ProjectTable, error) {
+	po, err := r.data.db.GenTable.Query().
+		Where(gentable.ID(id)).
+		WithColumns(func(q *ent.GenColumnQuery) {
+			q.Order(ent.Asc(gencolumn.FieldSort))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrTableNotFound
+		}
+		return nil, err
+	}
+	return convertProjectTable(po), nil
+}
+
+func (r *projectRepo) ListTables(ctx context.Context, projectID int64) ([]*biz.ProjectTable, error) {
+	pos, err := r.data.db.GenTable.Query().
+		Where(gentable.ProjectID(projectID)).
+		WithColumns(func(q *ent.GenColumnQuery) {
+			q.Order(ent.Asc(gencolumn.FieldSort))
+		}).
+		Order(ent.Asc(gentable.FieldTableName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var tables []*biz.ProjectTable
+	for _, po := range pos {
+		tables = append(tables, convertProjectTable(po))
+	}
+	return tables, nil
+}
+
+func (r *projectRepo) SaveTables(ctx context.Context, projectID int64, tables []gencode.Table) ([]*biz.ProjectTable, error) {
+	tx, err := r.data.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var ids []int64
+	for _, table := range tables {
+		po, err := tx.GenTable.Query().
+			Where(gentable.ProjectID(projectID), gentable.TableName(table.TableName)).
+			Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			po, err = tx.GenTable.Create().
+				SetProjectID(projectID).
+				SetTableName(table.TableName).
+				SetTableComment(table.TableComment).
+				SetIndexes(table.Indexes).
+				SetForeignKeys(table.ForeignKeys).
+				SetCreateTime(time.Now()).
+				SetUpdateTime(time.Now()).
+				Save(ctx)
+		case err == nil:
+			po, err = po.Update().
+				SetTableComment(table.TableComment).
+				SetIndexes(table.Indexes).
+				SetForeignKeys(table.ForeignKeys).
+				SetUpdateTime(time.Now()).
+				Save(ctx)
+		}
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		if err := saveColumns(ctx, tx, po.ID, table.Fields); err != nil {
+			return nil, rollback(tx, err)
+		}
+		ids = append(ids, po.ID)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	var saved []*biz.ProjectTable
+	for _, id := range ids {
+		table, err := r.FindTableByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		saved = append(saved, table)
+	}
+	return saved, nil
+}
+
+func (r *projectRepo) UpdateTable(ctx context.Context, table *biz.ProjectTable) (*biz.ProjectTable, error) {
+	tx, err := r.data.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.GenTable.UpdateOneID(table.ID).
+		SetTableName(table.Table.TableName).
+		SetTableComment(table.Table.TableComment).
+		SetIndexes(table.Table.Indexes).
+		SetForeignKeys(table.Table.ForeignKeys).
+		SetUpdateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			err = biz.ErrTableNotFound
+		case ent.IsConstraintError(err):
+			// another table of the project already has the new name.
+			err = biz.ErrTableExists
+		}
+		return nil, rollback(tx, err)
+	}
+	if err := saveColumns(ctx, tx, table.ID, table.Table.Fields); err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return r.FindTableByID(ctx, table.ID)
+}
+
+func (r *projectRepo) DeleteTable(ctx context.Context, id int64) error {
+	err := r.data.db.GenTable.DeleteOneID(id).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return biz.ErrTableNotFound
+	}
+	return err
+}
+
+// saveColumns replaces the columns of a table.
+func saveColumns(ctx context.Context, tx *ent.Tx, tableID int64, fields []gencode.Field) error {
+	if _, err := tx.GenColumn.Delete().Where(gencolumn.TableID(tableID)).Exec(ctx); err != nil {
+		return err
+	}
+	builders := make([]*ent.GenColumnCreate, 0, len(fields))
+	for i, f := range fields {
+		builders = append(builders, tx.GenColumn.Create().
+			SetTableID(tableID).
+			SetColumnName(f.ColumnName).
+			SetColumnType(f.ColumnType).
+			SetColumnComment(f.ColumnComment).
+			SetIsNullable(f.IsNullable).
+			SetIsPrimaryKey(f.IsPrimaryKey).
+			SetGoType(f.GoType).
+			SetJavaType(f.JavaType).
+			SetFieldName(f.FieldName).
+			SetHideInList(f.HideInList).
+			SetReadOnly(f.ReadOnly).
+			SetQueryType(f.QueryType).
+			SetHTMLType(f.HtmlType).
+			SetDictType(f.DictType).
+			SetNillableRequired(f.Required).
+			SetFill(f.Fill).
+			SetLogicDelete(f.LogicDelete).
+			SetVersion(f.Version).
+			SetLength(f.Length).
+			SetSensitive(f.Sensitive).
+			SetScale(f.Scale).
+			SetDefaultValue(f.DefaultValue).
+			SetEnumValues(f.EnumValues).
+			SetAutoIncrement(f.AutoIncrement).
+			SetSort(i))
+	}
+	return tx.GenColumn.CreateBulk(builders...).Exec(ctx)
+}
+
+// rollback rolls back the transaction and returns the original error.
+func rollback(tx *ent.Tx, err error) error {
+	_ = tx.Rollback()
+	return err
+}
@@ -0,0 +1,51 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gen_code/internal/biz"
+	"gen_code/internal/data/ent/enttest"
+	"gen_code/pkg/gencode"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestUpdateTableDuplicateName(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer db.Close()
+	repo := NewProjectRepo(&Data{db: db})
+	ctx := context.Background()
+
+	project, err := repo.CreateProject(ctx, &biz.Project{Name: "demo"})
+	if err != nil {
+		t.Fatalf("create project: %v", err)
+	}
+	tables, err := repo.SaveTables(ctx, project.ID, []gencode.Table{
+		{TableName: "sys_user", Fields: []gencode.Field{{ColumnName: "id", ColumnType: "bigint", IsPrimaryKey: true}}},
+		{TableName: "sys_role", Fields: []gencode.Field{{ColumnName: "id", ColumnType: "bigint", IsPrimaryKey: true}}},
+	})
+	if err != nil {
+		t.Fatalf("save tables: %v", err)
+	}
+
+	// renaming a table to the name of another table of the project is a conflict.
+	table := tables[1]
+	table.Table.TableName = "sys_user"
+	if _, err := repo.UpdateTable(ctx, table); !errors.Is(err, biz.ErrTableExists) {
+		t.Fatalf("update table error = %v, expected %v", err, biz.ErrTableExists)
+	}
+	saved, err := repo.FindTableByID(ctx, table.ID)
+	if err != nil {
+		t.Fatalf("find table: %v", err)
+	}
+	if saved.Table.TableName != "sys_role" || len(saved.Table.Fields) != 1 {
+		t.Errorf("table after failed rename = %+v", saved.Table)
+	}
+
+	table.Table.TableName = "sys_menu"
+	if _, err := repo.UpdateTable(ctx, table); err != nil {
+		t.Errorf("update table: %v", err)
+	}
+}
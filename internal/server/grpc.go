@@ -1,6 +1,7 @@
 package server
 
 import (
+	gencodev1 "gen_code/api/gencode/v1"
 	v1 "gen_code/api/kratos/admin/v1"
 	"gen_code/internal/conf"
 	"gen_code/internal/service"
@@ -11,7 +12,7 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, admin *service.AdminService) *grpc.Server {
+func NewGRPCServer(c *conf.Server, admin *service.AdminService, gencode *service.GenCodeService) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
@@ -29,5 +30,6 @@ func NewGRPCServer(c *conf.Server, admin *service.AdminService) *grpc.Server {
 	}
 	srv := grpc.NewServer(opts...)
 	v1.RegisterAdminServiceServer(srv, admin)
+	gencodev1.RegisterGenCodeServiceServer(srv, gencode)
 	return srv
 }
@@ -1,6 +1,7 @@
 package server
 
 import (
+	gencodev1 "gen_code/api/gencode/v1"
 	v1 "gen_code/api/kratos/admin/v1"
 	"gen_code/internal/conf"
 	"gen_code/internal/service"
@@ -12,7 +13,7 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, admin *service.AdminService) *http.Server {
+func NewHTTPServer(c *conf.Server, admin *service.AdminService, gencode *service.GenCodeService) *http.Server {
 	var opts = []http.ServerOption{
 		http.Filter(
 			auth.Middleware(),
@@ -33,5 +34,6 @@ func NewHTTPServer(c *conf.Server, admin *service.AdminService) *http.Server {
 	}
 	srv := http.NewServer(opts...)
 	v1.RegisterAdminServiceHTTPServer(srv, admin)
+	gencodev1.RegisterGenCodeServiceHTTPServer(srv, gencode)
 	return srv
 }
@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+
+	v1 "gen_code/api/gencode/v1"
+	"gen_code/internal/biz"
+	"gen_code/pkg/auth"
+	"gen_code/pkg/gencode"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func convertConfig(m *v1.Config) gencode.Config {
+	return gencode.Config{
+		ProjectName: m.GetProjectName(),
+		GenConfig: gencode.GenConfig{
+			EnableLombok:  m.GetGenConfig().GetEnableLombok(),
+			EnableSwagger: m.GetGenConfig().GetEnableSwagger(),
+			EnableExcel:   m.GetGenConfig().GetEnableExcel(),
+			Author:        m.GetGenConfig().GetAuthor(),
+			Date:          m.GetGenConfig().GetDate(),
+			Target:        m.GetGenConfig().GetTarget(),
+			Conventions: gencode.Conventions{
+				InsertFill:  m.GetGenConfig().GetConventions().GetInsertFill(),
+				UpdateFill:  m.GetGenConfig().GetConventions().GetUpdateFill(),
+				LogicDelete: m.GetGenConfig().GetConventions().GetLogicDelete(),
+				Version:     m.GetGenConfig().GetConventions().GetVersion(),
+			},
+			Migration: m.GetGenConfig().GetMigration(),
+			Dialect:   m.GetGenConfig().GetDialect(),
+			Layout:    m.GetGenConfig().GetLayout(),
+			Profile:   m.GetGenConfig().GetProfile(),
+			CI:        m.GetGenConfig().GetCi(),
+			Auth:      m.GetGenConfig().GetAuth(),
+			Strict:    m.GetGenConfig().GetStrict(),
+			Mock: gencode.MockConfig{
+				Rows: int(m.GetGenConfig().GetMock().GetRows()),
+				Seed: m.GetGenConfig().GetMock().GetSeed(),
+			},
+		},
+		PackageConfig: gencode.PackageConfig{
+			BasePackage:       m.GetPackageConfig().GetBasePackage(),
+			EntityPackage:     m.GetPackageConfig().GetEntityPackage(),
+			MapperPackage:     m.GetPackageConfig().GetMapperPackage(),
+			ServicePackage:    m.GetPackageConfig().GetServicePackage(),
+			ControllerPackage: m.GetPackageConfig().GetControllerPackage(),
+			QueryPackage:      m.GetPackageConfig().GetQueryPackage(),
+			DtoPackage:        m.GetPackageConfig().GetDtoPackage(),
+			VoPackage:         m.GetPackageConfig().GetVoPackage(),
+			ConverterPackage:  m.GetPackageConfig().GetConverterPackage(),
+		},
+		DeployConfig: convertDeployConfig(m.GetDeployConfig()),
+		Vars:         convertVars(m.GetVars()),
+	}
+}
+
+func convertVars(m *structpb.Struct) map[string]any {
+	if m == nil {
+		return nil
+	}
+	return m.AsMap()
+}
+
+func convertDeployConfig(m *v1.DeployConfig) gencode.DeployConfig {
+	c := gencode.DeployConfig{
+		Registry:             m.GetRegistry(),
+		RegistryNamespace:    m.GetRegistryNamespace(),
+		RegistryCredential:   m.GetRegistryCredential(),
+		KubeconfigCredential: m.GetKubeconfigCredential(),
+		GitCredential:        m.GetGitCredential(),
+		Namespace:            m.GetNamespace(),
+		Replicas:             int(m.GetReplicas()),
+		Port:                 int(m.GetPort()),
+		ServicePort:          int(m.GetServicePort()),
+		ServiceType:          m.GetServiceType(),
+		Resources: gencode.DeployResources{
+			CPURequest:    m.GetResources().GetCpuRequest(),
+			MemoryRequest: m.GetResources().GetMemoryRequest(),
+			CPULimit:      m.GetResources().GetCpuLimit(),
+			MemoryLimit:   m.GetResources().GetMemoryLimit(),
+		},
+		Probe: gencode.DeployProbe{
+			Path:                m.GetProbe().GetPath(),
+			InitialDelaySeconds: int(m.GetProbe().GetInitialDelaySeconds()),
+			PeriodSeconds:       int(m.GetProbe().GetPeriodSeconds()),
+			TimeoutSeconds:      int(m.GetProbe().GetTimeoutSeconds()),
+			FailureThreshold:    int(m.GetProbe().GetFailureThreshold()),
+		},
+		Helm: m.GetHelm(),
+	}
+	for _, env := range m.GetEnvironments() {
+		c.Environments = append(c.Environments, gencode.DeployEnvironment{
+			Name:      env.GetName(),
+			Namespace: env.GetNamespace(),
+			Replicas:  int(env.GetReplicas()),
+			Env:       env.GetEnv(),
+		})
+	}
+	return c
+}
+
+func convertTable(m *v1.Table) gencode.Table {
+	table := gencode.Table{
+		TableName:    m.GetTableName(),
+		TableComment: m.GetTableComment(),
+		Fields:       make([]gencode.Field, 0, len(m.GetFields())),
+	}
+	for _, f := range m.GetFields() {
+		table.Fields = append(table.Fields, gencode.Field{
+			ColumnName:    f.ColumnName,
+			ColumnType:    f.ColumnType,
+			ColumnComment: f.ColumnComment,
+			IsNullable:    f.IsNullable,
+			IsPrimaryKey:  f.IsPrimaryKey,
+			GoType:        f.GoType,
+			JavaType:      f.JavaType,
+			FieldName:     f.FieldName,
+			HideInList:    f.HideInList,
+			ReadOnly:      f.ReadOnly,
+			QueryType:     f.QueryType,
+			HtmlType:      f.HtmlType,
+			DictType:      f.DictType,
+			Required:      f.Required,
+			Fill:          f.Fill,
+			LogicDelete:   f.LogicDelete,
+			Version:       f.Version,
+			Length:        int(f.Length),
+			Sensitive:     f.Sensitive,
+			Scale:         int(f.Scale),
+			DefaultValue:  f.DefaultValue,
+			EnumValues:    f.EnumValues,
+			AutoIncrement: f.AutoIncrement,
+		})
+	}
+	for _, index := range m.GetIndexes() {
+		table.Indexes = append(table.Indexes, gencode.Index{
+			Name:    index.Name,
+			Columns: index.Columns,
+			Unique:  index.Unique,
+		})
+	}
+	for _, fk := range m.GetForeignKeys() {
+		table.ForeignKeys = append(table.ForeignKeys, gencode.ForeignKey{
+			Name:       fk.Name,
+			Columns:    fk.Columns,
+			RefTable:   fk.RefTable,
+			RefColumns: fk.RefColumns,
+		})
+	}
+	return table
+}
+
+// GenCodeService is a code generation service.
+type GenCodeService struct {
+	v1.UnimplementedGenCodeServiceServer
+
+	uc      *biz.GenCodeUsecase
+	project *biz.ProjectUsecase
+}
+
+// NewGenCodeService new a code generation service.
+func NewGenCodeService(uc *biz.GenCodeUsecase, project *biz.ProjectUsecase) *GenCodeService {
+	return &GenCodeService{uc: uc, project: project}
+}
+
+// GenerateFiles implements code generation returning the generated files.
+func (s *GenCodeService) GenerateFiles(ctx context.Context, req *v1.GenerateRequest) (*v1.GeneratedFileSet, error) {
+	_, files, err := s.generate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	fileSet := &v1.GeneratedFileSet{
+		Files: make([]*v1.GeneratedFile, 0, len(files)),
+	}
+	for _, file := range files {
+		fileSet.Files = append(fileSet.Files, &v1.GeneratedFile{
+			Path:    file.Path,
+			Size:    int64(len(file.Content)),
+			Content: string(file.Content),
+		})
+	}
+	return fileSet, nil
+}
+
+// GenerateArchive implements code generation returning a zip archive.
+func (s *GenCodeService) GenerateArchive(ctx context.Context, req *v1.GenerateRequest) (*v1.GeneratedArchive, error) {
+	config, files, err := s.generate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	content, err := s.uc.Archive(ctx, files)
+	if err != nil {
+		return nil, err
+	}
+	filename := config.ProjectName
+	if filename == "" {
+		filename = "project"
+	}
+	return &v1.GeneratedArchive{
+		Filename: filename + ".zip",
+		Content:  content,
+	}, nil
+}
+
+// generate authorizes the request and generates code from the tables, ddl or
+// a saved project, returning the config used for generation.
+func (s *GenCodeService) generate(ctx context.Context, req *v1.GenerateRequest) (gencode.Config, []*biz.GeneratedFile, error) {
+	if _, ok := auth.FromContext(ctx); !ok {
+		return gencode.Config{}, nil, auth.ErrUnauthorized
+	}
+	var (
+		config gencode.Config
+		tables []gencode.Table
+		err    error
+	)
+	if req.ProjectId != 0 {
+		if len(req.Tables) > 0 || req.Ddl != "" {
+			return gencode.Config{}, nil, errors.BadRequest("GENCODE", "only one of tables, ddl or project_id can be set")
+		}
+		config, tables, err = s.project.LoadProject(ctx, req.ProjectId)
+		if err != nil {
+			return gencode.Config{}, nil, err
+		}
+	} else {
+		if req.Config == nil {
+			return gencode.Config{}, nil, errors.BadRequest("GENCODE", "config is required")
+		}
+		config = convertConfig(req.Config)
+		tables, err = s.parseTables(ctx, req.Tables, req.Ddl)
+		if err != nil {
+			return gencode.Config{}, nil, err
+		}
+	}
+	files, err := s.uc.Generate(ctx, config, tables)
+	if err != nil {
+		return gencode.Config{}, nil, err
+	}
+	return config, files, nil
+}
+
+// parseTables converts the tables or parses the ddl, only one of them can be set.
+func (s *GenCodeService) parseTables(ctx context.Context, tables []*v1.Table, ddl string) ([]gencode.Table, error) {
+	if len(tables) > 0 && ddl != "" {
+		return nil, errors.BadRequest("GENCODE", "only one of tables or ddl can be set")
+	}
+	if ddl != "" {
+		return s.uc.ParseDDL(ctx, ddl)
+	}
+	parsed := make([]gencode.Table, 0, len(tables))
+	for _, table := range tables {
+		parsed = append(parsed, convertTable(table))
+	}
+	return parsed, nil
+}
@@ -0,0 +1,363 @@
+package service
+
+import (
+	"context"
+
+	v1 "gen_code/api/gencode/v1"
+	"gen_code/internal/biz"
+	"gen_code/pkg/auth"
+	"gen_code/pkg/gencode"
+
+	"go.einride.tech/aip/fieldmask"
+	"go.einride.tech/aip/filtering"
+	"go.einride.tech/aip/ordering"
+	"go.einride.tech/aip/pagination"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertConfigProto(c gencode.Config) *v1.Config {
+	return &v1.Config{
+		ProjectName: c.ProjectName,
+		GenConfig: &v1.GenConfig{
+			EnableLombok:  c.GenConfig.EnableLombok,
+			EnableSwagger: c.GenConfig.EnableSwagger,
+			EnableExcel:   c.GenConfig.EnableExcel,
+			Author:        c.GenConfig.Author,
+			Date:          c.GenConfig.Date,
+			Target:        c.GenConfig.Target,
+			Conventions: &v1.Conventions{
+				InsertFill:  c.GenConfig.Conventions.InsertFill,
+				UpdateFill:  c.GenConfig.Conventions.UpdateFill,
+				LogicDelete: c.GenConfig.Conventions.LogicDelete,
+				Version:     c.GenConfig.Conventions.Version,
+			},
+			Migration: c.GenConfig.Migration,
+			Dialect:   c.GenConfig.Dialect,
+			Layout:    c.GenConfig.Layout,
+			Profile:   c.GenConfig.Profile,
+			Ci:        c.GenConfig.CI,
+			Auth:      c.GenConfig.Auth,
+			Strict:    c.GenConfig.Strict,
+			Mock: &v1.MockConfig{
+				Rows: int32(c.GenConfig.Mock.Rows),
+				Seed: c.GenConfig.Mock.Seed,
+			},
+		},
+		PackageConfig: &v1.PackageConfig{
+			BasePackage:       c.PackageConfig.BasePackage,
+			EntityPackage:     c.PackageConfig.EntityPackage,
+			MapperPackage:     c.PackageConfig.MapperPackage,
+			ServicePackage:    c.PackageConfig.ServicePackage,
+			ControllerPackage: c.PackageConfig.ControllerPackage,
+			QueryPackage:      c.PackageConfig.QueryPackage,
+			DtoPackage:        c.PackageConfig.DtoPackage,
+			VoPackage:         c.PackageConfig.VoPackage,
+			ConverterPackage:  c.PackageConfig.ConverterPackage,
+		},
+		DeployConfig: convertDeployConfigProto(c.DeployConfig),
+		Vars:         convertVarsProto(c.Vars),
+	}
+}
+
+func convertVarsProto(vars map[string]any) *structpb.Struct {
+	if vars == nil {
+		return nil
+	}
+	// The variables are stored as JSON, so they always convert.
+	m, _ := structpb.NewStruct(vars)
+	return m
+}
+
+func convertDeployConfigProto(c gencode.DeployConfig) *v1.DeployConfig {
+	m := &v1.DeployConfig{
+		Registry:             c.Registry,
+		RegistryNamespace:    c.RegistryNamespace,
+		RegistryCredential:   c.RegistryCredential,
+		KubeconfigCredential: c.KubeconfigCredential,
+		GitCredential:        c.GitCredential,
+		Namespace:            c.Namespace,
+		Replicas:             int32(c.Replicas),
+		Port:                 int32(c.Port),
+		ServicePort:          int32(c.ServicePort),
+		ServiceType:          c.ServiceType,
+		Resources: &v1.DeployResources{
+			CpuRequest:    c.Resources.CPURequest,
+			MemoryRequest: c.Resources.MemoryRequest,
+			CpuLimit:      c.Resources.CPULimit,
+			MemoryLimit:   c.Resources.MemoryLimit,
+		},
+		Probe: &v1.DeployProbe{
+			Path:                c.Probe.Path,
+			InitialDelaySeconds: int32(c.Probe.InitialDelaySeconds),
+			PeriodSeconds:       int32(c.Probe.PeriodSeconds),
+			TimeoutSeconds:      int32(c.Probe.TimeoutSeconds),
+			FailureThreshold:    int32(c.Probe.FailureThreshold),
+		},
+		Helm: c.Helm,
+	}
+	for _, env := range c.Environments {
+		m.Environments = append(m.Environments, &v1.DeployEnvironment{
+			Name:      env.Name,
+			Namespace: env.Namespace,
+			Replicas:  int32(env.Replicas),
+			Env:       env.Env,
+		})
+	}
+	return m
+}
+
+func convertTableProto(t gencode.Table) *v1.Table {
+	table := &v1.Table{
+		TableName:    t.TableName,
+		TableComment: t.TableComment,
+		Fields:       make([]*v1.Field, 0, len(t.Fields)),
+	}
+	for _, f := range t.Fields {
+		table.Fields = append(table.Fields, &v1.Field{
+			ColumnName:    f.ColumnName,
+			ColumnType:    f.ColumnType,
+			ColumnComment: f.ColumnComment,
+			IsNullable:    f.IsNullable,
+			IsPrimaryKey:  f.IsPrimaryKey,
+			GoType:        f.GoType,
+			JavaType:      f.JavaType,
+			FieldName:     f.FieldName,
+			HideInList:    f.HideInList,
+			ReadOnly:      f.ReadOnly,
+			QueryType:     f.QueryType,
+			HtmlType:      f.HtmlType,
+			DictType:      f.DictType,
+			Required:      f.Required,
+			Fill:          f.Fill,
+			LogicDelete:   f.LogicDelete,
+			Version:       f.Version,
+			Length:        int32(f.Length),
+			Sensitive:     f.Sensitive,
+			Scale:         int32(f.Scale),
+			DefaultValue:  f.DefaultValue,
+			EnumValues:    f.EnumValues,
+			AutoIncrement: f.AutoIncrement,
+		})
+	}
+	for _, index := range t.Indexes {
+		table.Indexes = append(table.Indexes, &v1.Index{
+			Name:    index.Name,
+			Columns: index.Columns,
+			Unique:  index.Unique,
+		})
+	}
+	for _, fk := range t.ForeignKeys {
+		table.ForeignKeys = append(table.ForeignKeys, &v1.ForeignKey{
+			Name:       fk.Name,
+			Columns:    fk.Columns,
+			RefTable:   fk.RefTable,
+			RefColumns: fk.RefColumns,
+		})
+	}
+	return table
+}
+
+func convertProject(m *biz.Project) *v1.Project {
+	return &v1.Project{
+		Id:          m.ID,
+		Name:        m.Name,
+		Description: m.Description,
+		Config:      convertConfigProto(m.Config),
+		CreateTime:  timestamppb.New(m.CreateTime),
+		UpdateTime:  timestamppb.New(m.UpdateTime),
+	}
+}
+
+func convertProjectTable(m *biz.ProjectTable) *v1.ProjectTable {
+	return &v1.ProjectTable{
+		Id:         m.ID,
+		ProjectId:  m.ProjectID,
+		Table:      convertTableProto(m.Table),
+		CreateTime: timestamppb.New(m.CreateTime),
+		UpdateTime: timestamppb.New(m.UpdateTime),
+	}
+}
+
+func convertProjectTableSet(tables []*biz.ProjectTable) *v1.ProjectTableSet {
+	tableSet := &v1.ProjectTableSet{
+		Tables: make([]*v1.ProjectTable, 0, len(tables)),
+	}
+	for _, table := range tables {
+		tableSet.Tables = append(tableSet.Tables, convertProjectTable(table))
+	}
+	return tableSet
+}
+
+// ListProjects implements project listing with filtering, ordering, and pagination.
+func (s *GenCodeService) ListProjects(ctx context.Context, req *v1.ListProjectsRequest) (*v1.ProjectSet, error) {
+	if _, ok := auth.FromContext(ctx); !ok {
+		return nil, auth.ErrUnauthorized
+	}
+	declarations, err := filtering.NewDeclarations(
+		filtering.DeclareStandardFunctions(),
+		filtering.DeclareIdent("name", filtering.TypeString),
+		filtering.DeclareIdent("create_time", filtering.TypeTimestamp),
+		filtering.DeclareIdent("update_time", filtering.TypeTimestamp),
+	)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := filtering.ParseFilter(req, declarations)
+	if err != nil {
+		return nil, err
+	}
+	pageToken, err := pagination.ParsePageToken(req)
+	if err != nil {
+		return nil, err
+	}
+	orderBy, err := ordering.ParseOrderBy(req)
+	if err != nil {
+		return nil, err
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	projects, err := s.project.ListProjects(ctx,
+		biz.ListFilter(filter),
+		biz.ListOrderBy(orderBy),
+		biz.ListLimit(int(req.PageSize)),
+		biz.ListOffset(int(pageToken.Offset)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	projectSet := &v1.ProjectSet{
+		Projects: make([]*v1.Project, 0, len(projects)),
+	}
+	if len(projects) >= int(req.PageSize) {
+		projectSet.NextPageToken = pageToken.Next(req).String()
+	}
+	for _, project := range projects {
+		projectSet.Projects = append(projectSet.Projects, convertProject(project))
+	}
+	return projectSet, nil
+}
+
+// CreateProject implements project creation.
+func (s *GenCodeService) CreateProject(ctx context.Context, req *v1.CreateProjectRequest) (*v1.Project, error) {
+	if _, ok := auth.FromContext(ctx); !ok {
+		return nil, auth.ErrUnauthorized
+	}
+	project, err := s.project.CreateProject(ctx, &biz.Project{
+		Name:        req.Project.GetName(),
+		Description: req.Project.GetDescription(),
+		Config:      convertConfig(req.Project.GetConfig()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertProject(project), nil
+}
+
+// UpdateProject implements project update.
+func (s *GenCodeService) UpdateProject(ctx context.Context, req *v1.UpdateProjectRequest) (*v1.Project, error) {
+	project, err := s.GetProject(ctx, &v1.GetProjectRequest{Id: req.Project.GetId()})
+	if err != nil {
+		return nil, err
+	}
+	fieldmask.Update(req.UpdateMask, project, req.Project)
+	updated, err := s.project.UpdateProject(ctx, &biz.Project{
+		ID:          project.Id,
+		Name:        project.Name,
+		Description: project.Description,
+		Config:      convertConfig(project.Config),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertProject(updated), nil
+}
+
+// DeleteProject implements project deletion.
+func (s *GenCodeService) DeleteProject(ctx context.Context, req *v1.DeleteProjectRequest) (*emptypb.Empty, error) {
+	if _, ok := auth.FromContext(ctx); !ok {
+		return nil, auth.ErrUnauthorized
+	}
+	if err := s.project.DeleteProject(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetProject implements project retrieval.
+func (s *GenCodeService) GetProject(ctx context.Context, req *v1.GetProjectRequest) (*v1.Project, error) {
+	if _, ok := auth.FromContext(ctx); !ok {
+		return nil, auth.ErrUnauthorized
+	}
+	project, err := s.project.GetProject(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return convertProject(project), nil
+}
+
+// ImportTables implements importing tables or ddl into a project.
+func (s *GenCodeService) ImportTables(ctx context.Context, req *v1.ImportTablesRequest) (*v1.ProjectTableSet, error) {
+	if _, ok := auth.FromContext(ctx); !ok {
+		return nil, auth.ErrUnauthorized
+	}
+	tables, err := s.parseTables(ctx, req.Tables, req.Ddl)
+	if err != nil {
+		return nil, err
+	}
+	imported, err := s.project.ImportTables(ctx, req.ProjectId, tables)
+	if err != nil {
+		return nil, err
+	}
+	return convertProjectTableSet(imported), nil
+}
+
+// ListTables implements project table listing.
+func (s *GenCodeService) ListTables(ctx context.Context, req *v1.ListTablesRequest) (*v1.ProjectTableSet, error) {
+	if _, ok := auth.FromContext(ctx); !ok {
+		return nil, auth.ErrUnauthorized
+	}
+	if _, err := s.project.GetProject(ctx, req.ProjectId); err != nil {
+		return nil, err
+	}
+	tables, err := s.project.ListTables(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	return convertProjectTableSet(tables), nil
+}
+
+// UpdateTable implements project table update.
+func (s *GenCodeService) UpdateTable(ctx context.Context, req *v1.UpdateTableRequest) (*v1.ProjectTable, error) {
+	if _, ok := auth.FromContext(ctx); !ok {
+		return nil, auth.ErrUnauthorized
+	}
+	current, err := s.project.GetTable(ctx, req.Table.GetId())
+	if err != nil {
+		return nil, err
+	}
+	table := convertProjectTable(current)
+	fieldmask.Update(req.UpdateMask, table, req.Table)
+	updated, err := s.project.UpdateTable(ctx, &biz.ProjectTable{
+		ID:        current.ID,
+		ProjectID: current.ProjectID,
+		Table:     convertTable(table.Table),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertProjectTable(updated), nil
+}
+
+// DeleteTable implements project table deletion.
+func (s *GenCodeService) DeleteTable(ctx context.Context, req *v1.DeleteTableRequest) (*emptypb.Empty, error) {
+	if _, ok := auth.FromContext(ctx); !ok {
+		return nil, auth.ErrUnauthorized
+	}
+	if err := s.project.DeleteTable(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,85 @@
+package gencode
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// 持续集成流水线，仅对 java 目标生效
+const (
+	CIJenkins = "jenkins" // Jenkinsfile
+	CIGitHub  = "github"  // GitHub Actions，.github/workflows/ci.yml
+	CIGitLab  = "gitlab"  // GitLab CI，.gitlab-ci.yml
+	CIDrone   = "drone"   // Drone，.drone.yml
+)
+
+// cis 支持的持续集成流水线
+var cis = []string{CIJenkins, CIGitHub, CIGitLab, CIDrone}
+
+// checkCI 校验持续集成流水线，不支持的流水线不会生成任何流水线文件
+func checkCI(ci string) error {
+	if slices.Contains(cis, ci) {
+		return nil
+	}
+	return fmt.Errorf("不支持的 CI: %s，可选值: %s", ci, strings.Join(cis, "/"))
+}
+
+// ci 获取持续集成流水线，默认 Jenkins
+func (g *Generator) ci() string {
+	if g.Config.GenConfig.CI == "" {
+		return CIJenkins
+	}
+	return g.Config.GenConfig.CI
+}
+
+// buildCommand 获取打包可执行 jar 的命令，跳过测试
+func (g *Generator) buildCommand() string {
+	if g.layout() == LayoutGradle {
+		return "gradle clean bootJar"
+	}
+	return "mvn clean package -DskipTests"
+}
+
+// testCommand 获取运行测试的命令
+func (g *Generator) testCommand() string {
+	if g.layout() == LayoutGradle {
+		return "gradle test"
+	}
+	return "mvn test"
+}
+
+// builderImage 获取流水线中构建项目所用的镜像，JDK 版本与 Java 版本配置一致
+func (g *Generator) builderImage() string {
+	if g.layout() == LayoutGradle {
+		return "gradle:8.7-jdk" + g.profile().JDK
+	}
+	return "maven:3.9-eclipse-temurin-" + g.profile().JDK
+}
+
+// jarPath 获取打包输出的 jar 路径
+func (g *Generator) jarPath() string {
+	switch g.layout() {
+	case LayoutGradle:
+		return "build/libs/*.jar"
+	case LayoutMavenMulti:
+		return g.moduleName(ModuleWeb) + "/target/*.jar"
+	default:
+		return "target/*.jar"
+	}
+}
+
+// imageName 获取不含标签的镜像名，如 docker.io/library/demo
+func (g *Generator) imageName() string {
+	deploy := g.deploy()
+	return fmt.Sprintf("%s/%s/%s", deploy.Registry, deploy.RegistryNamespace, g.artifactID())
+}
+
+// deployCommand 获取部署到指定环境的命令，镜像标签取自环境变量 IMAGE_TAG
+func (g *Generator) deployCommand(env string) string {
+	if g.deploy().Helm {
+		chart := "deploy/helm/" + g.artifactID()
+		return fmt.Sprintf("helm upgrade --install %s-%s %s -f %s/values-%s.yaml --set image.tag=$IMAGE_TAG", g.artifactID(), env, chart, chart, env)
+	}
+	return fmt.Sprintf("envsubst < deploy/%s.yaml | kubectl apply -f -", env)
+}
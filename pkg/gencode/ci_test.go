@@ -0,0 +1,59 @@
+package gencode
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gen_code/pkg/gencode/gencodetest"
+)
+
+func TestGenerateCI(t *testing.T) {
+	testCases := []struct {
+		name   string
+		ci     string
+		layout string
+		helm   bool
+		file   string
+	}{
+		{"jenkins", CIJenkins, LayoutMaven, false, "Jenkinsfile"},
+		{"github", CIGitHub, LayoutMaven, false, ".github/workflows/ci.yml"},
+		{"gitlab", CIGitLab, LayoutMavenMulti, false, ".gitlab-ci.yml"},
+		{"drone", CIDrone, LayoutGradle, true, ".drone.yml"},
+	}
+	files := []string{"Jenkinsfile", ".github/workflows/ci.yml", ".gitlab-ci.yml", ".drone.yml"}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := testConfig(t.TempDir())
+			config.GenConfig.CI = tc.ci
+			config.GenConfig.Layout = tc.layout
+			config.DeployConfig = DeployConfig{
+				Registry:          "registry.example.com",
+				RegistryNamespace: "team",
+				Helm:              tc.helm,
+				Environments:      []DeployEnvironment{{Name: "dev"}, {Name: "prod", Namespace: "prod"}},
+			}
+			rendered, err := NewGenerator(config, testTables()).RenderFiles()
+			if err != nil {
+				t.Fatalf("生成代码失败: %v", err)
+			}
+
+			// 只生成所选的流水线
+			for _, file := range files {
+				if _, exists := rendered[file]; exists != (file == tc.file) {
+					t.Errorf("%s 是否生成 = %v", file, exists)
+				}
+			}
+
+			golden := filepath.Join("testdata", "ci", tc.name)
+			gencodetest.Golden(t, golden, map[string][]byte{tc.file: rendered[tc.file]}, *update)
+		})
+	}
+	// 不支持的流水线返回错误，而不是不生成流水线文件
+	config := testConfig("")
+	config.GenConfig.CI = "gha"
+	if _, err := NewGenerator(config, testTables()).RenderFiles(); err == nil || !strings.Contains(err.Error(), "不支持的 CI: gha") {
+		t.Errorf("不支持的流水线应返回错误: %v", err)
+	}
+}
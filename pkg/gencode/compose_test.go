@@ -0,0 +1,61 @@
+package gencode
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerateCompose(t *testing.T) {
+	outputPath := t.TempDir()
+	config := testConfig(outputPath)
+	config.GenConfig.Dialect = DialectPostgres
+	config.GenConfig.Layout = LayoutMavenMulti
+	config.GenConfig.Profile = ProfileBoot3
+	if err := NewGenerator(config, testTables()).GenerateCode(); err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+
+	expected := map[string][]string{
+		"docker-compose.yml": {
+			"MYSQL_DATABASE: ${DB_NAME:-com_example}",
+			"./docker/mysql/init.sql:/docker-entrypoint-initdb.d/init.sql:ro",
+			"image: redis:7-alpine",
+			"DB_HOST: mysql",
+			"REDIS_HOST: redis",
+			"condition: service_healthy",
+		},
+		// 初始化脚本固定使用 MySQL 方言，不受 dialect 影响
+		"docker/mysql/init.sql": {"CREATE TABLE `user` (", "CREATE TABLE `product` ("},
+		"gentest-web/src/main/resources/application.yml": {
+			"default: local",
+			"url: jdbc:mysql://${DB_HOST}:${DB_PORT:3306}/${DB_NAME}?",
+			"username: ${DB_USERNAME}",
+		},
+		"gentest-web/src/main/resources/application-local.yml": {
+			"${DB_NAME:com_example}",
+			"password: ${DB_PASSWORD:app123456}",
+			"data:\n    redis:\n      host: ${REDIS_HOST:localhost}",
+		},
+	}
+	for file, contains := range expected {
+		content, err := os.ReadFile(filepath.Join(outputPath, file))
+		if err != nil {
+			t.Fatalf("读取文件失败: %v", err)
+		}
+		for _, e := range contains {
+			if !strings.Contains(string(content), e) {
+				t.Errorf("%s 缺少: %s\n%s", file, e, content)
+			}
+		}
+	}
+
+	content, err := os.ReadFile(filepath.Join(outputPath, "gentest-web/src/main/resources/application.yml"))
+	if err != nil {
+		t.Fatalf("读取文件失败: %v", err)
+	}
+	if strings.Contains(string(content), "123456") {
+		t.Errorf("application.yml 不应包含硬编码的数据库密码\n%s", content)
+	}
+}
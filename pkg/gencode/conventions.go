@@ -0,0 +1,142 @@
+package gencode
+
+import (
+	"slices"
+	"strings"
+)
+
+// 自动填充方式，与 MyBatis-Plus 的 FieldFill 对应
+const (
+	FillInsert       = "INSERT"        // 插入时填充
+	FillInsertUpdate = "INSERT_UPDATE" // 插入和更新时填充
+)
+
+// Conventions 约定字段配置，按列名（不区分大小写）识别审计、逻辑删除和乐观锁字段
+// 未配置（nil）时使用默认约定，配置为空列表时关闭对应识别
+type Conventions struct {
+	InsertFill  []string `json:"insert_fill"`  // 插入时自动填充的字段，如 create_time、create_by
+	UpdateFill  []string `json:"update_fill"`  // 插入和更新时自动填充的字段，如 update_time、update_by
+	LogicDelete []string `json:"logic_delete"` // 逻辑删除字段，如 deleted
+	Version     []string `json:"version"`      // 乐观锁版本字段，如 version
+}
+
+// 默认约定字段
+var (
+	defaultInsertFill  = []string{"create_time", "created_time", "created_at", "gmt_create", "create_by", "created_by", "creator"}
+	defaultUpdateFill  = []string{"update_time", "updated_time", "updated_at", "gmt_modified", "update_by", "updated_by", "updater"}
+	defaultLogicDelete = []string{"deleted", "is_deleted", "del_flag"}
+	defaultVersion     = []string{"version"}
+)
+
+// withDefaults 为未配置的约定填充默认值
+func (c Conventions) withDefaults() Conventions {
+	if c.InsertFill == nil {
+		c.InsertFill = defaultInsertFill
+	}
+	if c.UpdateFill == nil {
+		c.UpdateFill = defaultUpdateFill
+	}
+	if c.LogicDelete == nil {
+		c.LogicDelete = defaultLogicDelete
+	}
+	if c.Version == nil {
+		c.Version = defaultVersion
+	}
+	return c
+}
+
+// Apply 按约定识别表中的约定字段，已手动设置的字段保持不变
+func (c Conventions) Apply(table Table) Table {
+	c = c.withDefaults()
+	match := func(names []string, column string) bool {
+		return slices.ContainsFunc(names, func(name string) bool {
+			return strings.EqualFold(name, column)
+		})
+	}
+
+	fields := make([]Field, len(table.Fields))
+	for i, f := range table.Fields {
+		if !f.IsPrimaryKey && f.Fill == "" && !f.LogicDelete && !f.Version {
+			switch {
+			case match(c.InsertFill, f.ColumnName):
+				f.Fill = FillInsert
+			case match(c.UpdateFill, f.ColumnName):
+				f.Fill = FillInsertUpdate
+			case match(c.LogicDelete, f.ColumnName):
+				f.LogicDelete = true
+			case match(c.Version, f.ColumnName):
+				f.Version = true
+			}
+		}
+		fields[i] = f
+	}
+	table.Fields = fields
+	return table
+}
+
+// IsFillTime 字段是否为自动填充的时间字段
+func (f Field) IsFillTime() bool {
+	return f.Fill != "" && f.GoType == "time.Time"
+}
+
+// HasFill 表中是否有自动填充字段
+func (t Table) HasFill() bool {
+	return slices.ContainsFunc(t.Fields, func(f Field) bool { return f.Fill != "" })
+}
+
+// HasFillTime 表中是否有自动填充的时间字段
+func (t Table) HasFillTime() bool {
+	return slices.ContainsFunc(t.Fields, Field.IsFillTime)
+}
+
+// HasLogicDelete 表中是否有逻辑删除字段
+func (t Table) HasLogicDelete() bool {
+	return slices.ContainsFunc(t.Fields, func(f Field) bool { return f.LogicDelete })
+}
+
+// HasVersion 表中是否有乐观锁版本字段
+func (t Table) HasVersion() bool {
+	return slices.ContainsFunc(t.Fields, func(f Field) bool { return f.Version })
+}
+
+// LogicDeleteField 获取逻辑删除字段
+func (t Table) LogicDeleteField() Field {
+	for _, f := range t.Fields {
+		if f.LogicDelete {
+			return f
+		}
+	}
+	return Field{}
+}
+
+// VersionField 获取乐观锁版本字段
+func (t Table) VersionField() Field {
+	for _, f := range t.Fields {
+		if f.Version {
+			return f
+		}
+	}
+	return Field{}
+}
+
+// fillFields 汇总所有表中的自动填充字段，按属性名去重，供全局填充处理器使用
+func fillFields(tables []Table) []Field {
+	var fields []Field
+	for _, t := range tables {
+		for _, f := range t.Fields {
+			if f.Fill == "" {
+				continue
+			}
+			if slices.ContainsFunc(fields, func(e Field) bool { return e.FieldName == f.FieldName }) {
+				continue
+			}
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// hasVersion 是否有表包含乐观锁版本字段
+func hasVersion(tables []Table) bool {
+	return slices.ContainsFunc(tables, Table.HasVersion)
+}
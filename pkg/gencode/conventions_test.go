@@ -0,0 +1,104 @@
+package gencode
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestConventions(t *testing.T) {
+	tables, err := ParseDDL(`CREATE TABLE article (
+  id bigint PRIMARY KEY,
+  title varchar(64) NOT NULL,
+  deleted tinyint NOT NULL DEFAULT 0,
+  version int NOT NULL DEFAULT 0,
+  create_time datetime,
+  update_by varchar(32),
+  gmt_audit datetime
+) COMMENT '文章表'`)
+	if err != nil {
+		t.Fatalf("解析DDL失败: %v", err)
+	}
+
+	table := Conventions{}.Apply(tables[0])
+	testCases := []struct {
+		field       Field
+		fill        string
+		logicDelete bool
+		version     bool
+	}{
+		{table.Fields[1], "", false, false},
+		{table.Fields[2], "", true, false},
+		{table.Fields[3], "", false, true},
+		{table.Fields[4], FillInsert, false, false},
+		{table.Fields[5], FillInsertUpdate, false, false},
+		{table.Fields[6], "", false, false},
+	}
+	for _, tc := range testCases {
+		if tc.field.Fill != tc.fill || tc.field.LogicDelete != tc.logicDelete || tc.field.Version != tc.version {
+			t.Errorf("%s 约定 = %q/%v/%v, expected %q/%v/%v", tc.field.ColumnName,
+				tc.field.Fill, tc.field.LogicDelete, tc.field.Version, tc.fill, tc.logicDelete, tc.version)
+		}
+	}
+	for _, f := range table.QueryFields() {
+		if f.LogicDelete || f.Version {
+			t.Errorf("%s 不应作为查询条件", f.ColumnName)
+		}
+	}
+
+	// 自定义约定，空列表关闭识别
+	custom := Conventions{InsertFill: []string{"GMT_AUDIT"}, LogicDelete: []string{}}.Apply(tables[0])
+	if custom.Fields[6].Fill != FillInsert || custom.HasLogicDelete() {
+		t.Errorf("自定义约定未生效")
+	}
+
+	outputPath := t.TempDir()
+	if err := NewGenerator(testConfig(outputPath), tables).GenerateCode(); err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+	expectedFiles := map[string][]string{
+		"src/main/java/com/example/entity/Article.java": {
+			"@TableField(\"deleted\")\n    @TableLogic",
+			"@TableField(\"version\")\n    @Version",
+			`@TableField(value = "create_time", fill = FieldFill.INSERT)`,
+			`@TableField(value = "update_by", fill = FieldFill.INSERT_UPDATE)`,
+		},
+		"src/main/java/com/example/mapper/ArticleMapper.xml": {
+			`<sql id="Not_Deleted">`,
+		},
+		"src/main/java/com/example/service/impl/ArticleServiceImpl.java": {
+			`throw new OptimisticLockingFailureException`,
+		},
+		"src/main/java/com/example/config/MybatisPlusConfig.java": {
+			`new OptimisticLockerInnerInterceptor()`,
+			`new PaginationInnerInterceptor(DbType.MYSQL)`,
+		},
+		"src/main/java/com/example/config/AuditMetaObjectHandler.java": {
+			`this.strictInsertFill(metaObject, "createTime", Date.class, new Date());`,
+			`this.setFieldValByName("updateBy", currentUser(), metaObject);`,
+		},
+	}
+	for file, expected := range expectedFiles {
+		content, err := os.ReadFile(filepath.Join(outputPath, file))
+		if err != nil {
+			t.Fatalf("读取生成文件失败: %v", err)
+		}
+		for _, e := range expected {
+			if !strings.Contains(string(content), e) {
+				t.Errorf("%s 缺少: %s", file, e)
+			}
+		}
+	}
+
+	// 没有审计字段时不生成填充处理器
+	config := testConfig(t.TempDir())
+	config.GenConfig.Conventions = Conventions{InsertFill: []string{}, UpdateFill: []string{}}
+	if err := NewGenerator(config, tables).GenerateCode(); err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+	handler := filepath.Join(config.GenConfig.OutputPath, "src/main/java/com/example/config/AuditMetaObjectHandler.java")
+	if _, err := os.Stat(handler); !os.IsNotExist(err) {
+		t.Errorf("没有审计字段时不应生成填充处理器")
+	}
+}
@@ -0,0 +1,323 @@
+package gencode
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// 数据库方言
+const (
+	DialectMySQL    = "mysql"
+	DialectPostgres = "postgres"
+	DialectSQLite   = "sqlite"
+)
+
+// dialects 支持的数据库方言
+var dialects = []string{DialectMySQL, DialectPostgres, DialectSQLite}
+
+// quoteIdent 按方言引用标识符
+func quoteIdent(dialect, name string) string {
+	if dialect == DialectMySQL {
+		return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// quoteString 引用字符串字面量
+func quoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// isAutoIncrement 声明为自增的整数主键使用自增
+func isAutoIncrement(table Table, field Field) bool {
+	if !field.AutoIncrement || !strings.EqualFold(field.ColumnName, table.PrimaryKey.ColumnName) {
+		return false
+	}
+	switch strings.ToLower(field.ColumnType) {
+	case "bigint", "int", "integer", "mediumint", "smallint":
+		return true
+	}
+	return false
+}
+
+// sqlType 获取字段在指定方言中的列类型
+func sqlType(dialect string, field Field) string {
+	columnType := strings.ToLower(field.ColumnType)
+	if dialect == DialectMySQL {
+		switch {
+		case columnType == "enum" || columnType == "set":
+			// 缺少可选值时按字符串处理
+			if len(field.EnumValues) == 0 {
+				return "varchar(255)"
+			}
+			values := make([]string, len(field.EnumValues))
+			for i, v := range field.EnumValues {
+				values[i] = quoteString(v)
+			}
+			return fmt.Sprintf("%s(%s)", columnType, strings.Join(values, ","))
+		case field.Scale > 0:
+			return fmt.Sprintf("%s(%d,%d)", columnType, field.Length, field.Scale)
+		case field.Length > 0:
+			return fmt.Sprintf("%s(%d)", columnType, field.Length)
+		case columnType == "varchar" || columnType == "varbinary":
+			return columnType + "(255)"
+		default:
+			return columnType
+		}
+	}
+
+	if dialect == DialectSQLite {
+		switch columnType {
+		case "bigint", "int", "integer", "mediumint", "smallint", "tinyint", "year":
+			return "integer"
+		case "bit", "bool", "boolean":
+			return "boolean"
+		case "float", "double", "real":
+			return "real"
+		case "text", "tinytext", "mediumtext", "longtext", "json":
+			return "text"
+		case "blob", "tinyblob", "mediumblob", "longblob", "binary", "varbinary":
+			return "blob"
+		default:
+			// 其余类型沿用 MySQL 写法，SQLite 按类型亲和性处理
+			return sqlType(DialectMySQL, field)
+		}
+	}
+
+	switch columnType {
+	case "bigint":
+		return "bigint"
+	case "int", "integer", "mediumint":
+		return "integer"
+	case "smallint", "tinyint", "year":
+		return "smallint"
+	case "bit", "bool", "boolean":
+		return "boolean"
+	case "decimal", "numeric":
+		if field.Scale > 0 {
+			return fmt.Sprintf("numeric(%d,%d)", field.Length, field.Scale)
+		}
+		if field.Length > 0 {
+			return fmt.Sprintf("numeric(%d)", field.Length)
+		}
+		return "numeric"
+	case "float":
+		return "real"
+	case "double", "real":
+		return "double precision"
+	case "date":
+		return "date"
+	case "datetime", "timestamp":
+		return "timestamp"
+	case "time":
+		return "time"
+	case "char", "varchar":
+		if field.Length > 0 {
+			return fmt.Sprintf("%s(%d)", columnType, field.Length)
+		}
+		return "varchar(255)"
+	case "enum", "set":
+		return "varchar(255)"
+	case "json":
+		return "json"
+	case "blob", "tinyblob", "mediumblob", "longblob", "binary", "varbinary":
+		return "bytea"
+	default:
+		return "text"
+	}
+}
+
+// columnDefinition 生成列定义，如 `name` varchar(64) NOT NULL COMMENT '名称'
+func columnDefinition(dialect string, table Table, field Field) string {
+	var b strings.Builder
+	b.WriteString(quoteIdent(dialect, field.ColumnName))
+	b.WriteString(" ")
+	autoIncrement := isAutoIncrement(table, field)
+	if dialect == DialectSQLite && autoIncrement {
+		// SQLite 只有 INTEGER PRIMARY KEY 列可以自增
+		b.WriteString("integer PRIMARY KEY AUTOINCREMENT")
+		return b.String()
+	}
+	if dialect == DialectPostgres && autoIncrement {
+		// PostgreSQL 使用 serial 类型实现自增
+		if strings.EqualFold(field.ColumnType, "bigint") {
+			b.WriteString("bigserial")
+		} else {
+			b.WriteString("serial")
+		}
+	} else {
+		b.WriteString(sqlType(dialect, field))
+	}
+	if !field.IsNullable || field.IsPrimaryKey {
+		b.WriteString(" NOT NULL")
+	} else if dialect == DialectMySQL {
+		b.WriteString(" NULL")
+	}
+	if field.DefaultValue != "" && !autoIncrement {
+		b.WriteString(" DEFAULT " + defaultSQL(dialect, field))
+	}
+	if dialect == DialectMySQL {
+		if autoIncrement {
+			b.WriteString(" AUTO_INCREMENT")
+		}
+		if field.ColumnComment != "" {
+			b.WriteString(" COMMENT " + quoteString(field.ColumnComment))
+		}
+	}
+	return b.String()
+}
+
+// defaultSQL 生成字段在指定方言中的默认值表达式，MySQL 原样输出。
+// 其他方言去掉字符集引导符和 N 前缀，位串转为整数，布尔列的整数默认值转为 TRUE/FALSE，
+// PostgreSQL 的十六进制串转为 bytea 写法
+func defaultSQL(dialect string, field Field) string {
+	value := field.DefaultValue
+	if dialect == DialectMySQL {
+		return value
+	}
+	if i := strings.IndexByte(value, '\''); i > 0 && strings.HasSuffix(value, "'") {
+		prefix, literal := strings.ToLower(value[:i]), value[i:]
+		switch prefix {
+		case "b":
+			if n, err := strconv.ParseUint(unquoteString(literal), 2, 64); err == nil {
+				value = strconv.FormatUint(n, 10)
+			}
+		case "x":
+			if dialect == DialectPostgres {
+				value = `'\x` + literal[1:]
+			}
+		default:
+			value = literal
+		}
+	}
+	if sqlType(dialect, field) == "boolean" {
+		if n, err := strconv.ParseInt(strings.Trim(value, "'"), 10, 64); err == nil {
+			if n == 0 {
+				return "FALSE"
+			}
+			return "TRUE"
+		}
+	}
+	return value
+}
+
+// quoteColumns 引用并拼接索引列
+func quoteColumns(dialect string, columns []string) string {
+	quoted := make([]string, len(columns))
+	for i, c := range columns {
+		quoted[i] = quoteIdent(dialect, c)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// CreateTableSQL 生成指定方言的建表语句，包含注释、主键和索引
+// PostgreSQL 的注释和索引、SQLite 的索引以独立语句输出
+func CreateTableSQL(dialect string, table Table) (string, error) {
+	if err := checkDialect(dialect); err != nil {
+		return "", err
+	}
+
+	var lines, comments []string
+	for _, f := range table.Fields {
+		lines = append(lines, "  "+columnDefinition(dialect, table, f))
+		comments = append(comments, f.ColumnComment)
+	}
+	if table.PrimaryKey.ColumnName != "" && !(dialect == DialectSQLite && isAutoIncrement(table, table.PrimaryKey)) {
+		lines = append(lines, fmt.Sprintf("  PRIMARY KEY (%s)", quoteIdent(dialect, table.PrimaryKey.ColumnName)))
+	}
+	if dialect == DialectMySQL {
+		for _, index := range table.Indexes {
+			kind := "KEY"
+			if index.Unique {
+				kind = "UNIQUE KEY"
+			}
+			lines = append(lines, fmt.Sprintf("  %s %s (%s)", kind, quoteIdent(dialect, index.Name), quoteColumns(dialect, index.Columns)))
+		}
+	}
+
+	var b strings.Builder
+	if dialect == DialectSQLite {
+		// SQLite 不支持注释语法，以行尾注释保留表和字段说明
+		if table.TableComment != "" {
+			fmt.Fprintf(&b, "-- %s\n", table.TableComment)
+		}
+		fmt.Fprintf(&b, "CREATE TABLE %s (\n", quoteIdent(dialect, table.TableName))
+		for i, line := range lines {
+			b.WriteString(line)
+			if i < len(lines)-1 {
+				b.WriteString(",")
+			}
+			if i < len(comments) && comments[i] != "" {
+				b.WriteString(" -- " + strings.ReplaceAll(comments[i], "\n", " "))
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString(");\n")
+		for _, index := range table.Indexes {
+			b.WriteString(createIndexSQL(dialect, table, index))
+		}
+		return b.String(), nil
+	}
+
+	fmt.Fprintf(&b, "CREATE TABLE %s (\n%s\n)", quoteIdent(dialect, table.TableName), strings.Join(lines, ",\n"))
+	if dialect == DialectMySQL {
+		if table.TableComment != "" {
+			b.WriteString(" COMMENT=" + quoteString(table.TableComment))
+		}
+		b.WriteString(";\n")
+		return b.String(), nil
+	}
+
+	b.WriteString(";\n")
+	if table.TableComment != "" {
+		fmt.Fprintf(&b, "COMMENT ON TABLE %s IS %s;\n", quoteIdent(dialect, table.TableName), quoteString(table.TableComment))
+	}
+	for _, f := range table.Fields {
+		if f.ColumnComment != "" {
+			b.WriteString(columnCommentSQL(dialect, table, f))
+		}
+	}
+	for _, index := range table.Indexes {
+		b.WriteString(createIndexSQL(dialect, table, index))
+	}
+	return b.String(), nil
+}
+
+// columnCommentSQL 生成 PostgreSQL 的列注释语句
+func columnCommentSQL(dialect string, table Table, field Field) string {
+	return fmt.Sprintf("COMMENT ON COLUMN %s.%s IS %s;\n", quoteIdent(dialect, table.TableName),
+		quoteIdent(dialect, field.ColumnName), quoteString(field.ColumnComment))
+}
+
+// createIndexSQL 生成创建索引语句
+func createIndexSQL(dialect string, table Table, index Index) string {
+	kind := "INDEX"
+	if index.Unique {
+		kind = "UNIQUE INDEX"
+	}
+	return fmt.Sprintf("CREATE %s %s ON %s (%s);\n", kind, quoteIdent(dialect, indexName(dialect, table, index)),
+		quoteIdent(dialect, table.TableName), quoteColumns(dialect, index.Columns))
+}
+
+// indexName 获取索引在指定方言中的名称。MySQL 的索引名只需在表内唯一，
+// PostgreSQL 和 SQLite 的索引名在整个 schema 内唯一，加上表名前缀，如 sys_user_idx_status
+func indexName(dialect string, table Table, index Index) string {
+	name := index.Name
+	if name == "" {
+		name = strings.Join(index.Columns, "_")
+	}
+	if dialect == DialectMySQL || strings.HasPrefix(strings.ToLower(name), strings.ToLower(table.TableName)+"_") {
+		return name
+	}
+	return table.TableName + "_" + name
+}
+
+// checkDialect 校验数据库方言
+func checkDialect(dialect string) error {
+	if slices.Contains(dialects, dialect) {
+		return nil
+	}
+	return fmt.Errorf("不支持的数据库方言: %s，可选值: %s", dialect, strings.Join(dialects, "/"))
+}
@@ -0,0 +1,204 @@
+package gencode
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const roundTripDDL = `CREATE TABLE sys_user (
+  id bigint NOT NULL AUTO_INCREMENT COMMENT '主键',
+  username varchar(32) NOT NULL COMMENT '用户名',
+  gender enum('male','female') NOT NULL DEFAULT 'male' COMMENT '性别',
+  email varchar(128) COMMENT '邮箱',
+  balance decimal(10,2) NOT NULL DEFAULT 0.00 COMMENT '余额',
+  remark text,
+  dept_id int(11),
+  create_time datetime NOT NULL COMMENT '创建时间',
+  PRIMARY KEY (id),
+  UNIQUE KEY uk_username (username),
+  KEY idx_dept_time (dept_id, create_time)
+) COMMENT='用户表';
+CREATE TABLE sys_dict (
+  code varchar(64) NOT NULL COMMENT '编码',
+  label varchar(64) NOT NULL COMMENT '名称',
+  PRIMARY KEY (code)
+) COMMENT='字典';
+CREATE TABLE sys_order (
+  id bigint NOT NULL COMMENT '雪花ID',
+  amount decimal(10,2) NOT NULL,
+  PRIMARY KEY (id)
+)`
+
+func TestCreateTableSQLRoundTrip(t *testing.T) {
+	tables, err := ParseDDL(roundTripDDL)
+	if err != nil {
+		t.Fatalf("解析DDL失败: %v", err)
+	}
+
+	// DDL -> Table -> DDL -> Table 应得到相同的表结构
+	var ddl []string
+	for _, table := range tables {
+		sql, err := CreateTableSQL(DialectMySQL, table)
+		if err != nil {
+			t.Fatalf("生成建表语句失败: %v", err)
+		}
+		ddl = append(ddl, sql)
+	}
+	reparsed, err := ParseDDL(strings.Join(ddl, "\n"))
+	if err != nil {
+		t.Fatalf("解析生成的建表语句失败: %v\n%s", err, strings.Join(ddl, "\n"))
+	}
+	if !tables[0].PrimaryKey.AutoIncrement || tables[2].PrimaryKey.AutoIncrement {
+		t.Errorf("自增标记解析错误: %+v, %+v", tables[0].PrimaryKey, tables[2].PrimaryKey)
+	}
+	if !reflect.DeepEqual(tables, reparsed) {
+		t.Errorf("往返后表结构不一致:\n%+v\n%+v", tables, reparsed)
+	}
+}
+
+func TestCreateTableSQL(t *testing.T) {
+	tables, err := ParseDDL(roundTripDDL)
+	if err != nil {
+		t.Fatalf("解析DDL失败: %v", err)
+	}
+
+	testCases := []struct {
+		dialect  string
+		expected []string
+	}{
+		{DialectMySQL, []string{
+			"`id` bigint NOT NULL AUTO_INCREMENT COMMENT '主键',",
+			"`email` varchar(128) NULL COMMENT '邮箱',",
+			"`gender` enum('male','female') NOT NULL DEFAULT 'male' COMMENT '性别',",
+			"UNIQUE KEY `uk_username` (`username`),",
+			"KEY `idx_dept_time` (`dept_id`, `create_time`)",
+			") COMMENT='用户表';",
+			"`code` varchar(64) NOT NULL COMMENT '编码',",
+			// 未声明自增的整数主键由应用生成，如雪花ID
+			"`id` bigint NOT NULL COMMENT '雪花ID',",
+		}},
+		{DialectPostgres, []string{
+			`"id" bigserial NOT NULL,`,
+			`"balance" numeric(10,2) NOT NULL DEFAULT 0.00,`,
+			`"gender" varchar(255) NOT NULL DEFAULT 'male',`,
+			`"create_time" timestamp NOT NULL,`,
+			`COMMENT ON TABLE "sys_user" IS '用户表';`,
+			`COMMENT ON COLUMN "sys_user"."username" IS '用户名';`,
+			`CREATE INDEX "sys_user_idx_dept_time" ON "sys_user" ("dept_id", "create_time");`,
+			"CREATE TABLE \"sys_order\" (\n  \"id\" bigint NOT NULL,",
+		}},
+		{DialectSQLite, []string{
+			"-- 用户表\nCREATE TABLE \"sys_user\" (",
+			`"id" integer PRIMARY KEY AUTOINCREMENT, -- 主键`,
+			`"dept_id" integer,`,
+			`"remark" text,`,
+			`"create_time" datetime NOT NULL -- 创建时间`,
+			`CREATE UNIQUE INDEX "sys_user_uk_username" ON "sys_user" ("username");`,
+			"\"label\" varchar(64) NOT NULL, -- 名称\n  PRIMARY KEY (\"code\")\n);",
+			"\"id\" integer NOT NULL, -- 雪花ID\n  \"amount\" decimal(10,2) NOT NULL,\n  PRIMARY KEY (\"id\")\n);",
+		}},
+	}
+	for _, tc := range testCases {
+		var ddl []string
+		for _, table := range tables {
+			sql, err := CreateTableSQL(tc.dialect, table)
+			if err != nil {
+				t.Fatalf("生成建表语句失败: %v", err)
+			}
+			ddl = append(ddl, sql)
+		}
+		sql := strings.Join(ddl, "\n")
+		for _, e := range tc.expected {
+			if !strings.Contains(sql, e) {
+				t.Errorf("%s 建表语句缺少: %s\n%s", tc.dialect, e, sql)
+			}
+		}
+	}
+	if _, err := CreateTableSQL("oracle", tables[0]); err == nil {
+		t.Errorf("不支持的方言应返回错误")
+	}
+}
+
+func TestCreateIndexSQLName(t *testing.T) {
+	tables, err := ParseDDL(`
+CREATE TABLE sys_user (
+  id bigint NOT NULL AUTO_INCREMENT,
+  status tinyint NOT NULL,
+  PRIMARY KEY (id),
+  KEY idx_status (status)
+);
+CREATE TABLE sys_role (
+  id bigint NOT NULL AUTO_INCREMENT,
+  status tinyint NOT NULL,
+  PRIMARY KEY (id),
+  KEY idx_status (status)
+);`)
+	if err != nil {
+		t.Fatalf("解析DDL失败: %v", err)
+	}
+	// PostgreSQL 和 SQLite 的索引名在 schema 内唯一，不同表的同名索引需加表名前缀
+	expected := map[string][]string{
+		DialectMySQL:    {"KEY `idx_status` (`status`)"},
+		DialectPostgres: {`CREATE INDEX "sys_user_idx_status" ON "sys_user"`, `CREATE INDEX "sys_role_idx_status" ON "sys_role"`},
+		DialectSQLite:   {`CREATE INDEX "sys_user_idx_status" ON "sys_user"`, `CREATE INDEX "sys_role_idx_status" ON "sys_role"`},
+	}
+	for dialect, contents := range expected {
+		var ddl []string
+		for _, table := range tables {
+			sql, err := CreateTableSQL(dialect, table)
+			if err != nil {
+				t.Fatalf("生成建表语句失败: %v", err)
+			}
+			ddl = append(ddl, sql)
+		}
+		sql := strings.Join(ddl, "\n")
+		for _, e := range contents {
+			if !strings.Contains(sql, e) {
+				t.Errorf("%s 建表语句缺少: %s\n%s", dialect, e, sql)
+			}
+		}
+	}
+
+	// 删除索引时使用相同的名称
+	to := tables[0]
+	to.Indexes = nil
+	sql, err := DiffTables([]Table{tables[0]}, []Table{to}).SQL(DialectPostgres)
+	if err != nil {
+		t.Fatalf("生成迁移语句失败: %v", err)
+	}
+	if !strings.Contains(sql, `DROP INDEX "sys_user_idx_status";`) {
+		t.Errorf("删除索引名称错误:\n%s", sql)
+	}
+}
+
+func TestGenerateSchemaSQL(t *testing.T) {
+	tables, err := ParseDDL(roundTripDDL)
+	if err != nil {
+		t.Fatalf("解析DDL失败: %v", err)
+	}
+
+	outputPath := t.TempDir()
+	config := testConfig(outputPath)
+	config.GenConfig.Dialect = DialectPostgres
+	if err := NewGenerator(config, tables).GenerateCode(); err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+	content, err := os.ReadFile(filepath.Join(outputPath, "src/main/resources/db/schema.sql"))
+	if err != nil {
+		t.Fatalf("读取schema.sql失败: %v", err)
+	}
+	for _, e := range []string{`CREATE TABLE "sys_user"`, `CREATE TABLE "sys_dict"`, `COMMENT ON TABLE "sys_dict" IS '字典';`} {
+		if !strings.Contains(string(content), e) {
+			t.Errorf("schema.sql 缺少: %s\n%s", e, content)
+		}
+	}
+
+	// 未声明自增的主键由 MyBatis-Plus 生成
+	entity, err := os.ReadFile(filepath.Join(outputPath, "src/main/java/com/example/entity/SysOrder.java"))
+	if err != nil || !strings.Contains(string(entity), `@TableId(value = "id", type = IdType.ASSIGN_ID)`) {
+		t.Errorf("SysOrder.java 主键应使用 ASSIGN_ID: %v\n%s", err, entity)
+	}
+}
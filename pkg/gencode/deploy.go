@@ -0,0 +1,102 @@
+package gencode
+
+// DeployConfig 部署配置，用于生成 Jenkinsfile 及各环境的 Kubernetes 部署清单或 Helm Chart
+type DeployConfig struct {
+	Registry             string              `json:"registry"`              // 镜像仓库地址，默认 docker.io
+	RegistryNamespace    string              `json:"registry_namespace"`    // 镜像仓库命名空间，默认 library
+	RegistryCredential   string              `json:"registry_credential"`   // Jenkins 中镜像仓库的凭据 ID，默认 registry-credential
+	KubeconfigCredential string              `json:"kubeconfig_credential"` // Jenkins 中 kubeconfig 的凭据 ID，默认 kubeconfig
+	GitCredential        string              `json:"git_credential"`        // Jenkins 中推送标签的 Git 凭据 ID，默认 git-credential
+	Namespace            string              `json:"namespace"`             // Kubernetes 命名空间，默认 default
+	Replicas             int                 `json:"replicas"`              // 副本数，默认 1
+	Port                 int                 `json:"port"`                  // 容器端口，默认 8080
+	ServicePort          int                 `json:"service_port"`          // Service 端口，默认与容器端口相同
+	ServiceType          string              `json:"service_type"`          // Service 类型 ClusterIP/NodePort/LoadBalancer，默认 ClusterIP
+	Resources            DeployResources     `json:"resources"`             // 容器资源，为空时使用默认值
+	Probe                DeployProbe         `json:"probe"`                 // 就绪与存活探针
+	Environments         []DeployEnvironment `json:"environments"`          // 部署环境，默认 dev、test、prod
+	Helm                 bool                `json:"helm"`                  // 生成 Helm Chart，否则为每个环境生成部署清单
+}
+
+// DeployResources 容器资源请求与限制，如 CPU 500m、内存 512Mi
+type DeployResources struct {
+	CPURequest    string `json:"cpu_request"`    // 默认 100m
+	MemoryRequest string `json:"memory_request"` // 默认 256Mi
+	CPULimit      string `json:"cpu_limit"`      // 默认 1
+	MemoryLimit   string `json:"memory_limit"`   // 默认 1Gi
+}
+
+// DeployProbe 就绪与存活探针，Path 为空时使用 TCP 端口探测
+type DeployProbe struct {
+	Path                string `json:"path"`                  // HTTP 探测路径，如 /actuator/health
+	InitialDelaySeconds int    `json:"initial_delay_seconds"` // 默认 0
+	PeriodSeconds       int    `json:"period_seconds"`        // 默认 5
+	TimeoutSeconds      int    `json:"timeout_seconds"`       // 默认 10
+	FailureThreshold    int    `json:"failure_threshold"`     // 默认 30
+}
+
+// DeployEnvironment 部署环境，未设置的命名空间和副本数沿用 DeployConfig 中的值
+type DeployEnvironment struct {
+	Name      string            `json:"name"`      // 环境名，如 dev、test、prod
+	Namespace string            `json:"namespace"` // Kubernetes 命名空间
+	Replicas  int               `json:"replicas"`  // 副本数
+	Env       map[string]string `json:"env"`       // 额外的容器环境变量
+}
+
+// deploy 获取补全默认值后的部署配置
+func (g *Generator) deploy() DeployConfig {
+	c := g.Config.DeployConfig
+	c.Registry = orDefault(c.Registry, "docker.io")
+	c.RegistryNamespace = orDefault(c.RegistryNamespace, "library")
+	c.RegistryCredential = orDefault(c.RegistryCredential, "registry-credential")
+	c.KubeconfigCredential = orDefault(c.KubeconfigCredential, "kubeconfig")
+	c.GitCredential = orDefault(c.GitCredential, "git-credential")
+	c.Namespace = orDefault(c.Namespace, "default")
+	c.ServiceType = orDefault(c.ServiceType, "ClusterIP")
+	if c.Replicas <= 0 {
+		c.Replicas = 1
+	}
+	if c.Port <= 0 {
+		c.Port = 8080
+	}
+	if c.ServicePort <= 0 {
+		c.ServicePort = c.Port
+	}
+
+	c.Resources.CPURequest = orDefault(c.Resources.CPURequest, "100m")
+	c.Resources.MemoryRequest = orDefault(c.Resources.MemoryRequest, "256Mi")
+	c.Resources.CPULimit = orDefault(c.Resources.CPULimit, "1")
+	c.Resources.MemoryLimit = orDefault(c.Resources.MemoryLimit, "1Gi")
+
+	if c.Probe.PeriodSeconds <= 0 {
+		c.Probe.PeriodSeconds = 5
+	}
+	if c.Probe.TimeoutSeconds <= 0 {
+		c.Probe.TimeoutSeconds = 10
+	}
+	if c.Probe.FailureThreshold <= 0 {
+		c.Probe.FailureThreshold = 30
+	}
+
+	environments := c.Environments
+	if len(environments) == 0 {
+		environments = []DeployEnvironment{{Name: "dev"}, {Name: "test"}, {Name: "prod"}}
+	}
+	c.Environments = make([]DeployEnvironment, len(environments))
+	for i, env := range environments {
+		env.Namespace = orDefault(env.Namespace, c.Namespace)
+		if env.Replicas <= 0 {
+			env.Replicas = c.Replicas
+		}
+		c.Environments[i] = env
+	}
+	return c
+}
+
+// orDefault 值为空时返回默认值
+func orDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
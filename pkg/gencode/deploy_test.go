@@ -0,0 +1,123 @@
+package gencode
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDeployDefaults(t *testing.T) {
+	g := NewGenerator(Config{DeployConfig: DeployConfig{
+		Namespace:    "apps",
+		Replicas:     2,
+		Port:         9090,
+		Environments: []DeployEnvironment{{Name: "dev"}, {Name: "prod", Namespace: "prod", Replicas: 4}},
+	}}, nil)
+	c := g.deploy()
+	if c.ServicePort != 9090 || c.ServiceType != "ClusterIP" || c.Registry != "docker.io" {
+		t.Errorf("默认值错误: %+v", c)
+	}
+	expected := []DeployEnvironment{{Name: "dev", Namespace: "apps", Replicas: 2}, {Name: "prod", Namespace: "prod", Replicas: 4}}
+	if !reflect.DeepEqual(c.Environments, expected) {
+		t.Errorf("环境 = %+v, 期望 %+v", c.Environments, expected)
+	}
+	if g.Config.DeployConfig.Environments[0].Namespace != "" {
+		t.Errorf("补全默认值不应修改原配置")
+	}
+
+	names := []string{}
+	for _, env := range NewGenerator(Config{}, nil).deploy().Environments {
+		names = append(names, env.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"dev", "test", "prod"}) {
+		t.Errorf("默认环境 = %v", names)
+	}
+}
+
+func TestGenerateDeploy(t *testing.T) {
+	deployConfig := DeployConfig{
+		Registry:           "registry.example.com",
+		RegistryNamespace:  "team",
+		RegistryCredential: "harbor",
+		ServiceType:        "NodePort",
+		Probe:              DeployProbe{Path: "/actuator/health"},
+		Environments: []DeployEnvironment{
+			{Name: "dev"},
+			{Name: "prod", Namespace: "prod", Replicas: 3, Env: map[string]string{"JAVA_OPTS": "-Xmx512m"}},
+		},
+	}
+
+	testCases := []struct {
+		helm     bool
+		exists   []string
+		missing  []string
+		contains map[string][]string
+	}{
+		{
+			helm:    false,
+			exists:  []string{"deploy/dev.yaml", "deploy/prod.yaml"},
+			missing: []string{"deploy/test.yaml", "deploy/helm"},
+			contains: map[string][]string{
+				"deploy/prod.yaml": {
+					"name: gentest-prod\n  namespace: prod",
+					"replicas: 3",
+					"image: registry.example.com/team/gentest:$IMAGE_TAG",
+					"- name: JAVA_OPTS\n              value: \"-Xmx512m\"",
+					"httpGet:\n              path: /actuator/health",
+					"type: NodePort",
+				},
+				"deploy/dev.yaml": {"namespace: default", "replicas: 1"},
+				"Jenkinsfile": {
+					"choices: ['dev', 'prod']",
+					"REGISTRY = 'registry.example.com'",
+					"DOCKER_CREDENTIAL_ID = 'harbor'",
+					"envsubst < deploy/$DEPLOY_ENV.yaml",
+				},
+			},
+		},
+		{
+			helm:    true,
+			exists:  []string{"deploy/helm/gentest/Chart.yaml", "deploy/helm/gentest/templates/deployment.yaml", "deploy/helm/gentest/templates/service.yaml"},
+			missing: []string{"deploy/dev.yaml", "deploy/prod.yaml"},
+			contains: map[string][]string{
+				"deploy/helm/gentest/values.yaml":               {"repository: registry.example.com/team/gentest", "type: NodePort"},
+				"deploy/helm/gentest/values-prod.yaml":          {"replicaCount: 3", "namespace: prod", "SPRING_PROFILES_ACTIVE: prod"},
+				"deploy/helm/gentest/templates/deployment.yaml": {"replicas: {{ .Values.replicaCount }}"},
+				"Jenkinsfile": {"helm upgrade --install gentest-$DEPLOY_ENV deploy/helm/gentest -f deploy/helm/gentest/values-$DEPLOY_ENV.yaml"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		outputPath := t.TempDir()
+		config := testConfig(outputPath)
+		config.DeployConfig = deployConfig
+		config.DeployConfig.Helm = tc.helm
+		if err := NewGenerator(config, testTables()).GenerateCode(); err != nil {
+			t.Fatalf("生成代码失败: %v", err)
+		}
+
+		for _, file := range tc.exists {
+			if _, err := os.Stat(filepath.Join(outputPath, file)); err != nil {
+				t.Errorf("缺少文件: %s", file)
+			}
+		}
+		for _, file := range tc.missing {
+			if _, err := os.Stat(filepath.Join(outputPath, file)); !os.IsNotExist(err) {
+				t.Errorf("不应生成: %s", file)
+			}
+		}
+		for file, contains := range tc.contains {
+			content, err := os.ReadFile(filepath.Join(outputPath, file))
+			if err != nil {
+				t.Fatalf("读取文件失败: %v", err)
+			}
+			for _, e := range contains {
+				if !strings.Contains(string(content), e) {
+					t.Errorf("%s 缺少: %s\n%s", file, e, content)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package gencode
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Relation 表之间的引用关系
+type Relation struct {
+	Table     string // 引用方表名
+	Column    string // 引用方列名
+	RefTable  string // 被引用的表名
+	RefColumn string // 被引用的列名
+	Nullable  bool   // 引用列是否可空
+	Unique    bool   // 引用列是否唯一，唯一时为一对一关系
+	Declared  bool   // 是否为 DDL 中声明的外键，否则按列名约定推断
+}
+
+// DetectRelations 识别表之间的引用关系
+// 除声明的外键外，按列名约定推断：xxx_id 引用表名为 xxx 或以 _xxx 结尾的表的主键，parent_id 引用本表主键
+func DetectRelations(tables []Table) []Relation {
+	var relations []Relation
+	for _, table := range tables {
+		declared := make(map[string]bool)
+		for _, fk := range table.ForeignKeys {
+			for i, column := range fk.Columns {
+				refColumn := ""
+				if i < len(fk.RefColumns) {
+					refColumn = fk.RefColumns[i]
+				}
+				declared[strings.ToLower(column)] = true
+				relations = append(relations, newRelation(table, column, fk.RefTable, refColumn, true))
+			}
+		}
+
+		for _, f := range table.Fields {
+			name := strings.ToLower(f.ColumnName)
+			if f.IsPrimaryKey || declared[name] || !strings.HasSuffix(name, "_id") {
+				continue
+			}
+			prefix := strings.TrimSuffix(name, "_id")
+			if prefix == "parent" {
+				relations = append(relations, newRelation(table, f.ColumnName, table.TableName, table.PrimaryKey.ColumnName, false))
+				continue
+			}
+			if ref, ok := findRefTable(tables, prefix); ok {
+				relations = append(relations, newRelation(table, f.ColumnName, ref.TableName, ref.PrimaryKey.ColumnName, false))
+			}
+		}
+	}
+	return relations
+}
+
+// newRelation 创建引用关系，根据引用列补全可空与唯一信息
+func newRelation(table Table, column, refTable, refColumn string, declared bool) Relation {
+	relation := Relation{
+		Table:     table.TableName,
+		Column:    column,
+		RefTable:  refTable,
+		RefColumn: refColumn,
+		Declared:  declared,
+	}
+	if f, ok := findField(table.Fields, column); ok {
+		relation.Nullable = f.IsNullable
+	}
+	for _, index := range table.Indexes {
+		if index.Unique && len(index.Columns) == 1 && strings.EqualFold(index.Columns[0], column) {
+			relation.Unique = true
+		}
+	}
+	return relation
+}
+
+// findRefTable 按列名前缀查找被引用的表，优先匹配同名表，其次匹配以 _前缀 结尾的表，如 dept 匹配 sys_dept
+func findRefTable(tables []Table, prefix string) (Table, bool) {
+	if table, ok := findTable(tables, prefix); ok {
+		return table, true
+	}
+	for _, table := range tables {
+		if strings.HasSuffix(strings.ToLower(table.TableName), "_"+prefix) {
+			return table, true
+		}
+	}
+	return Table{}, false
+}
+
+// columnKeys 获取字段的键标识，如 PK、UK、IDX、FK，多个以逗号分隔
+func columnKeys(tables []Table, table Table, field Field) string {
+	var keys []string
+	if field.IsPrimaryKey {
+		keys = append(keys, "PK")
+	}
+	unique, indexed := false, false
+	for _, index := range table.Indexes {
+		for _, column := range index.Columns {
+			if strings.EqualFold(column, field.ColumnName) {
+				unique = unique || index.Unique
+				indexed = indexed || !index.Unique
+			}
+		}
+	}
+	if unique {
+		keys = append(keys, "UK")
+	}
+	if indexed {
+		keys = append(keys, "IDX")
+	}
+	for _, relation := range tableRelations(tables, table) {
+		if strings.EqualFold(relation.Column, field.ColumnName) {
+			keys = append(keys, "FK")
+			break
+		}
+	}
+	return strings.Join(keys, ", ")
+}
+
+// tableRelations 获取指定表引用其他表的关系
+func tableRelations(tables []Table, table Table) []Relation {
+	var relations []Relation
+	for _, relation := range DetectRelations(tables) {
+		if strings.EqualFold(relation.Table, table.TableName) {
+			relations = append(relations, relation)
+		}
+	}
+	return relations
+}
+
+// ERDiagram 生成 Mermaid 格式的 ER 图
+func ERDiagram(tables []Table) string {
+	var b strings.Builder
+	b.WriteString("erDiagram\n")
+	for _, table := range tables {
+		fmt.Fprintf(&b, "    %s {\n", table.TableName)
+		for _, f := range table.Fields {
+			// Mermaid 的属性键只支持 PK、FK、UK
+			var keys []string
+			for _, key := range strings.Split(columnKeys(tables, table, f), ", ") {
+				if key == "PK" || key == "FK" || key == "UK" {
+					keys = append(keys, key)
+				}
+			}
+			line := fmt.Sprintf("        %s %s", strings.ToLower(f.ColumnType), f.ColumnName)
+			if len(keys) > 0 {
+				line += " " + strings.Join(keys, ", ")
+			}
+			if f.ColumnComment != "" {
+				line += ` "` + strings.NewReplacer(`"`, "'", "\n", " ").Replace(f.ColumnComment) + `"`
+			}
+			b.WriteString(line + "\n")
+		}
+		b.WriteString("    }\n")
+	}
+	for _, relation := range DetectRelations(tables) {
+		left, right := "||", "o{"
+		if relation.Nullable {
+			left = "|o"
+		}
+		if relation.Unique {
+			right = "o|"
+		}
+		fmt.Fprintf(&b, "    %s %s--%s %s : \"%s\"\n", relation.RefTable, left, right, relation.Table, relation.Column)
+	}
+	return b.String()
+}
+
+// markdownCell 转义 Markdown 表格单元格中的竖线和换行
+func markdownCell(s string) string {
+	return strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>").Replace(s)
+}
@@ -0,0 +1,109 @@
+package gencode
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const docDDL = `CREATE TABLE sys_dept (
+  id bigint PRIMARY KEY COMMENT '部门ID',
+  parent_id bigint COMMENT '上级部门',
+  name varchar(32) NOT NULL COMMENT '名称|简称'
+) COMMENT='部门';
+CREATE TABLE sys_user (
+  id bigint PRIMARY KEY,
+  dept_id bigint NOT NULL COMMENT '部门',
+  role_id bigint,
+  status enum('on','off') NOT NULL DEFAULT 'on' COMMENT '状态',
+  card_no varchar(18) COMMENT '证件"号"',
+  UNIQUE KEY uk_card_no (card_no),
+  CONSTRAINT fk_user_role FOREIGN KEY (role_id) REFERENCES sys_role (id)
+) COMMENT='用户';
+CREATE TABLE user_profile (
+  id bigint PRIMARY KEY,
+  user_id bigint NOT NULL,
+  UNIQUE KEY uk_user (user_id)
+)`
+
+func TestDetectRelations(t *testing.T) {
+	tables, err := ParseDDL(docDDL)
+	if err != nil {
+		t.Fatalf("解析DDL失败: %v", err)
+	}
+
+	expected := []Relation{
+		{Table: "sys_dept", Column: "parent_id", RefTable: "sys_dept", RefColumn: "id", Nullable: true},
+		{Table: "sys_user", Column: "role_id", RefTable: "sys_role", RefColumn: "id", Nullable: true, Declared: true},
+		{Table: "sys_user", Column: "dept_id", RefTable: "sys_dept", RefColumn: "id"},
+		{Table: "user_profile", Column: "user_id", RefTable: "sys_user", RefColumn: "id", Unique: true},
+	}
+	if relations := DetectRelations(tables); !reflect.DeepEqual(relations, expected) {
+		t.Errorf("关联关系 = %+v\nexpected %+v", relations, expected)
+	}
+
+	if keys := columnKeys(tables, tables[2], tables[2].Fields[1]); keys != "UK, FK" {
+		t.Errorf("键标识 = %s, expected UK, FK", keys)
+	}
+
+	diagram := ERDiagram(tables)
+	for _, e := range []string{
+		"    sys_dept {\n        bigint id PK \"部门ID\"\n",
+		`        varchar card_no UK "证件'号'"`,
+		`    sys_dept ||--o{ sys_user : "dept_id"`,
+		`    sys_dept |o--o{ sys_dept : "parent_id"`,
+		`    sys_user ||--o| user_profile : "user_id"`,
+	} {
+		if !strings.Contains(diagram, e) {
+			t.Errorf("ER图缺少: %s\n%s", e, diagram)
+		}
+	}
+}
+
+func TestGenerateDoc(t *testing.T) {
+	tables, err := ParseDDL(docDDL)
+	if err != nil {
+		t.Fatalf("解析DDL失败: %v", err)
+	}
+
+	outputPath := t.TempDir()
+	config := testConfig(outputPath)
+	config.GenConfig.Target = TargetDoc
+	if err := NewGenerator(config, tables).GenerateCode(); err != nil {
+		t.Fatalf("生成文档失败: %v", err)
+	}
+
+	testCases := []struct {
+		file     string
+		expected []string
+	}{
+		{"data-dictionary.md", []string{
+			"- [sys_dept](#sys_dept) 部门",
+			"## sys_user（用户）",
+			`| name | varchar(32) | 否 |  |  |  | 名称\|简称 |`,
+			"| status | enum('on','off') | 否 | 'on' |  | on, off | 状态 |",
+			"| role_id | [sys_role](#sys_role).id | 外键 |",
+			"| dept_id | [sys_dept](#sys_dept).id | 列名约定 |",
+			"```mermaid\nerDiagram\n",
+		}},
+		{"data-dictionary.html", []string{
+			`<h2 id="sys_user">sys_user（用户）</h2>`,
+			`<td>证件&#34;号&#34;</td>`,
+			`<td>uk_card_no</td><td>card_no</td><td>是</td>`,
+			`sys_dept ||--o{ sys_user : &#34;dept_id&#34;`,
+		}},
+	}
+	for _, tc := range testCases {
+		content, err := os.ReadFile(filepath.Join(outputPath, tc.file))
+		if err != nil {
+			t.Fatalf("读取文档失败: %v", err)
+		}
+		for _, e := range tc.expected {
+			if !strings.Contains(string(content), e) {
+				t.Errorf("%s 缺少: %s\n%s", tc.file, e, content)
+			}
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package gencode
+
+// 生成进度事件类型
+const (
+	EventTemplateStarted = "template_started" // 开始渲染模板
+	EventFileWritten     = "file_written"     // 文件已写入，在内存中渲染时为已收集
+	EventFileSkipped     = "file_skipped"     // 文件未写入，原因见 Event.Reason
+	EventError           = "error"            // 生成失败，生成随即结束
+)
+
+// 文件未写入的原因
+const (
+	SkipEmpty    = "empty"    // 渲染结果为空，如按条件生成的模板
+	SkipModified = "modified" // 上次生成后被手动修改，按 KeepModified 保留
+)
+
+// Event 生成进度事件
+type Event struct {
+	Type     string
+	Template string // 相对模板根目录的模板路径，插件生成的文件为 plugin:插件名
+	Table    string // 按表生成的模板所属的表
+	Output   string // 相对输出目录的文件路径
+	Reason   string // 文件未写入的原因
+	Index    int    // 开始渲染的是第几个模板，从 1 开始
+	Total    int    // 模板总数
+	Err      error
+}
+
+// emit 通过 OnEvent 发送进度事件
+func (g *Generator) emit(event Event) {
+	if g.OnEvent != nil {
+		g.OnEvent(event)
+	}
+}
@@ -0,0 +1,95 @@
+package gencode
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEvents(t *testing.T) {
+	outputPath := t.TempDir()
+	config := testConfig(outputPath)
+	config.GenConfig.KeepModified = true
+
+	var events []Event
+	g := NewGenerator(config, testTables())
+	g.OnEvent = func(event Event) { events = append(events, event) }
+	if err := g.GenerateCode(); err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+
+	counts := map[string]int{}
+	started := 0
+	for _, event := range events {
+		counts[event.Type]++
+		if event.Type == EventTemplateStarted {
+			started++
+			if event.Index != started || event.Total == 0 || event.Template == "" {
+				t.Errorf("模板开始事件 = %+v", event)
+			}
+		}
+		if event.Type == EventFileWritten && event.Output == "" {
+			t.Errorf("文件写入事件缺少输出路径: %+v", event)
+		}
+	}
+	if started == 0 || started != events[0].Total {
+		t.Errorf("模板开始事件数 = %d", started)
+	}
+	if counts[EventFileWritten] != len(g.outputs) || counts[EventError] != 0 {
+		t.Errorf("事件计数 = %v，生成文件 %d 个", counts, len(g.outputs))
+	}
+
+	// 手动修改的文件在再次生成时跳过
+	pom := filepath.Join(outputPath, "pom.xml")
+	if err := os.WriteFile(pom, []byte("<project/>"), 0644); err != nil {
+		t.Fatalf("修改文件失败: %v", err)
+	}
+	events = nil
+	g = NewGenerator(config, testTables())
+	g.OnEvent = func(event Event) { events = append(events, event) }
+	if err := g.GenerateCode(); err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+	skipped := false
+	for _, event := range events {
+		if event.Type == EventFileSkipped && event.Output == "pom.xml" {
+			skipped = event.Reason == SkipModified
+		}
+	}
+	if !skipped {
+		t.Errorf("未收到 pom.xml 的跳过事件")
+	}
+}
+
+func TestGenerateCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var written int
+	var failed error
+	g := NewGenerator(testConfig(t.TempDir()), testTables())
+	g.OnEvent = func(event Event) {
+		switch event.Type {
+		case EventFileWritten:
+			written++
+			cancel()
+		case EventError:
+			failed = event.Err
+		}
+	}
+	err := g.GenerateCodeContext(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("取消后应返回 context.Canceled: %v", err)
+	}
+	if failed != err {
+		t.Errorf("错误事件 = %v", failed)
+	}
+	if written != 1 {
+		t.Errorf("取消后仍写入了 %d 个文件", written)
+	}
+	if _, err := os.Stat(filepath.Join(g.outputDir(), filepath.FromSlash(ManifestFile))); !os.IsNotExist(err) {
+		t.Errorf("取消后不应写入清单: %v", err)
+	}
+}
@@ -0,0 +1,147 @@
+package gencode
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestExcelFields(t *testing.T) {
+	tables, err := ParseDDL(openAPIDDL)
+	if err != nil {
+		t.Fatalf("解析DDL失败: %v", err)
+	}
+	table := Conventions{}.Apply(tables[0])
+
+	var names []string
+	for _, f := range table.ExcelFields() {
+		names = append(names, f.ColumnName)
+	}
+	// 密码为敏感字段，版本号由框架维护，均不导出
+	if got := strings.Join(names, ","); got != "id,user_name,email,status,create_time" {
+		t.Errorf("ExcelFields = %s", got)
+	}
+}
+
+func TestGenerateExcel(t *testing.T) {
+	config := testConfig(t.TempDir())
+	config.GenConfig.EnableExcel = true
+	config.GenConfig.Layout = LayoutMavenMulti
+	config.GenConfig.Profile = ProfileBoot3
+	files, err := NewGenerator(config, testTables()).RenderFiles()
+	if err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+
+	// 导入导出模型与服务一同放在 domain 模块
+	domain := "gentest-domain/src/main/java/com/example/"
+	checks := map[string][]string{
+		domain + "excel/UserExcel.java": {
+			`@ExcelProperty(value = "用户名", index = 1)`,
+			`case "username":`,
+		},
+		domain + "excel/ExcelImportListener.java":    {"implements ReadListener<T>", "ExcelDataConvertException"},
+		domain + "excel/ExcelImportResult.java":      {"public static class RowError"},
+		domain + "service/IUserService.java":         {"List<UserExcel> exportList(UserQuery query);", "ExcelImportResult importExcel(InputStream inputStream);"},
+		domain + "service/impl/UserServiceImpl.java": {"import jakarta.validation.Validator;", "validator.validate(dto)", "saveBatch(entities);"},
+		domain + "converter/UserConverter.java":      {"public static UserExcel toExcel(User entity)", "dto.setUsername(excel.getUsername());"},
+		"gentest-web/src/main/java/com/example/controller/UserController.java": {
+			"import jakarta.servlet.http.HttpServletResponse;",
+			`@GetMapping("/export")`,
+			`@GetMapping("/import-template")`,
+			`@PostMapping("/import")`,
+		},
+		"gentest-domain/pom.xml": {"<artifactId>easyexcel</artifactId>"},
+		"pom.xml":                {"<easyexcel.version>3.3.4</easyexcel.version>"},
+	}
+	for name, contents := range checks {
+		content, ok := files[name]
+		if !ok {
+			t.Errorf("缺少 %s", name)
+			continue
+		}
+		for _, s := range contents {
+			if !strings.Contains(string(content), s) {
+				t.Errorf("%s 缺少 %s", name, s)
+			}
+		}
+	}
+
+	var doc struct {
+		Paths map[string]map[string]struct {
+			OperationID string `yaml:"operationId"`
+		} `yaml:"paths"`
+	}
+	if err := yaml.Unmarshal(files["openapi.yaml"], &doc); err != nil {
+		t.Fatalf("解析OpenAPI文档失败: %v", err)
+	}
+	if doc.Paths["/user/export"]["get"].OperationID != "userExport" || doc.Paths["/user/import"]["post"].OperationID != "userImport" {
+		t.Errorf("OpenAPI 文档缺少导入导出接口: %v", doc.Paths)
+	}
+
+	// 未开启时不生成导入导出代码和依赖
+	config.GenConfig.EnableExcel = false
+	files, err = NewGenerator(config, testTables()).RenderFiles()
+	if err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+	for name, content := range files {
+		if strings.Contains(name, "/excel/") || strings.Contains(string(content), "easyexcel") || strings.Contains(string(content), "/export") {
+			t.Errorf("未开启时 %s 不应包含导入导出代码", name)
+		}
+	}
+}
+
+func TestGenerateExcelImport(t *testing.T) {
+	config := testConfig(t.TempDir())
+	config.GenConfig.EnableExcel = true
+	config.GenConfig.Auth = AuthSaToken
+	render := func(ddl string) (map[string][]byte, Table) {
+		tables, err := ParseDDL(ddl)
+		if err != nil {
+			t.Fatalf("解析DDL失败: %v", err)
+		}
+		files, err := NewGenerator(config, tables).RenderFiles()
+		if err != nil {
+			t.Fatalf("生成代码失败: %v", err)
+		}
+		return files, Conventions{}.Apply(tables[0])
+	}
+	base := "src/main/java/com/example/"
+
+	// 必填的密码为敏感字段，不从 Excel 读取，导入的行无法通过校验，只生成导出
+	files, table := render(openAPIDDL)
+	if table.CanImport() {
+		t.Fatalf("必填字段不可导入时 CanImport 应为 false")
+	}
+	for _, name := range []string{"controller/SysUserController.java", "service/ISysUserService.java", "service/impl/SysUserServiceImpl.java", "converter/SysUserConverter.java"} {
+		for _, s := range []string{"importExcel", "toCreateDTO", "Validator", "ExcelImportResult"} {
+			if strings.Contains(string(files[base+name]), s) {
+				t.Errorf("%s 不应包含 %s", name, s)
+			}
+		}
+	}
+	if !strings.Contains(string(files[base+"controller/SysUserController.java"]), `@GetMapping("/export")`) {
+		t.Errorf("仍应生成导出接口")
+	}
+	if strings.Contains(string(files["openapi.yaml"]), "/sys_user/import") || strings.Contains(string(files["src/main/resources/db/menu.sql"]), "sys:user:import") {
+		t.Errorf("OpenAPI 文档和菜单不应包含导入")
+	}
+
+	// 密码可为空时生成导入，新增参数中的必填字段均从 Excel 读取
+	files, table = render(strings.Replace(openAPIDDL, "password varchar(64) NOT NULL", "password varchar(64)", 1))
+	converter := string(files[base+"converter/SysUserConverter.java"])
+	if !table.CanImport() || !strings.Contains(string(files[base+"service/impl/SysUserServiceImpl.java"]), "validator.validate(dto)") {
+		t.Fatalf("可导入时应生成导入代码")
+	}
+	for _, f := range table.FormFields() {
+		set := "dto.set" + upperFirst(f.FieldName) + "(excel."
+		if f.IsRequired() && !strings.Contains(converter, set) {
+			t.Errorf("必填字段 %s 未从 Excel 读取", f.ColumnName)
+		}
+		if f.Sensitive && strings.Contains(converter, set) {
+			t.Errorf("敏感字段 %s 不应从 Excel 读取", f.ColumnName)
+		}
+	}
+}
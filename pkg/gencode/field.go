@@ -0,0 +1,248 @@
+package gencode
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// 查询方式
+const (
+	QueryEq      = "eq"      // 等于
+	QueryLike    = "like"    // 模糊匹配
+	QueryBetween = "between" // 范围
+	QueryIn      = "in"      // 包含
+)
+
+// 表单控件类型
+const (
+	HtmlInput    = "input"
+	HtmlTextarea = "textarea"
+	HtmlNumber   = "number"
+	HtmlSelect   = "select"
+	HtmlRadio    = "radio"
+	HtmlCheckbox = "checkbox"
+	HtmlDatetime = "datetime"
+	HtmlImage    = "image"
+	HtmlFile     = "file"
+	HtmlEditor   = "editor"
+)
+
+var (
+	queryTypes = []string{QueryEq, QueryLike, QueryBetween, QueryIn}
+	htmlTypes  = []string{HtmlInput, HtmlTextarea, HtmlNumber, HtmlSelect, HtmlRadio, HtmlCheckbox, HtmlDatetime, HtmlImage, HtmlFile, HtmlEditor}
+)
+
+// IsRequired 字段是否必填，未设置时非空且非主键的字段为必填
+func (f Field) IsRequired() bool {
+	if f.Required != nil {
+		return *f.Required
+	}
+	return !f.IsNullable && !f.IsPrimaryKey
+}
+
+// IsList 字段是否在列表中显示，逻辑删除、版本和敏感字段不显示
+func (f Field) IsList() bool {
+	return !f.HideInList && !f.LogicDelete && !f.Version && !f.Sensitive
+}
+
+// IsResponse 字段是否在响应中返回，敏感字段和逻辑删除字段不返回
+func (f Field) IsResponse() bool {
+	return !f.Sensitive && !f.LogicDelete
+}
+
+// Validations 根据是否必填、字段长度和字段名生成请求参数的校验注解
+func (f Field) Validations() []string {
+	label := f.ColumnComment
+	if label == "" {
+		label = f.FieldName
+	}
+	var annotations []string
+	if f.IsRequired() {
+		if f.JavaType == "String" {
+			annotations = append(annotations, fmt.Sprintf(`@NotBlank(message = "%s不能为空")`, label))
+		} else {
+			annotations = append(annotations, fmt.Sprintf(`@NotNull(message = "%s不能为空")`, label))
+		}
+	}
+	if f.JavaType == "String" && f.Length > 0 {
+		annotations = append(annotations, fmt.Sprintf(`@Size(max = %d, message = "%s长度不能超过%d个字符")`, f.Length, label, f.Length))
+	}
+	if f.JavaType == "String" && strings.Contains(strings.ToLower(f.ColumnName), "email") {
+		annotations = append(annotations, fmt.Sprintf(`@Email(message = "%s格式不正确")`, label))
+	}
+	return annotations
+}
+
+// UpdateValidations 生成修改参数的校验注解，敏感字段不在视图中返回，修改时可不传，不校验必填
+func (f Field) UpdateValidations() []string {
+	if f.Sensitive {
+		required := false
+		f.Required = &required
+	}
+	return f.Validations()
+}
+
+// IsEdit 字段是否可在表单中编辑，主键和约定字段不可编辑
+func (f Field) IsEdit() bool {
+	return !f.ReadOnly && !f.IsPrimaryKey && f.Fill == "" && !f.LogicDelete && !f.Version
+}
+
+// IsExcel 字段是否导出到 Excel 或从 Excel 导入，列表中显示或可编辑的字段中除去敏感字段和二进制字段
+func (f Field) IsExcel() bool {
+	return (f.IsList() || f.IsEdit()) && !f.Sensitive && f.JavaType != "byte[]"
+}
+
+// IsQuery 字段是否作为查询条件，逻辑删除和版本字段由框架处理，不作为查询条件
+func (f Field) IsQuery() bool {
+	return f.QueryType != "" && !f.LogicDelete && !f.Version
+}
+
+// DatePattern 日期时间字段的格式，date 字段只有日期，time 字段只有时间
+func (f Field) DatePattern() string {
+	switch strings.ToLower(f.ColumnType) {
+	case "date":
+		return "yyyy-MM-dd"
+	case "time":
+		return "HH:mm:ss"
+	default:
+		return "yyyy-MM-dd HH:mm:ss"
+	}
+}
+
+// Validate 校验字段的生成设置
+func (f Field) Validate() error {
+	if f.QueryType != "" && !slices.Contains(queryTypes, f.QueryType) {
+		return fmt.Errorf("字段 %s 的查询方式 %s 不支持，可选值: %s", f.ColumnName, f.QueryType, strings.Join(queryTypes, "/"))
+	}
+	if f.HtmlType != "" && !slices.Contains(htmlTypes, f.HtmlType) {
+		return fmt.Errorf("字段 %s 的表单控件 %s 不支持，可选值: %s", f.ColumnName, f.HtmlType, strings.Join(htmlTypes, "/"))
+	}
+	if f.Fill != "" && f.Fill != FillInsert && f.Fill != FillInsertUpdate {
+		return fmt.Errorf("字段 %s 的填充方式 %s 不支持，可选值: %s/%s", f.ColumnName, f.Fill, FillInsert, FillInsertUpdate)
+	}
+	return nil
+}
+
+// ListFields 获取列表中显示的字段
+func (t Table) ListFields() []Field {
+	return t.filterFields(Field.IsList)
+}
+
+// FormFields 获取表单中可编辑的字段
+func (t Table) FormFields() []Field {
+	return t.filterFields(Field.IsEdit)
+}
+
+// ResponseFields 获取在响应中返回的字段
+func (t Table) ResponseFields() []Field {
+	return t.filterFields(Field.IsResponse)
+}
+
+// ExcelFields 获取导出和导入的字段
+func (t Table) ExcelFields() []Field {
+	return t.filterFields(Field.IsExcel)
+}
+
+// CanImport 是否生成 Excel 导入，必填的可编辑字段均可从 Excel 读取时导入的行才能通过新增参数的校验
+func (t Table) CanImport() bool {
+	for _, f := range t.FormFields() {
+		if f.IsRequired() && !f.IsExcel() {
+			return false
+		}
+	}
+	return true
+}
+
+// QueryFields 获取作为查询条件的字段
+func (t Table) QueryFields() []Field {
+	return t.filterFields(Field.IsQuery)
+}
+
+// HasStringLike 表中是否有模糊查询的字符串字段，其查询条件需判断空白字符串
+func (t Table) HasStringLike() bool {
+	for _, f := range t.QueryFields() {
+		if f.QueryType == QueryLike && f.JavaType == "String" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasGoType 判断表中是否有指定Go类型的字段
+func (t Table) HasGoType(goType string) bool {
+	for _, f := range t.Fields {
+		if f.GoType == goType {
+			return true
+		}
+	}
+	return false
+}
+
+// HasJavaType 判断表中是否有指定Java类型的字段
+func (t Table) HasJavaType(javaType string) bool {
+	for _, f := range t.Fields {
+		if f.JavaType == javaType {
+			return true
+		}
+	}
+	return false
+}
+
+// filterFields 按条件筛选字段
+func (t Table) filterFields(match func(Field) bool) []Field {
+	var fields []Field
+	for _, f := range t.Fields {
+		if match(f) {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// queryTypeOf 根据字段类型推断默认查询方式：编号与枚举等值匹配，字符串模糊匹配，日期与数值范围查询
+func queryTypeOf(field Field) string {
+	name := strings.ToLower(field.ColumnName)
+	if name == "id" || strings.HasSuffix(name, "_id") {
+		return QueryEq
+	}
+	switch strings.ToLower(field.ColumnType) {
+	case "tinyint", "bit", "bool", "boolean", "enum", "set", "char":
+		return QueryEq
+	case "varchar":
+		return QueryLike
+	case "date", "datetime", "timestamp", "time", "year",
+		"bigint", "int", "integer", "mediumint", "smallint", "decimal", "numeric", "float", "double", "real":
+		return QueryBetween
+	default:
+		return ""
+	}
+}
+
+// sensitiveNames 默认视为敏感字段的列名关键字
+var sensitiveNames = []string{"password", "passwd", "pwd", "secret", "salt"}
+
+// isSensitive 根据列名判断是否为敏感字段
+func isSensitive(columnName string) bool {
+	name := strings.ToLower(columnName)
+	return slices.ContainsFunc(sensitiveNames, func(s string) bool {
+		return strings.Contains(name, s)
+	})
+}
+
+// htmlTypeOf 根据字段类型推断表单控件类型
+func htmlTypeOf(field Field) string {
+	if field.DictType != "" {
+		return HtmlSelect
+	}
+	switch strings.ToLower(field.ColumnType) {
+	case "text", "tinytext", "mediumtext", "longtext":
+		return HtmlTextarea
+	case "date", "datetime", "timestamp", "time":
+		return HtmlDatetime
+	case "bigint", "int", "integer", "mediumint", "smallint", "tinyint", "decimal", "numeric", "float", "double", "real":
+		return HtmlNumber
+	default:
+		return HtmlInput
+	}
+}
@@ -0,0 +1,242 @@
+package gencode
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFieldSettings(t *testing.T) {
+	required := false
+	table := CompleteTable(Table{
+		TableName:    "article",
+		TableComment: "文章表",
+		Fields: []Field{
+			{ColumnName: "id", ColumnType: "bigint", IsPrimaryKey: true},
+			{ColumnName: "title", ColumnType: "varchar", QueryType: QueryLike, Required: &required},
+			{ColumnName: "content", ColumnType: "text", HideInList: true, IsNullable: true},
+			{ColumnName: "status", ColumnType: "tinyint", QueryType: QueryIn, DictType: "article_status"},
+			{ColumnName: "publish_time", ColumnType: "datetime", QueryType: QueryBetween, ReadOnly: true},
+		},
+	})
+
+	testCases := []struct {
+		field    Field
+		htmlType string
+		required bool
+		list     bool
+		edit     bool
+	}{
+		{table.Fields[0], HtmlNumber, false, true, false},
+		{table.Fields[1], HtmlInput, false, true, true},
+		{table.Fields[2], HtmlTextarea, false, false, true},
+		{table.Fields[3], HtmlSelect, true, true, true},
+		{table.Fields[4], HtmlDatetime, true, true, false},
+	}
+	for _, tc := range testCases {
+		if tc.field.HtmlType != tc.htmlType {
+			t.Errorf("%s 表单控件 = %s, expected %s", tc.field.ColumnName, tc.field.HtmlType, tc.htmlType)
+		}
+		if tc.field.IsRequired() != tc.required {
+			t.Errorf("%s 必填 = %v, expected %v", tc.field.ColumnName, tc.field.IsRequired(), tc.required)
+		}
+		if tc.field.IsList() != tc.list || tc.field.IsEdit() != tc.edit {
+			t.Errorf("%s 列表/表单 = %v/%v, expected %v/%v", tc.field.ColumnName, tc.field.IsList(), tc.field.IsEdit(), tc.list, tc.edit)
+		}
+	}
+	if n := len(table.QueryFields()); n != 3 {
+		t.Errorf("查询字段数量 = %d, expected 3", n)
+	}
+	if n := len(table.FormFields()); n != 3 {
+		t.Errorf("表单字段数量 = %d, expected 3", n)
+	}
+
+	if err := (Field{ColumnName: "title", QueryType: "gt"}).Validate(); err == nil {
+		t.Errorf("不支持的查询方式应返回错误")
+	}
+	if err := (Field{ColumnName: "title", HtmlType: "slider"}).Validate(); err == nil {
+		t.Errorf("不支持的表单控件应返回错误")
+	}
+	// 生成时同样校验，不支持的设置不会交给模板
+	invalid := table
+	invalid.Fields = slices.Clone(table.Fields)
+	invalid.Fields[1].QueryType = "gt"
+	if _, err := NewGenerator(testConfig(""), []Table{invalid}).RenderFiles(); err == nil || !strings.Contains(err.Error(), "查询方式 gt 不支持") {
+		t.Errorf("生成时不支持的查询方式应返回错误: %v", err)
+	}
+
+	// 生成的查询条件类与Service根据查询方式构建查询条件
+	outputPath := t.TempDir()
+	generator := NewGenerator(testConfig(outputPath), []Table{table})
+	if err := generator.GenerateCode(); err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+	expectedFiles := map[string][]string{
+		"src/main/java/com/example/query/ArticleQuery.java": {
+			`private String title;`,
+			`private Date beginPublishTime;`,
+			`private List<Integer> statusList;`,
+		},
+		"src/main/java/com/example/service/impl/ArticleServiceImpl.java": {
+			`import com.baomidou.mybatisplus.core.toolkit.StringUtils;`,
+			`wrapper.like(StringUtils.isNotBlank(query.getTitle()), Article::getTitle, query.getTitle());`,
+			`wrapper.ge(query.getBeginPublishTime() != null, Article::getPublishTime, query.getBeginPublishTime());`,
+			`wrapper.in(query.getStatusList() != null && !query.getStatusList().isEmpty(), Article::getStatus, query.getStatusList());`,
+		},
+		"src/main/java/com/example/controller/ArticleController.java": {
+			`return ArticleConverter.toVOList(articleService.queryList(query));`,
+			`return articleService.queryPage(page, query).convert(ArticleConverter::toVO);`,
+		},
+	}
+	for file, expected := range expectedFiles {
+		content, err := os.ReadFile(filepath.Join(outputPath, file))
+		if err != nil {
+			t.Fatalf("读取生成文件失败: %v", err)
+		}
+		for _, e := range expected {
+			if !strings.Contains(string(content), e) {
+				t.Errorf("%s 缺少: %s", file, e)
+			}
+		}
+		if strings.Contains(string(content), "Content") {
+			t.Errorf("%s: 未设置查询方式的字段不应作为查询条件", file)
+		}
+	}
+
+	// 没有字符串模糊查询时不引入 StringUtils
+	table.Fields[1].QueryType = QueryEq
+	files, err := NewGenerator(testConfig(""), []Table{table}).RenderFiles()
+	if err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+	if content := string(files["src/main/java/com/example/service/impl/ArticleServiceImpl.java"]); strings.Contains(content, "StringUtils") {
+		t.Errorf("不应引入 StringUtils:\n%s", content)
+	}
+}
+
+func TestGenerateKratos(t *testing.T) {
+	tables, err := ParseDDL(`CREATE TABLE user_info (
+  id bigint PRIMARY KEY,
+  user_name varchar(32) NOT NULL COMMENT '用户名',
+  dept_id int,
+  created_time datetime,
+  bio text
+) COMMENT '用户信息'`)
+	if err != nil {
+		t.Fatalf("解析DDL失败: %v", err)
+	}
+
+	outputPath := t.TempDir()
+	config := testConfig(outputPath)
+	config.ProjectName = "example.com/demo"
+	config.GenConfig.Target = TargetKratos
+	generator := NewGenerator(config, tables)
+	if err := generator.GenerateCode(); err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(outputPath, "pom.xml")); !os.IsNotExist(err) {
+		t.Errorf("Kratos 目标不应生成 Java 文件")
+	}
+	content, err := os.ReadFile(filepath.Join(outputPath, "internal/service/user_info.go"))
+	if err != nil {
+		t.Fatalf("读取生成文件失败: %v", err)
+	}
+	for _, expected := range []string{
+		`v1 "example.com/demo/api/user_info/v1"`,
+		`filtering.DeclareIdent("user_name", filtering.TypeString),`,
+		`filtering.DeclareIdent("dept_id", filtering.TypeInt),`,
+		`filtering.DeclareIdent("created_time", filtering.TypeTimestamp),`,
+	} {
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("Service 缺少: %s", expected)
+		}
+	}
+	if strings.Contains(string(content), `"bio"`) {
+		t.Errorf("text 字段不应作为过滤条件")
+	}
+
+	config.GenConfig.Target = "php"
+	if err := NewGenerator(config, tables).GenerateCode(); err == nil {
+		t.Errorf("不支持的生成目标应返回错误")
+	}
+}
+
+func TestGenerateDTO(t *testing.T) {
+	tables, err := ParseDDL(`CREATE TABLE sys_user (
+  id bigint PRIMARY KEY,
+  user_name varchar(32) NOT NULL COMMENT '用户名',
+  email varchar(128) COMMENT '邮箱',
+  password varchar(64) NOT NULL COMMENT '密码',
+  dept_id bigint NOT NULL COMMENT '部门',
+  create_time datetime
+) COMMENT '用户表'`)
+	if err != nil {
+		t.Fatalf("解析DDL失败: %v", err)
+	}
+	if !tables[0].Fields[3].Sensitive || tables[0].Fields[3].IsQuery() {
+		t.Errorf("password 应识别为敏感字段且不作为查询条件")
+	}
+
+	outputPath := t.TempDir()
+	if err := NewGenerator(testConfig(outputPath), tables).GenerateCode(); err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+	testCases := []struct {
+		file       string
+		expected   []string
+		unexpected []string
+	}{
+		{
+			file: "src/main/java/com/example/dto/SysUserCreateDTO.java",
+			expected: []string{
+				"@NotBlank(message = \"用户名不能为空\")\n    @Size(max = 32, message = \"用户名长度不能超过32个字符\")\n    private String userName;",
+				"@Size(max = 128, message = \"邮箱长度不能超过128个字符\")\n    @Email(message = \"邮箱格式不正确\")\n    private String email;",
+				"@NotNull(message = \"部门不能为空\")\n    private Long deptId;",
+				`private String password;`,
+			},
+			unexpected: []string{`private Long id;`, `createTime`},
+		},
+		{
+			file: "src/main/java/com/example/dto/SysUserUpdateDTO.java",
+			expected: []string{
+				"@NotNull(message = \"id不能为空\")\n    private Long id;",
+				// 视图不返回敏感字段，修改时可不传，只校验长度
+				"/** 密码 */\n    @Size(max = 64, message = \"密码长度不能超过64个字符\")\n    private String password;",
+			},
+			unexpected: []string{"密码不能为空"},
+		},
+		{
+			file:       "src/main/java/com/example/vo/SysUserVO.java",
+			expected:   []string{`private Long id;`, `private Date createTime;`},
+			unexpected: []string{`password`},
+		},
+		{
+			file:       "src/main/java/com/example/converter/SysUserConverter.java",
+			expected:   []string{`entity.setPassword(dto.getPassword());`, `vo.setUserName(entity.getUserName());`},
+			unexpected: []string{`vo.setPassword`},
+		},
+		{
+			file:     "src/main/java/com/example/controller/SysUserController.java",
+			expected: []string{`public boolean add(@Validated @RequestBody SysUserCreateDTO dto)`, `public SysUserVO getInfo(`},
+		},
+	}
+	for _, tc := range testCases {
+		content, err := os.ReadFile(filepath.Join(outputPath, tc.file))
+		if err != nil {
+			t.Fatalf("读取生成文件失败: %v", err)
+		}
+		for _, e := range tc.expected {
+			if !strings.Contains(string(content), e) {
+				t.Errorf("%s 缺少: %s", tc.file, e)
+			}
+		}
+		for _, e := range tc.unexpected {
+			if strings.Contains(string(content), e) {
+				t.Errorf("%s 不应包含: %s", tc.file, e)
+			}
+		}
+	}
+}
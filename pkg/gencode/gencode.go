@@ -2,7 +2,12 @@ package gencode
 
 import (
 	"bufio"
+	"bytes"
+	"context"
+	"embed"
 	"fmt"
+	"go/format"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -11,22 +16,50 @@ import (
 	"time"
 )
 
+// embeddedTemplates 内嵌的模板，部署的服务和其他目录中运行的程序不依赖源码中的模板目录
+//
+//go:embed all:template
+var embeddedTemplates embed.FS
+
 // Config 代码生成器配置
 type Config struct {
-	ProjectName   string        `json:"project_name"`
-	GenConfig     GenConfig     `json:"gen_config"`
-	PackageConfig PackageConfig `json:"package_config"`
+	ProjectName   string         `json:"project_name"`
+	GenConfig     GenConfig      `json:"gen_config"`
+	PackageConfig PackageConfig  `json:"package_config"`
+	DeployConfig  DeployConfig   `json:"deploy_config"`
+	Vars          map[string]any `json:"vars"` // 自定义模板变量，模板中通过 .Vars 引用，如公司名、版权声明
 }
 
 // GenConfig 代码生成配置
 type GenConfig struct {
+	Target        string `json:"target"` // 生成目标 java/kratos/doc，默认 java
 	OutputPath    string `json:"output_path"`
 	EnableLombok  bool   `json:"enable_lombok"`
 	EnableSwagger bool   `json:"enable_swagger"`
+	EnableExcel   bool   `json:"enable_excel"` // 生成基于 EasyExcel 的导出、导入接口
 	Author        string `json:"author"`
 	Date          string `json:"date"`
+	CleanStale    bool   `json:"clean_stale"`   // 删除清单中已不再生成的过期文件
+	KeepModified  bool   `json:"keep_modified"` // 保留上次生成后被手动修改过的文件，不再覆盖
+
+	Conventions Conventions `json:"conventions"` // 审计、逻辑删除和乐观锁字段约定
+	Migration   []string    `json:"migration"`   // 输出版本化迁移脚本的数据库方言 mysql/postgres，为空时不输出
+	Dialect     string      `json:"dialect"`     // 生成 schema.sql 的数据库方言 mysql/postgres/sqlite，默认 mysql
+	Layout      string      `json:"layout"`      // Java 项目结构 maven/maven-multi/gradle，默认 maven
+	Profile     string      `json:"profile"`     // Java 版本配置 boot2/boot3，默认 boot2
+	CI          string      `json:"ci"`          // 持续集成流水线 jenkins/github/gitlab/drone，默认 jenkins
+	Auth        string      `json:"auth"`        // 权限框架 spring-security/sa-token，为空时不生成权限控制
+	Strict      bool        `json:"strict"`      // 严格模式，引用未定义的环境变量或 .Vars 变量时报错
+	Mock        MockConfig  `json:"mock"`        // 模拟数据，输出 INSERT 脚本和 JSON 数据
 }
 
+// 生成目标，对应 template 下的子目录
+const (
+	TargetJava   = "java"   // Spring Boot + MyBatis-Plus
+	TargetKratos = "kratos" // Kratos + ent
+	TargetDoc    = "doc"    // 数据字典文档
+)
+
 // PackageConfig 包名配置
 type PackageConfig struct {
 	BasePackage       string `json:"base_package"`
@@ -34,6 +67,10 @@ type PackageConfig struct {
 	MapperPackage     string `json:"mapper_package"`
 	ServicePackage    string `json:"service_package"`
 	ControllerPackage string `json:"controller_package"`
+	QueryPackage      string `json:"query_package"`     // 查询条件包名，默认 BasePackage.query
+	DtoPackage        string `json:"dto_package"`       // 请求参数包名，默认 BasePackage.dto
+	VoPackage         string `json:"vo_package"`        // 响应视图包名，默认 BasePackage.vo
+	ConverterPackage  string `json:"converter_package"` // 对象转换包名，默认 BasePackage.converter
 }
 
 // Table 表信息
@@ -42,6 +79,23 @@ type Table struct {
 	TableComment string
 	Fields       []Field
 	PrimaryKey   Field
+	Indexes      []Index
+	ForeignKeys  []ForeignKey
+}
+
+// Index 索引信息
+type Index struct {
+	Name    string
+	Columns []string
+	Unique  bool
+}
+
+// ForeignKey 外键信息，仅用于文档中的表关系，不输出到建表语句
+type ForeignKey struct {
+	Name       string
+	Columns    []string
+	RefTable   string
+	RefColumns []string
 }
 
 // Field 字段信息
@@ -54,13 +108,46 @@ type Field struct {
 	GoType        string
 	JavaType      string
 	FieldName     string
+	Length        int      // 字段长度，如 varchar(64) 中的 64，未声明时为 0
+	Scale         int      // 小数位数，如 decimal(10,2) 中的 2
+	DefaultValue  string   // 默认值表达式，如 0、''、CURRENT_TIMESTAMP，未声明时为空
+	EnumValues    []string // enum/set 类型的可选值
+	AutoIncrement bool     // 自增列，如 MySQL 的 AUTO_INCREMENT，未声明时主键由应用生成
+
+	// 生成设置
+	HideInList bool   // 列表中不显示
+	ReadOnly   bool   // 表单中不可编辑
+	QueryType  string // 查询方式 eq/like/between/in，为空则不作为查询条件
+	HtmlType   string // 表单控件类型，为空时根据字段类型推断
+	DictType   string // 绑定的字典类型
+	Required   *bool  // 是否必填，为空时根据字段是否可空推断
+	Sensitive  bool   // 敏感字段，不在响应中返回，如密码
+
+	// 约定字段，为空时按 GenConfig.Conventions 根据列名识别
+	Fill        string // 自动填充方式 INSERT/INSERT_UPDATE
+	LogicDelete bool   // 逻辑删除字段
+	Version     bool   // 乐观锁版本字段
 }
 
 // Generator 代码生成器
 type Generator struct {
 	Config       Config
 	Tables       []Table
-	TemplatePath string
+	TemplatePath string         // 项目根目录，其下存在 pkg/gencode/template 时使用该目录的模板，否则使用内嵌的模板
+	Report       ManifestReport // 最近一次生成的清单比对结果
+
+	// LookupEnv 读取配置中 ${NAME} 引用的环境变量，为空时使用 os.LookupEnv
+	LookupEnv func(key string) (string, bool)
+	Hooks     []Hook      // 进程内钩子，按顺序调用
+	Plugins   []Plugin    // 外部插件，在模板生成后按顺序执行
+	OnEvent   func(Event) // 接收生成进度事件，在生成所在的 goroutine 中同步调用
+
+	prevManifest *Manifest // 上次生成的清单
+	manifest     *Manifest // 本次生成的清单
+	expanded     bool      // 配置中的环境变量引用是否已展开
+
+	files   map[string][]byte // 在内存中渲染时收集的文件，键为相对输出目录的路径
+	outputs []string          // 本次生成的文件，相对输出目录的路径
 }
 
 // TemplateInfo 模板信息
@@ -68,6 +155,7 @@ type TemplateInfo struct {
 	FilePath   string // 模板文件路径
 	OutputPath string // 输出路径（从元数据解析或根据模板路径生成）
 	IsPerTable bool   // 是否需要为每个表生成（包含表相关变量）
+	IsPerEnv   bool   // 是否需要为每个部署环境生成（包含 .Environment 变量）
 }
 
 // NewGenerator 创建代码生成器实例
@@ -103,13 +191,8 @@ func (g *Generator) Init() error {
 
 // EnsureOutputDirs 确保输出目录存在
 func (g *Generator) EnsureOutputDirs() error {
-	outputDir := g.Config.GenConfig.OutputPath
-	if outputDir == "" {
-		outputDir = "./output"
-	}
-
 	// 创建基础输出目录
-	err := os.MkdirAll(outputDir, 0755)
+	err := os.MkdirAll(g.outputDir(), 0755)
 	if err != nil {
 		return err
 	}
@@ -117,51 +200,234 @@ func (g *Generator) EnsureOutputDirs() error {
 	return nil
 }
 
+// outputDir 获取基础输出目录
+func (g *Generator) outputDir() string {
+	if g.Config.GenConfig.OutputPath == "" {
+		return "./output"
+	}
+	return g.Config.GenConfig.OutputPath
+}
+
 // GenerateCode 生成代码
 func (g *Generator) GenerateCode() error {
+	return g.GenerateCodeContext(context.Background())
+}
+
+// GenerateCodeContext 生成代码并通过 OnEvent 发送进度事件，
+// ctx 取消后不再渲染新的模板和表，返回 ctx.Err()
+func (g *Generator) GenerateCodeContext(ctx context.Context) error {
+	err := g.generateCode(ctx)
+	if err != nil {
+		g.emit(Event{Type: EventError, Err: err})
+	}
+	return err
+}
+
+// generateCode 依次渲染模板、执行插件、生成迁移脚本并写入清单
+func (g *Generator) generateCode(ctx context.Context) error {
+	// 展开配置中的环境变量引用
+	err := g.ExpandConfig()
+	if err != nil {
+		return fmt.Errorf("展开配置失败: %v", err)
+	}
+
+	// 校验生成配置
+	err = g.checkConfig()
+	if err != nil {
+		return err
+	}
+
 	// 扫描所有模板文件
 	templates, err := g.scanTemplates()
 	if err != nil {
 		return fmt.Errorf("扫描模板文件失败: %v", err)
 	}
 
+	// 加载上次生成的清单
+	err = g.beginManifest()
+	if err != nil {
+		return fmt.Errorf("加载生成清单失败: %v", err)
+	}
+
 	// 生成代码
-	for _, tmplInfo := range templates {
+	g.outputs = nil
+	tables, err := g.prepareTables()
+	if err != nil {
+		return err
+	}
+	err = g.renderTemplates(ctx, templates, tables, nil)
+	if err != nil {
+		return err
+	}
+
+	// 执行外部插件
+	err = g.runPlugins(ctx, tables)
+	if err != nil {
+		return err
+	}
+
+	// 生成模拟数据
+	err = g.generateMock(tables)
+	if err != nil {
+		return fmt.Errorf("生成模拟数据失败: %v", err)
+	}
+
+	// 对比上次的表结构快照生成迁移脚本
+	err = g.generateMigrations()
+	if err != nil {
+		return fmt.Errorf("生成迁移脚本失败: %v", err)
+	}
+
+	// 清理过期文件并写入本次清单
+	err = g.finishManifest()
+	if err != nil {
+		return fmt.Errorf("写入生成清单失败: %v", err)
+	}
+
+	// 在内存中渲染时没有写入磁盘的文件
+	if g.files != nil {
+		return nil
+	}
+	return g.afterRun(g.outputs)
+}
+
+// checkConfig 校验生成配置，不支持的取值会生成不完整的项目，直接返回错误
+func (g *Generator) checkConfig() error {
+	if g.target() != TargetJava {
+		return nil
+	}
+	if err := checkLayout(g.layout()); err != nil {
+		return err
+	}
+	if err := checkCI(g.ci()); err != nil {
+		return err
+	}
+	if err := checkProfile(g.Config.GenConfig.Profile); err != nil {
+		return err
+	}
+	return checkAuth(g.auth())
+}
+
+// prepareTables 按约定识别审计、逻辑删除和乐观锁字段，并按 Java 版本配置调整日期时间类型，再交给钩子处理，最后校验字段的生成设置
+func (g *Generator) prepareTables() ([]Table, error) {
+	tables := make([]Table, len(g.Tables))
+	for i, table := range g.Tables {
+		tables[i] = g.applyProfile(g.Config.GenConfig.Conventions.Apply(table))
+		err := g.beforeTable(&tables[i])
+		if err != nil {
+			return nil, err
+		}
+		// 模板只处理支持的查询方式、表单控件和填充方式
+		for _, field := range tables[i].Fields {
+			if err := field.Validate(); err != nil {
+				return nil, fmt.Errorf("表 %s: %v", tables[i].TableName, err)
+			}
+		}
+	}
+	return tables, nil
+}
+
+// renderTemplates 渲染模板，only 不为空时按表生成的模板只为其中的表生成
+func (g *Generator) renderTemplates(ctx context.Context, templates []TemplateInfo, tables []Table, only map[string]bool) error {
+	for i, tmplInfo := range templates {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		g.emit(Event{Type: EventTemplateStarted, Template: g.templateName(tmplInfo.FilePath), Index: i + 1, Total: len(templates)})
+
 		if tmplInfo.IsPerTable {
 			// 需要为每个表生成
-			for _, table := range g.Tables {
+			for _, table := range tables {
+				if only != nil && !only[table.TableName] {
+					continue
+				}
+				if err := ctx.Err(); err != nil {
+					return err
+				}
 				templateData := g.prepareTemplateData(&table)
 				err := g.generateFromTemplate(tmplInfo, templateData)
 				if err != nil {
 					return fmt.Errorf("生成文件失败 [%s]: %v", tmplInfo.FilePath, err)
 				}
 			}
+		} else if tmplInfo.IsPerEnv {
+			// 需要为每个部署环境生成
+			for _, env := range g.deploy().Environments {
+				templateData := g.prepareOnceTemplateData(tables)
+				templateData.Environment = env
+				err := g.generateFromTemplate(tmplInfo, templateData)
+				if err != nil {
+					return fmt.Errorf("生成文件失败 [%s]: %v", tmplInfo.FilePath, err)
+				}
+			}
 		} else {
 			// 只生成一次（如pom.xml, Application.java等）
-			templateData := TemplateData{
-				Config: g.Config,
-			}
+			templateData := g.prepareOnceTemplateData(tables)
 			err := g.generateFromTemplate(tmplInfo, templateData)
 			if err != nil {
 				return fmt.Errorf("生成文件失败 [%s]: %v", tmplInfo.FilePath, err)
 			}
 		}
 	}
-
 	return nil
 }
 
-// scanTemplates 扫描所有模板文件
+// templateDir 获取模板根目录
+func (g *Generator) templateDir() string {
+	return filepath.Join(g.TemplatePath, "pkg/gencode/template")
+}
+
+// templatesOnDisk 模板根目录是否存在，存在时可修改模板并监听变更
+func (g *Generator) templatesOnDisk() bool {
+	info, err := os.Stat(g.templateDir())
+	return err == nil && info.IsDir()
+}
+
+// templateFS 获取模板文件系统，模板根目录不存在时使用内嵌的模板
+func (g *Generator) templateFS() fs.FS {
+	if g.templatesOnDisk() {
+		return os.DirFS(g.templateDir())
+	}
+	sub, _ := fs.Sub(embeddedTemplates, "template")
+	return sub
+}
+
+// readTemplate 读取模板文件，templatePath 为模板根目录下的路径
+func (g *Generator) readTemplate(templatePath string) ([]byte, error) {
+	return fs.ReadFile(g.templateFS(), g.templateName(templatePath))
+}
+
+// target 获取生成目标，默认 java
+func (g *Generator) target() string {
+	if g.Config.GenConfig.Target == "" {
+		return TargetJava
+	}
+	return g.Config.GenConfig.Target
+}
+
+// dialect 获取 schema.sql 的数据库方言，默认 mysql
+func (g *Generator) dialect() string {
+	if g.Config.GenConfig.Dialect == "" {
+		return DialectMySQL
+	}
+	return g.Config.GenConfig.Dialect
+}
+
+// scanTemplates 扫描生成目标下的所有模板文件
 func (g *Generator) scanTemplates() ([]TemplateInfo, error) {
 	var templates []TemplateInfo
-	templateDir := filepath.Join(g.TemplatePath, "pkg/gencode/template")
+	fsys := g.templateFS()
+	if info, err := fs.Stat(fsys, g.target()); err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("不支持的生成目标: %s", g.target())
+	}
 
-	err := filepath.Walk(templateDir, func(path string, info os.FileInfo, err error) error {
+	err := fs.WalkDir(fsys, g.target(), func(name string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
 
-		if !info.IsDir() && strings.HasSuffix(path, ".tpl") {
+		if !d.IsDir() && strings.HasSuffix(name, ".tpl") {
+			path := filepath.Join(g.templateDir(), filepath.FromSlash(name))
 			tmplInfo, err := g.parseTemplateInfo(path)
 			if err != nil {
 				return fmt.Errorf("解析模板信息失败 [%s]: %v", path, err)
@@ -177,17 +443,16 @@ func (g *Generator) scanTemplates() ([]TemplateInfo, error) {
 
 // parseTemplateInfo 解析模板信息
 func (g *Generator) parseTemplateInfo(templatePath string) (TemplateInfo, error) {
-	file, err := os.Open(templatePath)
+	content, err := g.readTemplate(templatePath)
 	if err != nil {
 		return TemplateInfo{}, err
 	}
-	defer file.Close()
 
 	var outputPath string
 	var isPerTable bool
 
 	// 读取文件前几行查找元数据
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
 	lineCount := 0
 	for scanner.Scan() && lineCount < 10 { // 只检查前10行
 		line := strings.TrimSpace(scanner.Text())
@@ -206,28 +471,25 @@ func (g *Generator) parseTemplateInfo(templatePath string) (TemplateInfo, error)
 	}
 
 	// 检查是否包含表相关的模板变量，判断是否需要为每个表生成
-	content, err := os.ReadFile(templatePath)
-	if err != nil {
-		return TemplateInfo{}, err
-	}
-
 	contentStr := string(content)
 	// 检查是否包含表相关变量
 	tableVarPattern := regexp.MustCompile(`\{\{\.(?:Table|ClassName)\b`)
 	isPerTable = tableVarPattern.MatchString(contentStr)
+	// 不按表生成时，检查是否包含部署环境变量
+	envVarPattern := regexp.MustCompile(`\.Environment\b`)
 
 	return TemplateInfo{
 		FilePath:   templatePath,
 		OutputPath: outputPath,
 		IsPerTable: isPerTable,
+		IsPerEnv:   !isPerTable && envVarPattern.MatchString(contentStr),
 	}, nil
 }
 
 // generateOutputPathFromTemplate 根据模板文件路径生成输出路径
 func (g *Generator) generateOutputPathFromTemplate(templatePath string) string {
 	// 获取相对于template目录的路径
-	templateDir := filepath.Join(g.TemplatePath, "pkg/gencode/template")
-	relPath, err := filepath.Rel(templateDir, templatePath)
+	relPath, err := filepath.Rel(g.templateDir(), templatePath)
 	if err != nil {
 		return ""
 	}
@@ -235,8 +497,8 @@ func (g *Generator) generateOutputPathFromTemplate(templatePath string) string {
 	// 移除.tpl后缀
 	outputPath := strings.TrimSuffix(relPath, ".tpl")
 
-	// 如果是java目录下的文件，需要添加前缀斜杠
-	if strings.HasPrefix(outputPath, "java/") {
+	// 如果是生成目标目录下的文件，需要添加前缀斜杠
+	if strings.HasPrefix(outputPath, g.target()+"/") {
 		outputPath = "/" + outputPath
 	}
 
@@ -256,29 +518,118 @@ func (g *Generator) generateFromTemplate(tmplInfo TemplateInfo, data TemplateDat
 	if err != nil {
 		return fmt.Errorf("渲染输出路径失败: %v", err)
 	}
+	outputPath = g.modulePath(outputPath)
 
-	// 生成完整的输出路径
-	baseOutputPath := g.Config.GenConfig.OutputPath
-	if baseOutputPath == "" {
-		baseOutputPath = "./output"
+	// 渲染文件内容
+	var content bytes.Buffer
+	err = tmpl.Execute(&content, data)
+	if err != nil {
+		return fmt.Errorf("模板渲染失败: %v", err)
 	}
 
-	fullOutputPath := filepath.Join(baseOutputPath, outputPath)
+	// 渲染结果为空时不生成文件，用于按条件生成的模板
+	if len(bytes.TrimSpace(content.Bytes())) == 0 {
+		output, err := g.outputName(outputPath)
+		if err != nil {
+			output = outputPath
+		}
+		g.emit(Event{Type: EventFileSkipped, Template: g.templateName(tmplInfo.FilePath), Table: data.Table.TableName, Output: output, Reason: SkipEmpty})
+		return nil
+	}
 
-	// 确保输出目录存在
-	outputDir := filepath.Dir(fullOutputPath)
-	err = os.MkdirAll(outputDir, 0755)
+	// Go 文件统一使用 gofmt 格式化
+	if strings.HasSuffix(outputPath, ".go") {
+		formatted, err := format.Source(content.Bytes())
+		if err != nil {
+			return fmt.Errorf("格式化Go代码失败: %v", err)
+		}
+		content.Reset()
+		content.Write(formatted)
+	}
+
+	return g.emitFile(g.templateName(tmplInfo.FilePath), data.Table.TableName, outputPath, content.Bytes())
+}
+
+// emitFile 输出模板或插件生成的文件，template 为清单中记录的来源
+func (g *Generator) emitFile(template, tableName, outputPath string, content []byte) error {
+	output, err := g.outputName(outputPath)
 	if err != nil {
-		return fmt.Errorf("创建输出目录失败: %v", err)
+		return err
+	}
+	g.outputs = append(g.outputs, output)
+
+	// 对比清单，跳过被手动修改且需要保留的文件
+	if !g.recordManifest(template, tableName, output, content) {
+		g.emit(Event{Type: EventFileSkipped, Template: template, Table: tableName, Output: output, Reason: SkipModified})
+		return nil
 	}
 
 	// 生成文件
-	return g.generateFile(fullOutputPath, tmpl, data)
+	err = g.writeOutput(output, content)
+	if err != nil {
+		return err
+	}
+	g.emit(Event{Type: EventFileWritten, Template: template, Table: tableName, Output: output})
+	return nil
+}
+
+// outputName 获取相对输出目录的文件路径，不允许超出输出目录
+func (g *Generator) outputName(outputPath string) (string, error) {
+	fullOutputPath := filepath.Join(g.outputDir(), outputPath)
+	relPath, err := filepath.Rel(g.outputDir(), fullOutputPath)
+	if err != nil || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("输出路径超出输出目录: %s", outputPath)
+	}
+	return filepath.ToSlash(relPath), nil
+}
+
+// templateName 获取相对模板根目录的模板路径
+func (g *Generator) templateName(templatePath string) string {
+	name, err := filepath.Rel(g.templateDir(), templatePath)
+	if err != nil {
+		return filepath.ToSlash(templatePath)
+	}
+	return filepath.ToSlash(name)
+}
+
+// RenderFiles 在内存中渲染所有模板，返回相对输出目录的路径与文件内容，
+// 不读写输出目录，迁移脚本按首次生成处理
+func (g *Generator) RenderFiles() (map[string][]byte, error) {
+	g.files = map[string][]byte{}
+	defer func() {
+		g.files = nil
+	}()
+
+	err := g.GenerateCode()
+	if err != nil {
+		return nil, err
+	}
+	return g.files, nil
+}
+
+// writeOutput 写入相对输出目录的文件，在内存中渲染时只收集内容
+func (g *Generator) writeOutput(output string, content []byte) error {
+	if g.files != nil {
+		g.files[output] = content
+		return nil
+	}
+
+	// 确保输出目录存在
+	fullPath := filepath.Join(g.outputDir(), filepath.FromSlash(output))
+	err := os.MkdirAll(filepath.Dir(fullPath), 0755)
+	if err != nil {
+		return fmt.Errorf("创建输出目录失败: %v", err)
+	}
+	err = g.generateFile(fullPath, content)
+	if err != nil {
+		return err
+	}
+	return g.afterFile(fullPath, content)
 }
 
 // renderOutputPath 渲染输出路径模板
 func (g *Generator) renderOutputPath(pathTemplate string, data TemplateData) (string, error) {
-	tmpl, err := template.New("outputPath").Funcs(g.getTemplateFuncMap()).Parse(pathTemplate)
+	tmpl, err := template.New("outputPath").Funcs(g.getTemplateFuncMap()).Option(g.missingKey()).Parse(pathTemplate)
 	if err != nil {
 		return "", err
 	}
@@ -295,14 +646,25 @@ func (g *Generator) renderOutputPath(pathTemplate string, data TemplateData) (st
 // TemplateData 模板数据
 type TemplateData struct {
 	Config            Config
+	Tables            []Table           // 所有表，供只生成一次的模板使用
+	Profile           JavaProfile       // Java 版本配置
+	Deploy            DeployConfig      // 补全默认值后的部署配置
+	Environment       DeployEnvironment // 当前部署环境，供按环境生成的模板使用
+	Vars              map[string]any    // 自定义模板变量
 	Table             Table
 	ClassName         string
 	EntityPackage     string
 	MapperPackage     string
 	ServicePackage    string
 	ControllerPackage string
+	QueryPackage      string
+	DtoPackage        string
+	VoPackage         string
+	ConverterPackage  string
+	ExcelPackage      string
 	EnableLombok      bool
 	EnableSwagger     bool
+	EnableExcel       bool
 	Author            string
 	Date              string
 }
@@ -318,19 +680,52 @@ func (g *Generator) prepareTemplateData(table *Table) TemplateData {
 
 	return TemplateData{
 		Config:            g.Config,
+		Profile:           g.profile(),
+		Deploy:            g.deploy(),
+		Vars:              g.Config.Vars,
 		Table:             *table,
 		ClassName:         className,
 		EntityPackage:     pkgConfig.EntityPackage,
 		MapperPackage:     pkgConfig.MapperPackage,
 		ServicePackage:    pkgConfig.ServicePackage,
 		ControllerPackage: pkgConfig.ControllerPackage,
+		QueryPackage:      g.queryPackage(),
+		DtoPackage:        g.subPackage(pkgConfig.DtoPackage, "dto"),
+		VoPackage:         g.subPackage(pkgConfig.VoPackage, "vo"),
+		ConverterPackage:  g.subPackage(pkgConfig.ConverterPackage, "converter"),
+		ExcelPackage:      g.subPackage("", "excel"),
 		EnableLombok:      genConfig.EnableLombok,
 		EnableSwagger:     genConfig.EnableSwagger,
+		EnableExcel:       genConfig.EnableExcel,
 		Author:            genConfig.Author,
 		Date:              genConfig.Date,
 	}
 }
 
+// prepareOnceTemplateData 准备只生成一次或按环境生成的模板数据
+func (g *Generator) prepareOnceTemplateData(tables []Table) TemplateData {
+	return TemplateData{
+		Config:  g.Config,
+		Tables:  tables,
+		Profile: g.profile(),
+		Deploy:  g.deploy(),
+		Vars:    g.Config.Vars,
+	}
+}
+
+// queryPackage 获取查询条件包名，未配置时放在基础包下
+func (g *Generator) queryPackage() string {
+	return g.subPackage(g.Config.PackageConfig.QueryPackage, "query")
+}
+
+// subPackage 获取配置的包名，未配置时放在基础包的 name 子包下
+func (g *Generator) subPackage(pkg, name string) string {
+	if pkg != "" {
+		return pkg
+	}
+	return g.Config.PackageConfig.BasePackage + "." + name
+}
+
 // getTemplateFuncMap 获取模板自定义函数映射
 func (g *Generator) getTemplateFuncMap() template.FuncMap {
 	return template.FuncMap{
@@ -343,19 +738,73 @@ func (g *Generator) getTemplateFuncMap() template.FuncMap {
 		"replace": func(old, new, s string) string {
 			return strings.ReplaceAll(s, old, new)
 		},
+		"upperFirst": upperFirst,
 		"title": func(s string) string {
 			if len(s) == 0 {
 				return s
 			}
 			return strings.ToUpper(s[:1]) + strings.ToLower(s[1:])
 		},
+		"lowerFirst": lowerFirst,
+		"upper":      strings.ToUpper,
+		"join":       strings.Join,
+		"pascal":     toPascalCase,
+		"entName":    entName,
+		"entType":    entType,
+		"protoType":  protoType,
+		"filterType": filterType,
+		"fillFields": fillFields,
+		"hasVersion": hasVersion,
+		"javaSample": javaSample,
+		"goSample":   goSample,
+		"h2Type":     h2Type,
+		"isDateTime": isDateTime,
+		"createTable": func(table Table) (string, error) {
+			return CreateTableSQL(g.dialect(), table)
+		},
+		"sqlType": func(field Field) string {
+			return sqlType(g.dialect(), field)
+		},
+		"defaultSQL": func(field Field) string {
+			return defaultSQL(g.dialect(), field)
+		},
+		// 生成的 Java 项目使用 MySQL 驱动，本地环境的初始化脚本固定为 MySQL 方言
+		"mysqlTable": func(table Table) (string, error) {
+			return CreateTableSQL(DialectMySQL, table)
+		},
+		"relations":     tableRelations,
+		"columnKeys":    columnKeys,
+		"erDiagram":     ERDiagram,
+		"markdownCell":  markdownCell,
+		"layout":        g.layout,
+		"artifactId":    g.artifactID,
+		"module":        g.moduleName,
+		"ci":            g.ci,
+		"buildCommand":  g.buildCommand,
+		"testCommand":   g.testCommand,
+		"builderImage":  g.builderImage,
+		"jarPath":       g.jarPath,
+		"imageName":     g.imageName,
+		"deployCommand": g.deployCommand,
+		"auth":          g.auth,
+		"permission":    g.permissionAnnotation,
+		"menuSQL": func(tables []Table) (string, error) {
+			return MenuSQL(g.dialect(), Menus(tables, g.Config.GenConfig.EnableExcel))
+		},
+		"menuJSON": func(tables []Table) (string, error) {
+			return MenuJSON(Menus(tables, g.Config.GenConfig.EnableExcel))
+		},
+		"openapi": func(tables []Table) (string, error) {
+			spec, err := OpenAPISpec(g.Config, tables)
+			return string(spec), err
+		},
 	}
 }
 
 // createTemplateWithFuncs 创建带有自定义函数的模板
 func (g *Generator) createTemplateWithFuncs(templatePath string) (*template.Template, error) {
 	// 读取模板文件内容
-	content, err := os.ReadFile(templatePath)
+	content, err := g.readTemplate(templatePath)
 	if err != nil {
 		return nil, err
 	}
@@ -364,7 +813,7 @@ func (g *Generator) createTemplateWithFuncs(templatePath string) (*template.Temp
 	cleanedContent := g.cleanMetadataFromTemplate(string(content))
 
 	// 创建模板并添加自定义函数
-	tmpl := template.New(filepath.Base(templatePath)).Funcs(g.getTemplateFuncMap())
+	tmpl := template.New(filepath.Base(templatePath)).Funcs(g.getTemplateFuncMap()).Option(g.missingKey())
 	tmpl, err = tmpl.Parse(cleanedContent)
 	if err != nil {
 		return nil, err
@@ -396,17 +845,11 @@ func (g *Generator) cleanMetadataFromTemplate(content string) string {
 }
 
 // generateFile 生成文件
-func (g *Generator) generateFile(filePath string, tmpl *template.Template, data interface{}) error {
-	file, err := os.Create(filePath)
+func (g *Generator) generateFile(filePath string, content []byte) error {
+	err := os.WriteFile(filePath, content, 0644)
 	if err != nil {
 		return fmt.Errorf("创建文件失败: %v", err)
 	}
-	defer file.Close()
-
-	err = tmpl.Execute(file, data)
-	if err != nil {
-		return fmt.Errorf("模板渲染失败: %v", err)
-	}
 
 	return nil
 }
@@ -418,6 +861,16 @@ func (g *Generator) Close() error {
 
 // toCamelCase 下划线转驼峰命名
 func (g *Generator) toCamelCase(str string) string {
+	return toCamelCase(str)
+}
+
+// toPascalCase 下划线转大驼峰命名
+func (g *Generator) toPascalCase(str string) string {
+	return toPascalCase(str)
+}
+
+// toCamelCase 下划线转驼峰命名
+func toCamelCase(str string) string {
 	parts := strings.Split(str, "_")
 	if len(parts) == 0 {
 		return str
@@ -434,7 +887,7 @@ func (g *Generator) toCamelCase(str string) string {
 }
 
 // toPascalCase 下划线转大驼峰命名
-func (g *Generator) toPascalCase(str string) string {
+func toPascalCase(str string) string {
 	parts := strings.Split(str, "_")
 	var result strings.Builder
 	for i := 0; i < len(parts); i++ {
@@ -143,11 +143,12 @@ func TestGenerateCode(t *testing.T) {
 	// 准备表列表
 	tables := []Table{userTable, productTable}
 
-	// 创建配置
+	// 创建配置，输出到测试结束后自动清理的临时目录
+	outputPath := t.TempDir()
 	config := Config{
 		ProjectName: "gentest",
 		GenConfig: GenConfig{
-			OutputPath:    "tmp/maven_project",
+			OutputPath:    outputPath,
 			EnableLombok:  true,
 			EnableSwagger: true,
 			Author:        "CodeGenerator",
@@ -183,7 +184,6 @@ func TestGenerateCode(t *testing.T) {
 	t.Logf("代码生成成功！")
 
 	// 验证生成的文件是否存在
-	outputPath := "tmp/maven_project"
 
 	// 检查目录结构
 	expectedDirs := []string{
@@ -288,14 +288,3 @@ func TestToPascalCase(t *testing.T) {
 		}
 	}
 }
-
-// 清理测试生成的文件
-func TestCleanup(t *testing.T) {
-	outputPath := "tmp/maven_project"
-	err := os.RemoveAll(outputPath)
-	if err != nil {
-		t.Logf("清理测试文件失败: %v", err)
-	} else {
-		t.Logf("测试文件清理完成: %s", outputPath)
-	}
-}
@@ -0,0 +1,120 @@
+// Package gencodetest 模板的 golden 文件测试工具
+//
+// 模板作者用 Generator.RenderFiles 在内存中渲染模板集，再用 Golden 与提交到仓库的
+// golden 文件比较。是否刷新 golden 文件由调用方决定，通常在测试文件中注册 -update 参数传入，
+// 修改模板后执行 go test -update 刷新 golden 文件，在代码评审中查看差异。
+package gencodetest
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// Suffix golden 文件的后缀，避免其中的 go.mod、.go 等文件被 Go 工具链识别
+const Suffix = ".golden"
+
+// Golden 将渲染的文件与 dir 下同路径加 .golden 后缀的文件逐一比较，缺少或多出的文件同样报错。
+// update 为 true 时先清空 dir 再写入渲染结果
+func Golden(t testing.TB, dir string, files map[string][]byte, update bool) {
+	t.Helper()
+
+	if update {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatalf("清空 golden 目录失败: %v", err)
+		}
+		for name, content := range files {
+			path := filepath.Join(dir, filepath.FromSlash(name)+Suffix)
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatalf("创建 golden 目录失败: %v", err)
+			}
+			if err := os.WriteFile(path, content, 0644); err != nil {
+				t.Fatalf("写入 golden 文件失败: %v", err)
+			}
+		}
+		return
+	}
+
+	golden, err := Load(dir)
+	if err != nil {
+		t.Fatalf("读取 golden 文件失败: %v", err)
+	}
+	for _, name := range sortedKeys(files) {
+		expected, ok := golden[name]
+		if !ok {
+			t.Errorf("%s 缺少 golden 文件，使用 -update 更新", name)
+			continue
+		}
+		if diff := Diff(string(expected), string(files[name])); diff != "" {
+			t.Errorf("%s 与 golden 文件不一致，使用 -update 更新\n%s", name, diff)
+		}
+	}
+	for _, name := range sortedKeys(golden) {
+		if _, ok := files[name]; !ok {
+			t.Errorf("%s 不再生成，使用 -update 删除 golden 文件", name)
+		}
+	}
+}
+
+// Load 读取 dir 下的所有 golden 文件，键为去掉后缀的相对路径，dir 不存在时返回空
+func Load(dir string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if os.IsNotExist(err) && path == dir {
+			return filepath.SkipDir
+		}
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, Suffix) {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files[strings.TrimSuffix(filepath.ToSlash(rel), Suffix)] = content
+		return nil
+	})
+	return files, err
+}
+
+// Diff 逐行比较期望与实际内容，返回首个不一致处前后的若干行，内容相同时返回空
+func Diff(expected, actual string) string {
+	if expected == actual {
+		return ""
+	}
+	want, got := strings.Split(expected, "\n"), strings.Split(actual, "\n")
+	line := 0
+	for line < len(want) && line < len(got) && want[line] == got[line] {
+		line++
+	}
+
+	const context = 3
+	var b strings.Builder
+	for i := max(line-context, 0); i < line; i++ {
+		b.WriteString("  " + want[i] + "\n")
+	}
+	for i := line; i < min(line+context, len(want)); i++ {
+		b.WriteString("- " + want[i] + "\n")
+	}
+	for i := line; i < min(line+context, len(got)); i++ {
+		b.WriteString("+ " + got[i] + "\n")
+	}
+	return fmt.Sprintf("第 %d 行起:\n%s", line+1, b.String())
+}
+
+// sortedKeys 按路径排序的文件名，保证报错顺序稳定
+func sortedKeys(files map[string][]byte) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
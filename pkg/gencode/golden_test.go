@@ -0,0 +1,56 @@
+package gencode
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gen_code/pkg/gencode/gencodetest"
+)
+
+// update 是否用渲染结果更新 golden 文件，通过 go test -update 开启
+var update = flag.Bool("update", false, "用渲染结果更新 golden 文件")
+
+// TestGolden 用 testdata/golden/fixture.sql 中的表渲染各模板集，与 testdata/golden 下的 golden 文件比较，
+// 修改模板后执行 go test ./pkg/gencode -update 刷新
+func TestGolden(t *testing.T) {
+	ddl, err := os.ReadFile(filepath.Join("testdata", "golden", "fixture.sql"))
+	if err != nil {
+		t.Fatalf("读取表结构失败: %v", err)
+	}
+	tables, err := ParseDDL(string(ddl))
+	if err != nil {
+		t.Fatalf("解析表结构失败: %v", err)
+	}
+
+	testCases := []struct {
+		name   string
+		target string
+	}{
+		{"java", TargetJava},
+		{"kratos", TargetKratos},
+		{"doc", TargetDoc},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			outputPath := t.TempDir()
+			config := testConfig(outputPath)
+			config.GenConfig.Target = tc.target
+			config.GenConfig.EnableSwagger = true
+			config.GenConfig.Date = "2024-01-01"
+			config.GenConfig.Migration = []string{DialectMySQL}
+			if tc.target == TargetKratos {
+				config.ProjectName = "example.com/gentest"
+			}
+			files, err := NewGenerator(config, tables).RenderFiles()
+			if err != nil {
+				t.Fatalf("生成代码失败: %v", err)
+			}
+			if entries, _ := os.ReadDir(outputPath); len(entries) > 0 {
+				t.Errorf("在内存中渲染不应写入输出目录: %v", entries)
+			}
+			gencodetest.Golden(t, filepath.Join("testdata", "golden", tc.name), files, *update)
+		})
+	}
+}
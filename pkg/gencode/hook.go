@@ -0,0 +1,85 @@
+package gencode
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Hook 进程内钩子，在生成过程中执行项目相关的逻辑，如在集中配置中注册新的 Mapper。
+// 钩子返回错误时中止生成，只关心部分时机的钩子可嵌入 NopHook
+type Hook interface {
+	// BeforeTable 生成前为每个表调用，可修改表结构
+	BeforeTable(table *Table) error
+	// AfterFile 文件写入磁盘后调用，path 为完整路径；在内存中渲染时不调用
+	AfterFile(path string, content []byte) error
+	// AfterRun 全部文件生成后调用，files 为相对输出目录的路径；在内存中渲染时不调用
+	AfterRun(outputDir string, files []string) error
+}
+
+// NopHook 不执行任何操作的钩子
+type NopHook struct{}
+
+// BeforeTable 不修改表结构
+func (NopHook) BeforeTable(table *Table) error { return nil }
+
+// AfterFile 不处理文件
+func (NopHook) AfterFile(path string, content []byte) error { return nil }
+
+// AfterRun 不执行任何操作
+func (NopHook) AfterRun(outputDir string, files []string) error { return nil }
+
+// CommandHook 生成后在输出目录执行命令的钩子，如 mvn spotless:apply
+type CommandHook struct {
+	NopHook
+	Name string
+	Args []string
+}
+
+// AfterRun 在输出目录执行命令，失败时返回命令的输出
+func (h CommandHook) AfterRun(outputDir string, files []string) error {
+	var output bytes.Buffer
+	cmd := exec.Command(h.Name, h.Args...)
+	cmd.Dir = outputDir
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	err := cmd.Run()
+	if err != nil {
+		return fmt.Errorf("执行命令失败 [%s]: %v\n%s", strings.Join(append([]string{h.Name}, h.Args...), " "), err, output.String())
+	}
+	return nil
+}
+
+// beforeTable 依次调用钩子的 BeforeTable
+func (g *Generator) beforeTable(table *Table) error {
+	for _, hook := range g.Hooks {
+		err := hook.BeforeTable(table)
+		if err != nil {
+			return fmt.Errorf("钩子处理表失败 [%s]: %v", table.TableName, err)
+		}
+	}
+	return nil
+}
+
+// afterFile 依次调用钩子的 AfterFile
+func (g *Generator) afterFile(path string, content []byte) error {
+	for _, hook := range g.Hooks {
+		err := hook.AfterFile(path, content)
+		if err != nil {
+			return fmt.Errorf("钩子处理文件失败 [%s]: %v", path, err)
+		}
+	}
+	return nil
+}
+
+// afterRun 依次调用钩子的 AfterRun
+func (g *Generator) afterRun(files []string) error {
+	for _, hook := range g.Hooks {
+		err := hook.AfterRun(g.outputDir(), files)
+		if err != nil {
+			return fmt.Errorf("钩子执行失败: %v", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package gencode
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// recordHook 记录调用并将表注释加上前缀的钩子
+type recordHook struct {
+	files []string
+	run   []string
+}
+
+func (h *recordHook) BeforeTable(table *Table) error {
+	table.TableComment = "[hook] " + table.TableComment
+	return nil
+}
+
+func (h *recordHook) AfterFile(path string, content []byte) error {
+	h.files = append(h.files, path)
+	return nil
+}
+
+func (h *recordHook) AfterRun(outputDir string, files []string) error {
+	h.run = files
+	return nil
+}
+
+func TestHooks(t *testing.T) {
+	outputPath := t.TempDir()
+	hook := &recordHook{}
+	g := NewGenerator(testConfig(outputPath), testTables())
+	g.Hooks = []Hook{hook, CommandHook{Name: "touch", Args: []string{"formatted"}}}
+	if err := g.GenerateCode(); err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(outputPath, "src/main/resources/db/schema.sql"))
+	if err != nil {
+		t.Fatalf("读取文件失败: %v", err)
+	}
+	if !strings.Contains(string(content), "[hook] 用户表") {
+		t.Errorf("BeforeTable 修改的表结构未生效\n%s", content)
+	}
+	if !slices.Contains(hook.files, filepath.Join(outputPath, "pom.xml")) {
+		t.Errorf("AfterFile 未收到 pom.xml: %v", hook.files)
+	}
+	if len(hook.run) != len(hook.files) || !slices.Contains(hook.run, "pom.xml") {
+		t.Errorf("AfterRun 文件 = %v", hook.run)
+	}
+	if _, err := os.Stat(filepath.Join(outputPath, "formatted")); err != nil {
+		t.Errorf("CommandHook 未在输出目录执行: %v", err)
+	}
+
+	// 在内存中渲染时只调用 BeforeTable
+	hook = &recordHook{}
+	g = NewGenerator(testConfig(t.TempDir()), testTables())
+	g.Hooks = []Hook{hook}
+	if _, err := g.RenderFiles(); err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+	if hook.files != nil || hook.run != nil {
+		t.Errorf("在内存中渲染不应调用 AfterFile/AfterRun")
+	}
+
+	g = NewGenerator(testConfig(t.TempDir()), testTables())
+	g.Hooks = []Hook{CommandHook{Name: "false"}}
+	if err := g.GenerateCode(); err == nil || !strings.Contains(err.Error(), "执行命令失败") {
+		t.Errorf("命令失败时应返回错误: %v", err)
+	}
+}
+
+// TestPluginProcess 作为插件子进程运行，为每个表生成一个文件
+func TestPluginProcess(t *testing.T) {
+	if os.Getenv("GENCODE_TEST_PLUGIN") != "1" {
+		return
+	}
+	var request PluginRequest
+	if err := json.NewDecoder(os.Stdin).Decode(&request); err != nil {
+		t.Fatal(err)
+	}
+	response := PluginResponse{}
+	if request.Parameter == "fail" {
+		response.Error = "参数错误"
+	}
+	for _, table := range request.Tables {
+		response.Files = append(response.Files, PluginFile{
+			Path:    "plugin/" + table.TableName + ".txt",
+			Content: request.Config.ProjectName + " " + table.TableName + " " + request.Parameter,
+		})
+	}
+	if slices.Contains(request.Files, "pom.xml") {
+		response.Files = append(response.Files, PluginFile{Path: "plugin/files.txt", Content: "pom.xml"})
+	}
+	if err := json.NewEncoder(os.Stdout).Encode(response); err != nil {
+		t.Fatal(err)
+	}
+	os.Exit(0)
+}
+
+func TestPlugin(t *testing.T) {
+	t.Setenv("GENCODE_TEST_PLUGIN", "1")
+	plugin := Plugin{Name: "test", Command: os.Args[0], Args: []string{"-test.run=^TestPluginProcess$"}, Parameter: "v1"}
+
+	outputPath := t.TempDir()
+	g := NewGenerator(testConfig(outputPath), testTables())
+	g.Plugins = []Plugin{plugin}
+	if err := g.GenerateCode(); err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+	for file, expected := range map[string]string{
+		"plugin/user.txt":    "gentest user v1",
+		"plugin/product.txt": "gentest product v1",
+		"plugin/files.txt":   "pom.xml",
+	} {
+		content, err := os.ReadFile(filepath.Join(outputPath, file))
+		if err != nil || string(content) != expected {
+			t.Errorf("%s = %q, %v", file, content, err)
+		}
+	}
+	manifest, err := LoadManifest(outputPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry, _ := manifest.Lookup("plugin/user.txt")
+	if expected := (ManifestEntry{Template: "plugin:test", Output: "plugin/user.txt", Hash: entry.Hash, GeneratorVersion: GeneratorVersion}); !reflect.DeepEqual(entry, expected) {
+		t.Errorf("清单条目 = %+v", entry)
+	}
+
+	// 插件返回错误时中止生成
+	plugin.Parameter = "fail"
+	g = NewGenerator(testConfig(t.TempDir()), testTables())
+	g.Plugins = []Plugin{plugin}
+	if err := g.GenerateCode(); err == nil || !strings.Contains(err.Error(), "参数错误") {
+		t.Errorf("插件错误应中止生成: %v", err)
+	}
+}
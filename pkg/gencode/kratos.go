@@ -0,0 +1,94 @@
+package gencode
+
+import "strings"
+
+// entAcronyms ent 生成字段名时保留大写的缩写词
+var entAcronyms = map[string]bool{
+	"ACL": true, "API": true, "ASCII": true, "AWS": true, "CPU": true, "CSS": true, "DNS": true,
+	"EOF": true, "GB": true, "GUID": true, "HCL": true, "HTML": true, "HTTP": true, "HTTPS": true,
+	"ID": true, "IP": true, "JSON": true, "KB": true, "LHS": true, "MAC": true, "MB": true,
+	"QPS": true, "RAM": true, "RHS": true, "RPC": true, "SLA": true, "SMTP": true, "SQL": true,
+	"SSH": true, "SSO": true, "TCP": true, "TLS": true, "TTL": true, "UDP": true, "UI": true,
+	"UID": true, "URI": true, "URL": true, "UTF8": true, "UUID": true, "VM": true, "XML": true,
+	"XMPP": true, "XSRF": true, "XSS": true,
+}
+
+// entName 字段名转 ent 生成的 Go 字段名，如 user_id 转为 UserID
+func entName(columnName string) string {
+	var result strings.Builder
+	for _, part := range strings.Split(strings.ToLower(columnName), "_") {
+		if part == "" {
+			continue
+		}
+		if upper := strings.ToUpper(part); entAcronyms[upper] {
+			result.WriteString(upper)
+			continue
+		}
+		result.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return result.String()
+}
+
+// entType Go类型转 ent 字段构造函数名
+func entType(goType string) string {
+	switch goType {
+	case "int64":
+		return "Int64"
+	case "int32":
+		return "Int32"
+	case "int16":
+		return "Int16"
+	case "int8":
+		return "Int8"
+	case "bool":
+		return "Bool"
+	case "float64":
+		return "Float"
+	case "float32":
+		return "Float32"
+	case "time.Time":
+		return "Time"
+	case "[]byte":
+		return "Bytes"
+	default:
+		return "String"
+	}
+}
+
+// protoType Go类型转 protobuf 类型
+func protoType(goType string) string {
+	switch goType {
+	case "int64":
+		return "int64"
+	case "int32", "int16", "int8":
+		return "int32"
+	case "bool":
+		return "bool"
+	case "float64":
+		return "double"
+	case "float32":
+		return "float"
+	case "time.Time":
+		return "google.protobuf.Timestamp"
+	case "[]byte":
+		return "bytes"
+	default:
+		return "string"
+	}
+}
+
+// filterType Go类型转 AIP 过滤条件的类型声明
+func filterType(goType string) string {
+	switch goType {
+	case "int64", "int32", "int16", "int8":
+		return "filtering.TypeInt"
+	case "bool":
+		return "filtering.TypeBool"
+	case "float64", "float32":
+		return "filtering.TypeFloat"
+	case "time.Time":
+		return "filtering.TypeTimestamp"
+	default:
+		return "filtering.TypeString"
+	}
+}
@@ -0,0 +1,116 @@
+package gencode
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+// 项目结构，仅对 java 目标生效
+const (
+	LayoutMaven      = "maven"       // 单模块 Maven 项目
+	LayoutMavenMulti = "maven-multi" // 多模块 Maven 项目，拆分为 -api、-domain、-web 子模块
+	LayoutGradle     = "gradle"      // 单模块 Gradle 项目，使用 Kotlin DSL
+)
+
+// layouts 支持的项目结构
+var layouts = []string{LayoutMaven, LayoutMavenMulti, LayoutGradle}
+
+// 多模块项目的子模块，模块目录为 artifactId-模块名
+const (
+	ModuleAPI    = "api"    // 请求参数、响应视图和查询条件
+	ModuleDomain = "domain" // 实体、Mapper、服务、对象转换及数据库脚本
+	ModuleWeb    = "web"    // 控制器、启动类、配置文件和测试
+)
+
+// layout 获取项目结构，默认单模块 Maven
+func (g *Generator) layout() string {
+	if g.Config.GenConfig.Layout == "" {
+		return LayoutMaven
+	}
+	return g.Config.GenConfig.Layout
+}
+
+// checkLayout 校验项目结构，不支持的项目结构不会生成任何构建文件
+func checkLayout(layout string) error {
+	if slices.Contains(layouts, layout) {
+		return nil
+	}
+	return fmt.Errorf("不支持的项目结构: %s，可选值: %s", layout, strings.Join(layouts, "/"))
+}
+
+// artifactID 根据项目名生成 artifactId，如 Demo Admin 生成 demo-admin，项目名为空时为 generated-project
+func (g *Generator) artifactID() string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(g.Config.ProjectName) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else if b.Len() > 0 && !strings.HasSuffix(b.String(), "-") {
+			b.WriteByte('-')
+		}
+	}
+	if id := strings.TrimSuffix(b.String(), "-"); id != "" {
+		return id
+	}
+	return "generated-project"
+}
+
+// moduleName 获取子模块的目录名，同时作为子模块的 artifactId
+func (g *Generator) moduleName(module string) string {
+	return g.artifactID() + "-" + module
+}
+
+// modulePath 将输出路径路由到所属子模块目录下，非多模块项目原样返回
+// src/main/java 下按包名归属模块，数据库脚本归属 domain 模块，其余源码、资源和测试归属 web 模块
+func (g *Generator) modulePath(output string) string {
+	if g.target() != TargetJava || g.layout() != LayoutMavenMulti {
+		return output
+	}
+	rel := strings.TrimPrefix(output, "/")
+	if !strings.HasPrefix(rel, "src/") {
+		return output
+	}
+
+	module := ModuleWeb
+	if source, ok := strings.CutPrefix(rel, "src/main/java/"); ok {
+		module = g.packageModule(source)
+	} else if strings.HasPrefix(rel, "src/main/resources/db/") {
+		module = ModuleDomain
+	}
+
+	result := path.Join(g.moduleName(module), rel)
+	if strings.HasPrefix(output, "/") {
+		return "/" + result
+	}
+	return result
+}
+
+// packageModule 按最长匹配的包名获取源码文件所属的子模块
+func (g *Generator) packageModule(source string) string {
+	pkgConfig := g.Config.PackageConfig
+	packages := []struct {
+		pkg    string
+		module string
+	}{
+		{g.subPackage(pkgConfig.DtoPackage, "dto"), ModuleAPI},
+		{g.subPackage(pkgConfig.VoPackage, "vo"), ModuleAPI},
+		{g.queryPackage(), ModuleAPI},
+		{pkgConfig.EntityPackage, ModuleDomain},
+		{pkgConfig.MapperPackage, ModuleDomain},
+		{pkgConfig.ServicePackage, ModuleDomain},
+		{g.subPackage(pkgConfig.ConverterPackage, "converter"), ModuleDomain},
+		{g.subPackage("", "config"), ModuleDomain},
+		{g.subPackage("", "excel"), ModuleDomain},
+		{pkgConfig.ControllerPackage, ModuleWeb},
+	}
+
+	module, matched := ModuleWeb, 0
+	for _, p := range packages {
+		dir := strings.ReplaceAll(p.pkg, ".", "/") + "/"
+		if p.pkg != "" && strings.HasPrefix(source, dir) && len(dir) > matched {
+			module, matched = p.module, len(dir)
+		}
+	}
+	return module
+}
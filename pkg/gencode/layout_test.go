@@ -0,0 +1,133 @@
+package gencode
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestArtifactID(t *testing.T) {
+	testCases := []struct {
+		projectName string
+		expected    string
+	}{
+		{"gentest", "gentest"},
+		{"Demo Admin", "demo-admin"},
+		{"shop_order.service", "shop-order-service"},
+		{"  --", "generated-project"},
+		{"", "generated-project"},
+	}
+	for _, tc := range testCases {
+		g := NewGenerator(Config{ProjectName: tc.projectName}, nil)
+		if got := g.artifactID(); got != tc.expected {
+			t.Errorf("artifactID(%q) = %q, 期望 %q", tc.projectName, got, tc.expected)
+		}
+	}
+}
+
+func TestGenerateLayout(t *testing.T) {
+	testCases := []struct {
+		layout   string
+		exists   []string
+		missing  []string
+		contains map[string][]string
+	}{
+		{
+			layout: LayoutMaven,
+			exists: []string{
+				"pom.xml",
+				"src/main/java/com/example/controller/UserController.java",
+				"src/main/resources/db/migration/mysql/V1__init.sql",
+			},
+			missing: []string{"build.gradle.kts", "gentest-web"},
+			contains: map[string][]string{
+				"pom.xml":    {"<artifactId>gentest</artifactId>", "<artifactId>mybatis-plus-boot-starter</artifactId>"},
+				"Dockerfile": {"COPY target/*.jar /home"},
+			},
+		},
+		{
+			layout: LayoutMavenMulti,
+			exists: []string{
+				"gentest-api/src/main/java/com/example/dto/UserCreateDTO.java",
+				"gentest-api/src/main/java/com/example/vo/UserVO.java",
+				"gentest-api/src/main/java/com/example/query/UserQuery.java",
+				"gentest-domain/src/main/java/com/example/entity/User.java",
+				"gentest-domain/src/main/java/com/example/mapper/UserMapper.xml",
+				"gentest-domain/src/main/java/com/example/service/impl/UserServiceImpl.java",
+				"gentest-domain/src/main/java/com/example/converter/UserConverter.java",
+				"gentest-domain/src/main/java/com/example/config/MybatisPlusConfig.java",
+				"gentest-domain/src/main/resources/db/schema.sql",
+				"gentest-domain/src/main/resources/db/migration/mysql/V1__init.sql",
+				"gentest-web/src/main/java/com/example/Application.java",
+				"gentest-web/src/main/java/com/example/controller/UserController.java",
+				"gentest-web/src/main/resources/application.yml",
+				"gentest-web/src/test/java/com/example/service/UserServiceTest.java",
+			},
+			missing: []string{"src", "build.gradle.kts"},
+			contains: map[string][]string{
+				"pom.xml": {
+					"<packaging>pom</packaging>",
+					"<module>gentest-api</module>\n        <module>gentest-domain</module>\n        <module>gentest-web</module>",
+				},
+				"gentest-domain/pom.xml": {"<artifactId>gentest-domain</artifactId>", "<artifactId>gentest-api</artifactId>"},
+				"gentest-web/pom.xml":    {"<artifactId>gentest-domain</artifactId>", "spring-boot-maven-plugin"},
+				"Dockerfile":             {"COPY gentest-web/target/*.jar /home"},
+			},
+		},
+		{
+			layout: LayoutGradle,
+			exists: []string{
+				"src/main/java/com/example/controller/UserController.java",
+				"settings.gradle.kts",
+			},
+			missing: []string{"pom.xml", "gentest-web"},
+			contains: map[string][]string{
+				"settings.gradle.kts": {`rootProject.name = "gentest"`},
+				"build.gradle.kts":    {`id("org.springframework.boot")`, `implementation("com.baomidou:mybatis-plus-boot-starter:$mybatisPlusVersion")`},
+				"Dockerfile":          {"COPY build/libs/*.jar /home"},
+				"Jenkinsfile":         {"label 'gradle'", "sh 'gradle clean bootJar'"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.layout, func(t *testing.T) {
+			outputPath := t.TempDir()
+			config := testConfig(outputPath)
+			config.GenConfig.Layout = tc.layout
+			config.GenConfig.Migration = []string{DialectMySQL}
+			if err := NewGenerator(config, testTables()).GenerateCode(); err != nil {
+				t.Fatalf("生成代码失败: %v", err)
+			}
+
+			for _, file := range tc.exists {
+				if _, err := os.Stat(filepath.Join(outputPath, file)); err != nil {
+					t.Errorf("缺少文件: %s", file)
+				}
+			}
+			for _, file := range tc.missing {
+				if _, err := os.Stat(filepath.Join(outputPath, file)); !os.IsNotExist(err) {
+					t.Errorf("不应生成: %s", file)
+				}
+			}
+			for file, contains := range tc.contains {
+				content, err := os.ReadFile(filepath.Join(outputPath, file))
+				if err != nil {
+					t.Fatalf("读取文件失败: %v", err)
+				}
+				for _, e := range contains {
+					if !strings.Contains(string(content), e) {
+						t.Errorf("%s 缺少: %s\n%s", file, e, content)
+					}
+				}
+			}
+		})
+	}
+	// 不支持的项目结构返回错误，而不是生成没有构建文件的项目
+	config := testConfig(t.TempDir())
+	config.GenConfig.Layout = "mvn"
+	if err := NewGenerator(config, testTables()).GenerateCode(); err == nil || !strings.Contains(err.Error(), "maven/maven-multi/gradle") {
+		t.Errorf("不支持的项目结构应返回错误: %v", err)
+	}
+}
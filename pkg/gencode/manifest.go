@@ -0,0 +1,202 @@
+package gencode
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// GeneratorVersion 代码生成器版本，写入生成清单
+const GeneratorVersion = "1.0.0"
+
+// ManifestFile 生成清单文件路径（相对于输出目录）
+const ManifestFile = ".gencode/manifest.json"
+
+// Manifest 生成清单，记录每次生成产出的文件
+type Manifest struct {
+	GeneratorVersion string          `json:"generator_version"`
+	GeneratedAt      string          `json:"generated_at"`
+	Files            []ManifestEntry `json:"files"`
+
+	Schemas    map[string][]Table `json:"schemas,omitempty"`    // 各方言最近一次输出迁移脚本时的表结构快照，用于对比生成迁移脚本
+	Migrations []MigrationEntry   `json:"migrations,omitempty"` // 已生成的迁移脚本，不参与过期文件清理
+}
+
+// ManifestEntry 清单条目
+type ManifestEntry struct {
+	Template         string `json:"template"`        // 模板路径（相对于模板目录）
+	Table            string `json:"table,omitempty"` // 表名，非表级模板为空
+	Output           string `json:"output"`          // 输出路径（相对于输出目录）
+	Hash             string `json:"hash"`            // 文件内容的sha256
+	GeneratorVersion string `json:"generator_version"`
+}
+
+// ManifestReport 清单比对结果
+type ManifestReport struct {
+	Stale    []ManifestEntry // 上次生成、本次不再生成的文件
+	Removed  []string        // 已删除的过期文件
+	Modified []ManifestEntry // 上次生成后被手动修改过的文件
+	Kept     []string        // 因手动修改而保留未覆盖的文件
+}
+
+// LoadManifest 读取输出目录下的生成清单，清单不存在时返回空清单
+func LoadManifest(outputDir string) (*Manifest, error) {
+	content, err := os.ReadFile(filepath.Join(outputDir, ManifestFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return &Manifest{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest Manifest
+	err = json.Unmarshal(content, &manifest)
+	if err != nil {
+		return nil, fmt.Errorf("解析清单失败: %v", err)
+	}
+	return &manifest, nil
+}
+
+// Save 将清单写入输出目录
+func (m *Manifest) Save(outputDir string) error {
+	sort.Slice(m.Files, func(i, j int) bool {
+		return m.Files[i].Output < m.Files[j].Output
+	})
+	content, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	manifestPath := filepath.Join(outputDir, ManifestFile)
+	err = os.MkdirAll(filepath.Dir(manifestPath), 0755)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(manifestPath, content, 0644)
+}
+
+// Lookup 按输出路径查找清单条目
+func (m *Manifest) Lookup(output string) (ManifestEntry, bool) {
+	for _, entry := range m.Files {
+		if entry.Output == output {
+			return entry, true
+		}
+	}
+	return ManifestEntry{}, false
+}
+
+// beginManifest 加载上次生成的清单并初始化本次清单
+func (g *Generator) beginManifest() error {
+	// 在内存中渲染时视为首次生成
+	prev := &Manifest{}
+	if g.files == nil {
+		var err error
+		prev, err = LoadManifest(g.outputDir())
+		if err != nil {
+			return err
+		}
+	}
+	g.prevManifest = prev
+	g.manifest = &Manifest{
+		GeneratorVersion: GeneratorVersion,
+		GeneratedAt:      time.Now().Format(time.RFC3339),
+	}
+	g.Report = ManifestReport{}
+	return nil
+}
+
+// recordManifest 记录生成的文件，返回是否需要写入磁盘
+func (g *Generator) recordManifest(template, tableName, output string, content []byte) bool {
+	if g.manifest == nil {
+		return true
+	}
+
+	entry := ManifestEntry{
+		Template:         template,
+		Table:            tableName,
+		Output:           output,
+		Hash:             hashContent(content),
+		GeneratorVersion: GeneratorVersion,
+	}
+
+	// 检查上次生成后是否被手动修改
+	if prev, ok := g.prevManifest.Lookup(output); ok && g.isModified(prev) {
+		g.Report.Modified = append(g.Report.Modified, prev)
+		if g.Config.GenConfig.KeepModified {
+			// 保留原有条目，下次仍能识别为手动修改
+			g.Report.Kept = append(g.Report.Kept, output)
+			g.manifest.Files = append(g.manifest.Files, prev)
+			return false
+		}
+	}
+
+	g.manifest.Files = append(g.manifest.Files, entry)
+	return true
+}
+
+// finishManifest 处理过期文件并写入本次清单
+func (g *Generator) finishManifest() error {
+	if g.manifest == nil || g.files != nil {
+		return nil
+	}
+
+	for _, prev := range g.prevManifest.Files {
+		if _, ok := g.manifest.Lookup(prev.Output); ok {
+			continue
+		}
+		fullPath := filepath.Join(g.outputDir(), filepath.FromSlash(prev.Output))
+		if _, err := os.Stat(fullPath); errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		g.Report.Stale = append(g.Report.Stale, prev)
+
+		// 被手动修改过的过期文件只报告，不删除；未删除的文件继续保留在清单中
+		if !g.Config.GenConfig.CleanStale || g.isModified(prev) {
+			g.manifest.Files = append(g.manifest.Files, prev)
+			continue
+		}
+		err := os.Remove(fullPath)
+		if err != nil {
+			return fmt.Errorf("删除过期文件失败 [%s]: %v", prev.Output, err)
+		}
+		g.removeEmptyDirs(filepath.Dir(fullPath))
+		g.Report.Removed = append(g.Report.Removed, prev.Output)
+	}
+
+	return g.manifest.Save(g.outputDir())
+}
+
+// isModified 判断清单中的文件在磁盘上是否已被修改
+func (g *Generator) isModified(entry ManifestEntry) bool {
+	content, err := os.ReadFile(filepath.Join(g.outputDir(), filepath.FromSlash(entry.Output)))
+	if err != nil {
+		return false
+	}
+	return hashContent(content) != entry.Hash
+}
+
+// removeEmptyDirs 自底向上删除输出目录内的空目录
+func (g *Generator) removeEmptyDirs(dir string) {
+	root := filepath.Clean(g.outputDir())
+	for dir = filepath.Clean(dir); dir != root && len(dir) > len(root); dir = filepath.Dir(dir) {
+		entries, err := os.ReadDir(dir)
+		if err != nil || len(entries) > 0 {
+			return
+		}
+		if os.Remove(dir) != nil {
+			return
+		}
+	}
+}
+
+// hashContent 计算内容的sha256
+func hashContent(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,123 @@
+package gencode
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// testTables 测试用表结构
+func testTables() []Table {
+	id := Field{ColumnName: "id", ColumnType: "bigint", ColumnComment: "主键ID", IsPrimaryKey: true, JavaType: "Long", FieldName: "id"}
+	return []Table{
+		{
+			TableName:    "user",
+			TableComment: "用户表",
+			Fields: []Field{
+				id,
+				{ColumnName: "username", ColumnType: "varchar", ColumnComment: "用户名", JavaType: "String", FieldName: "username"},
+			},
+			PrimaryKey: id,
+		},
+		{
+			TableName:    "product",
+			TableComment: "产品表",
+			Fields: []Field{
+				id,
+				{ColumnName: "product_name", ColumnType: "varchar", ColumnComment: "产品名称", JavaType: "String", FieldName: "productName"},
+			},
+			PrimaryKey: id,
+		},
+	}
+}
+
+// testConfig 测试用生成配置
+func testConfig(outputPath string) Config {
+	return Config{
+		ProjectName: "gentest",
+		GenConfig: GenConfig{
+			OutputPath:   outputPath,
+			EnableLombok: true,
+			Author:       "CodeGenerator",
+		},
+		PackageConfig: PackageConfig{
+			BasePackage:       "com.example",
+			EntityPackage:     "com.example.entity",
+			MapperPackage:     "com.example.mapper",
+			ServicePackage:    "com.example.service",
+			ControllerPackage: "com.example.controller",
+		},
+	}
+}
+
+func TestManifest(t *testing.T) {
+	outputPath := t.TempDir()
+	config := testConfig(outputPath)
+
+	generator := NewGenerator(config, testTables())
+	if err := generator.GenerateCode(); err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+
+	manifest, err := LoadManifest(outputPath)
+	if err != nil {
+		t.Fatalf("读取清单失败: %v", err)
+	}
+	entry, ok := manifest.Lookup("src/main/java/com/example/controller/ProductController.java")
+	if !ok {
+		t.Fatalf("清单缺少 ProductController.java")
+	}
+	if entry.Table != "product" || entry.Template != "java/src/main/java/controller/controller.java.tpl" {
+		t.Errorf("清单条目不正确: %+v", entry)
+	}
+	if entry.GeneratorVersion != GeneratorVersion {
+		t.Errorf("生成器版本 = %s, expected %s", entry.GeneratorVersion, GeneratorVersion)
+	}
+	productFiles := 0
+	for _, e := range manifest.Files {
+		if e.Table == "product" {
+			productFiles++
+		}
+	}
+
+	// 手动修改 User 实体，删除 product 表后重新生成
+	userEntity := filepath.Join(outputPath, "src/main/java/com/example/entity/User.java")
+	if err := os.WriteFile(userEntity, []byte("// hand edited"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	config.GenConfig.KeepModified = true
+	generator = NewGenerator(config, testTables()[:1])
+	if err := generator.GenerateCode(); err != nil {
+		t.Fatalf("重新生成代码失败: %v", err)
+	}
+
+	report := generator.Report
+	if len(report.Modified) != 1 || report.Modified[0].Output != "src/main/java/com/example/entity/User.java" {
+		t.Errorf("手动修改的文件不正确: %+v", report.Modified)
+	}
+	if content, _ := os.ReadFile(userEntity); string(content) != "// hand edited" {
+		t.Errorf("手动修改的文件被覆盖")
+	}
+	if len(report.Stale) != productFiles {
+		t.Errorf("过期文件数量 = %d, expected %d", len(report.Stale), productFiles)
+	}
+	if len(report.Removed) != 0 {
+		t.Errorf("未开启清理时不应删除文件: %v", report.Removed)
+	}
+
+	// 开启清理后删除过期文件
+	config.GenConfig.CleanStale = true
+	generator = NewGenerator(config, testTables()[:1])
+	if err := generator.GenerateCode(); err != nil {
+		t.Fatalf("重新生成代码失败: %v", err)
+	}
+	if len(generator.Report.Removed) != productFiles {
+		t.Errorf("删除的过期文件数量 = %d, expected %d", len(generator.Report.Removed), productFiles)
+	}
+	if _, err := os.Stat(filepath.Join(outputPath, "src/main/java/com/example/controller/ProductController.java")); !os.IsNotExist(err) {
+		t.Errorf("过期文件未删除")
+	}
+	if len(generator.Report.Modified) != 1 {
+		t.Errorf("保留的手动修改文件应继续被识别: %+v", generator.Report.Modified)
+	}
+}
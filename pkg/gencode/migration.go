@@ -0,0 +1,315 @@
+package gencode
+
+import (
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+)
+
+// SchemaDiff 两次表结构快照之间的差异
+type SchemaDiff struct {
+	Created []Table     // 新增的表
+	Altered []TableDiff // 结构变化的表
+	Dropped []Table     // 删除的表
+}
+
+// TableDiff 单个表的结构差异，字段按列名匹配，重命名视为删除后新增
+type TableDiff struct {
+	From            Table
+	To              Table
+	AddedColumns    []Field
+	ModifiedColumns []ColumnChange
+	DroppedColumns  []Field
+	AddedIndexes    []Index
+	DroppedIndexes  []Index
+}
+
+// ColumnChange 字段变化前后的定义
+type ColumnChange struct {
+	From Field
+	To   Field
+}
+
+// MigrationEntry 清单中记录的迁移脚本
+type MigrationEntry struct {
+	Version     int    `json:"version"`
+	Dialect     string `json:"dialect"`
+	Description string `json:"description"`
+	Output      string `json:"output"` // 输出路径（相对于输出目录）
+}
+
+// DiffTables 对比两次表结构快照，表按表名匹配（不区分大小写）
+func DiffTables(prev, curr []Table) SchemaDiff {
+	var diff SchemaDiff
+	for _, to := range curr {
+		from, ok := findTable(prev, to.TableName)
+		if !ok {
+			diff.Created = append(diff.Created, to)
+			continue
+		}
+		if td := diffTable(from, to); !td.IsEmpty() {
+			diff.Altered = append(diff.Altered, td)
+		}
+	}
+	for _, from := range prev {
+		if _, ok := findTable(curr, from.TableName); !ok {
+			diff.Dropped = append(diff.Dropped, from)
+		}
+	}
+	return diff
+}
+
+// IsEmpty 是否没有任何结构变化
+func (d SchemaDiff) IsEmpty() bool {
+	return len(d.Created) == 0 && len(d.Altered) == 0 && len(d.Dropped) == 0
+}
+
+// IsEmpty 表结构是否没有变化
+func (d TableDiff) IsEmpty() bool {
+	return d.From.TableComment == d.To.TableComment &&
+		len(d.AddedColumns) == 0 && len(d.ModifiedColumns) == 0 && len(d.DroppedColumns) == 0 &&
+		len(d.AddedIndexes) == 0 && len(d.DroppedIndexes) == 0
+}
+
+// Description 根据变化生成迁移脚本的描述，如 create_user_and_alter_dept
+func (d SchemaDiff) Description() string {
+	var parts []string
+	for _, t := range d.Created {
+		parts = append(parts, "create_"+t.TableName)
+	}
+	for _, t := range d.Altered {
+		parts = append(parts, "alter_"+t.To.TableName)
+	}
+	for _, t := range d.Dropped {
+		parts = append(parts, "drop_"+t.TableName)
+	}
+	desc := strings.ToLower(strings.Join(parts, "_and_"))
+	if len(desc) > 64 {
+		desc = strings.TrimRight(desc[:64], "_")
+	}
+	return desc
+}
+
+// migrationDialects 支持生成迁移脚本的数据库方言，SQLite 的 ALTER TABLE 能力有限，不支持
+var migrationDialects = []string{DialectMySQL, DialectPostgres}
+
+// SQL 生成指定方言的迁移语句，依次为建表、修改表和删除表
+func (d SchemaDiff) SQL(dialect string) (string, error) {
+	if !slices.Contains(migrationDialects, dialect) {
+		return "", fmt.Errorf("不支持生成迁移脚本的数据库方言: %s，可选值: %s", dialect, strings.Join(migrationDialects, "/"))
+	}
+
+	var b strings.Builder
+	for _, t := range d.Created {
+		sql, err := CreateTableSQL(dialect, t)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(sql + "\n")
+	}
+	for _, t := range d.Altered {
+		b.WriteString(t.SQL(dialect) + "\n")
+	}
+	for _, t := range d.Dropped {
+		fmt.Fprintf(&b, "DROP TABLE %s;\n\n", quoteIdent(dialect, t.TableName))
+	}
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+// SQL 生成单个表的修改语句：先删除索引和字段，再新增、修改字段，最后创建索引
+func (d TableDiff) SQL(dialect string) string {
+	var b strings.Builder
+	table := quoteIdent(dialect, d.To.TableName)
+	for _, index := range d.DroppedIndexes {
+		if dialect == DialectMySQL {
+			fmt.Fprintf(&b, "ALTER TABLE %s DROP INDEX %s;\n", table, quoteIdent(dialect, index.Name))
+		} else {
+			fmt.Fprintf(&b, "DROP INDEX %s;\n", quoteIdent(dialect, indexName(dialect, d.From, index)))
+		}
+	}
+	for _, f := range d.DroppedColumns {
+		fmt.Fprintf(&b, "ALTER TABLE %s DROP COLUMN %s;\n", table, quoteIdent(dialect, f.ColumnName))
+	}
+	for _, f := range d.AddedColumns {
+		fmt.Fprintf(&b, "ALTER TABLE %s ADD COLUMN %s;\n", table, columnDefinition(dialect, d.To, f))
+		if dialect == DialectPostgres && f.ColumnComment != "" {
+			b.WriteString(columnCommentSQL(dialect, d.To, f))
+		}
+	}
+	for _, c := range d.ModifiedColumns {
+		if dialect == DialectMySQL {
+			fmt.Fprintf(&b, "ALTER TABLE %s MODIFY COLUMN %s;\n", table, columnDefinition(dialect, d.To, c.To))
+			continue
+		}
+		column := quoteIdent(dialect, c.To.ColumnName)
+		if sqlType(dialect, c.From) != sqlType(dialect, c.To) {
+			fmt.Fprintf(&b, "ALTER TABLE %s ALTER COLUMN %s TYPE %s;\n", table, column, sqlType(dialect, c.To))
+		}
+		if c.From.IsNullable != c.To.IsNullable {
+			action := "SET NOT NULL"
+			if c.To.IsNullable {
+				action = "DROP NOT NULL"
+			}
+			fmt.Fprintf(&b, "ALTER TABLE %s ALTER COLUMN %s %s;\n", table, column, action)
+		}
+		if c.From.DefaultValue != c.To.DefaultValue {
+			if c.To.DefaultValue == "" {
+				fmt.Fprintf(&b, "ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT;\n", table, column)
+			} else {
+				fmt.Fprintf(&b, "ALTER TABLE %s ALTER COLUMN %s SET DEFAULT %s;\n", table, column, defaultSQL(dialect, c.To))
+			}
+		}
+		if c.From.ColumnComment != c.To.ColumnComment {
+			b.WriteString(columnCommentSQL(dialect, d.To, c.To))
+		}
+	}
+	for _, index := range d.AddedIndexes {
+		if dialect == DialectMySQL {
+			kind := "INDEX"
+			if index.Unique {
+				kind = "UNIQUE INDEX"
+			}
+			fmt.Fprintf(&b, "ALTER TABLE %s ADD %s %s (%s);\n", table, kind, quoteIdent(dialect, index.Name), quoteColumns(dialect, index.Columns))
+		} else {
+			b.WriteString(createIndexSQL(dialect, d.To, index))
+		}
+	}
+	if d.From.TableComment != d.To.TableComment {
+		if dialect == DialectMySQL {
+			fmt.Fprintf(&b, "ALTER TABLE %s COMMENT=%s;\n", table, quoteString(d.To.TableComment))
+		} else {
+			fmt.Fprintf(&b, "COMMENT ON TABLE %s IS %s;\n", table, quoteString(d.To.TableComment))
+		}
+	}
+	return b.String()
+}
+
+// diffTable 对比单个表的字段与索引
+func diffTable(from, to Table) TableDiff {
+	diff := TableDiff{From: from, To: to}
+	for _, f := range to.Fields {
+		prev, ok := findField(from.Fields, f.ColumnName)
+		if !ok {
+			diff.AddedColumns = append(diff.AddedColumns, f)
+		} else if columnChanged(prev, f) {
+			diff.ModifiedColumns = append(diff.ModifiedColumns, ColumnChange{From: prev, To: f})
+		}
+	}
+	for _, f := range from.Fields {
+		if _, ok := findField(to.Fields, f.ColumnName); !ok {
+			diff.DroppedColumns = append(diff.DroppedColumns, f)
+		}
+	}
+
+	// 索引按名称匹配，定义变化时先删除再重建
+	for _, index := range to.Indexes {
+		prev, ok := findIndex(from.Indexes, index.Name)
+		if !ok || prev.Unique != index.Unique || !slices.Equal(prev.Columns, index.Columns) {
+			diff.AddedIndexes = append(diff.AddedIndexes, index)
+			if ok {
+				diff.DroppedIndexes = append(diff.DroppedIndexes, prev)
+			}
+		}
+	}
+	for _, index := range from.Indexes {
+		if _, ok := findIndex(to.Indexes, index.Name); !ok {
+			diff.DroppedIndexes = append(diff.DroppedIndexes, index)
+		}
+	}
+	return diff
+}
+
+// columnChanged 判断字段的数据库定义是否变化
+func columnChanged(from, to Field) bool {
+	return !strings.EqualFold(from.ColumnType, to.ColumnType) || from.Length != to.Length || from.Scale != to.Scale ||
+		from.IsNullable != to.IsNullable || from.ColumnComment != to.ColumnComment ||
+		from.DefaultValue != to.DefaultValue || !slices.Equal(from.EnumValues, to.EnumValues)
+}
+
+// findTable 按表名查找表
+func findTable(tables []Table, name string) (Table, bool) {
+	for _, t := range tables {
+		if strings.EqualFold(t.TableName, name) {
+			return t, true
+		}
+	}
+	return Table{}, false
+}
+
+// findField 按列名查找字段
+func findField(fields []Field, name string) (Field, bool) {
+	for _, f := range fields {
+		if strings.EqualFold(f.ColumnName, name) {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
+
+// findIndex 按名称查找索引
+func findIndex(indexes []Index, name string) (Index, bool) {
+	for _, index := range indexes {
+		if strings.EqualFold(index.Name, name) {
+			return index, true
+		}
+	}
+	return Index{}, false
+}
+
+// migrationDir 获取迁移脚本的输出目录（相对于输出目录），Java 目标放在 Flyway 默认位置下，多模块项目放在 domain 模块中
+func (g *Generator) migrationDir(dialect string) string {
+	if g.target() == TargetJava {
+		return g.modulePath(path.Join("src/main/resources/db/migration", dialect))
+	}
+	return path.Join("migrations", dialect)
+}
+
+// generateMigrations 对比各方言上次输出迁移脚本时的表结构快照，按方言输出版本化迁移脚本
+// 某方言首次生成时输出全部建表语句，已生成的迁移脚本不会被覆盖或清理
+// 快照只在输出迁移脚本时更新，关闭迁移期间的结构变化会在重新开启后补上
+func (g *Generator) generateMigrations() error {
+	g.manifest.Schemas = maps.Clone(g.prevManifest.Schemas)
+	g.manifest.Migrations = append(g.manifest.Migrations, g.prevManifest.Migrations...)
+
+	for _, dialect := range g.Config.GenConfig.Migration {
+		version := 0
+		for _, m := range g.prevManifest.Migrations {
+			if m.Dialect == dialect && m.Version > version {
+				version = m.Version
+			}
+		}
+
+		diff, description := DiffTables(nil, g.Tables), "init"
+		if version > 0 {
+			diff = DiffTables(g.prevManifest.Schemas[dialect], g.Tables)
+			description = diff.Description()
+		}
+		if diff.IsEmpty() {
+			continue
+		}
+		sql, err := diff.SQL(dialect)
+		if err != nil {
+			return err
+		}
+
+		version++
+		output := path.Join(g.migrationDir(dialect), fmt.Sprintf("V%d__%s.sql", version, description))
+		content := fmt.Sprintf("-- %s\n-- 由代码生成器生成于 %s，已执行的迁移脚本请勿修改\n\n%s", description, g.Config.GenConfig.Date, sql)
+		if err := g.writeOutput(output, []byte(content)); err != nil {
+			return err
+		}
+		g.manifest.Migrations = append(g.manifest.Migrations, MigrationEntry{
+			Version:     version,
+			Dialect:     dialect,
+			Description: description,
+			Output:      output,
+		})
+		if g.manifest.Schemas == nil {
+			g.manifest.Schemas = map[string][]Table{}
+		}
+		g.manifest.Schemas[dialect] = g.Tables
+	}
+	return nil
+}
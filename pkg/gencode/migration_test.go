@@ -0,0 +1,167 @@
+package gencode
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDiffTables(t *testing.T) {
+	prev, err := ParseDDL(`CREATE TABLE user (
+  id bigint PRIMARY KEY,
+  name varchar(32) NOT NULL,
+  age int,
+  status tinyint NOT NULL DEFAULT 0,
+  KEY idx_name (name)
+) COMMENT '用户';
+CREATE TABLE legacy (id int PRIMARY KEY)`)
+	if err != nil {
+		t.Fatalf("解析DDL失败: %v", err)
+	}
+	curr, err := ParseDDL(`CREATE TABLE user (
+  id bigint PRIMARY KEY,
+  name varchar(64) NOT NULL COMMENT '姓名',
+  email varchar(128),
+  status tinyint NOT NULL DEFAULT 1,
+  UNIQUE KEY uk_email (email)
+) COMMENT '用户';
+CREATE TABLE dept (id bigint PRIMARY KEY AUTO_INCREMENT, amount decimal(10,2) NOT NULL)`)
+	if err != nil {
+		t.Fatalf("解析DDL失败: %v", err)
+	}
+
+	diff := DiffTables(prev, curr)
+	if len(diff.Created) != 1 || len(diff.Altered) != 1 || len(diff.Dropped) != 1 {
+		t.Fatalf("差异 = %d/%d/%d, expected 1/1/1", len(diff.Created), len(diff.Altered), len(diff.Dropped))
+	}
+	if desc := diff.Description(); desc != "create_dept_and_alter_user_and_drop_legacy" {
+		t.Errorf("描述 = %s", desc)
+	}
+	if !DiffTables(curr, curr).IsEmpty() {
+		t.Errorf("相同快照不应有差异")
+	}
+
+	testCases := []struct {
+		dialect  string
+		expected []string
+	}{
+		{DialectMySQL, []string{
+			"CREATE TABLE `dept` (\n  `id` bigint NOT NULL AUTO_INCREMENT,\n  `amount` decimal(10,2) NOT NULL,\n  PRIMARY KEY (`id`)\n);",
+			"ALTER TABLE `user` DROP INDEX `idx_name`;",
+			"ALTER TABLE `user` DROP COLUMN `age`;",
+			"ALTER TABLE `user` ADD COLUMN `email` varchar(128) NULL;",
+			"ALTER TABLE `user` MODIFY COLUMN `name` varchar(64) NOT NULL COMMENT '姓名';",
+			"ALTER TABLE `user` MODIFY COLUMN `status` tinyint NOT NULL DEFAULT 1;",
+			"ALTER TABLE `user` ADD UNIQUE INDEX `uk_email` (`email`);",
+			"DROP TABLE `legacy`;",
+		}},
+		{DialectPostgres, []string{
+			"CREATE TABLE \"dept\" (\n  \"id\" bigserial NOT NULL,\n  \"amount\" numeric(10,2) NOT NULL,\n  PRIMARY KEY (\"id\")\n);",
+			`DROP INDEX "user_idx_name";`,
+			`ALTER TABLE "user" ADD COLUMN "email" varchar(128);`,
+			`ALTER TABLE "user" ALTER COLUMN "name" TYPE varchar(64);`,
+			`COMMENT ON COLUMN "user"."name" IS '姓名';`,
+			`ALTER TABLE "user" ALTER COLUMN "status" SET DEFAULT 1;`,
+			`CREATE UNIQUE INDEX "user_uk_email" ON "user" ("email");`,
+			`DROP TABLE "legacy";`,
+		}},
+	}
+	for _, tc := range testCases {
+		sql, err := diff.SQL(tc.dialect)
+		if err != nil {
+			t.Fatalf("生成迁移语句失败: %v", err)
+		}
+		for _, e := range tc.expected {
+			if !strings.Contains(sql, e) {
+				t.Errorf("%s 迁移语句缺少: %s\n%s", tc.dialect, e, sql)
+			}
+		}
+	}
+	if _, err := diff.SQL("oracle"); err == nil {
+		t.Errorf("不支持的方言应返回错误")
+	}
+}
+
+func TestGenerateMigrations(t *testing.T) {
+	outputPath := t.TempDir()
+	config := testConfig(outputPath)
+	config.GenConfig.CleanStale = true
+	config.GenConfig.Migration = []string{DialectMySQL, DialectPostgres}
+	migrationDir := filepath.Join(outputPath, "src/main/resources/db/migration")
+
+	generate := func(ddl string) {
+		t.Helper()
+		tables, err := ParseDDL(ddl)
+		if err != nil {
+			t.Fatalf("解析DDL失败: %v", err)
+		}
+		if err := NewGenerator(config, tables).GenerateCode(); err != nil {
+			t.Fatalf("生成代码失败: %v", err)
+		}
+	}
+
+	// 首次生成输出全部建表语句
+	generate("CREATE TABLE article (id bigint PRIMARY KEY, title varchar(64) NOT NULL)")
+	content, err := os.ReadFile(filepath.Join(migrationDir, "mysql/V1__init.sql"))
+	if err != nil {
+		t.Fatalf("读取迁移脚本失败: %v", err)
+	}
+	if !strings.Contains(string(content), "CREATE TABLE `article`") {
+		t.Errorf("初始迁移脚本缺少建表语句:\n%s", content)
+	}
+
+	// 表结构变化时输出新版本，历史版本保留
+	generate("CREATE TABLE article (id bigint PRIMARY KEY, title varchar(64) NOT NULL, summary varchar(255))")
+	content, err = os.ReadFile(filepath.Join(migrationDir, "postgres/V2__alter_article.sql"))
+	if err != nil {
+		t.Fatalf("读取迁移脚本失败: %v", err)
+	}
+	if !strings.Contains(string(content), `ALTER TABLE "article" ADD COLUMN "summary" varchar(255);`) {
+		t.Errorf("迁移脚本缺少新增字段:\n%s", content)
+	}
+	if _, err := os.Stat(filepath.Join(migrationDir, "postgres/V1__init.sql")); err != nil {
+		t.Errorf("历史迁移脚本不应被清理: %v", err)
+	}
+
+	// 表结构未变化时不输出新版本
+	generate("CREATE TABLE article (id bigint PRIMARY KEY, title varchar(64) NOT NULL, summary varchar(255))")
+	entries, err := os.ReadDir(filepath.Join(migrationDir, "mysql"))
+	if err != nil {
+		t.Fatalf("读取迁移目录失败: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Errorf("迁移脚本数量 = %d, expected 2", len(entries))
+	}
+	manifest, err := LoadManifest(outputPath)
+	if err != nil {
+		t.Fatalf("读取清单失败: %v", err)
+	}
+	if len(manifest.Migrations) != 4 || len(manifest.Schemas[DialectMySQL]) != 1 {
+		t.Errorf("清单记录 = %d 个迁移脚本/%d 个表, expected 4/1", len(manifest.Migrations), len(manifest.Schemas[DialectMySQL]))
+	}
+
+	// 关闭迁移期间的结构变化不更新快照，重新开启后补上
+	config.GenConfig.Migration = nil
+	generate("CREATE TABLE article (id bigint PRIMARY KEY, title varchar(64) NOT NULL, summary varchar(255), author varchar(32))")
+	config.GenConfig.Migration = []string{DialectMySQL}
+	generate("CREATE TABLE article (id bigint PRIMARY KEY, title varchar(64) NOT NULL, summary varchar(255), author varchar(32))")
+	content, err = os.ReadFile(filepath.Join(migrationDir, "mysql/V3__alter_article.sql"))
+	if err != nil {
+		t.Fatalf("读取迁移脚本失败: %v", err)
+	}
+	if !strings.Contains(string(content), "ADD COLUMN `author` varchar(32)") {
+		t.Errorf("迁移脚本缺少关闭迁移期间新增的字段:\n%s", content)
+	}
+
+	// 未开启的方言保留原快照，重新开启后同样补上
+	config.GenConfig.Migration = []string{DialectPostgres}
+	generate("CREATE TABLE article (id bigint PRIMARY KEY, title varchar(64) NOT NULL, summary varchar(255), author varchar(32))")
+	content, err = os.ReadFile(filepath.Join(migrationDir, "postgres/V3__alter_article.sql"))
+	if err != nil {
+		t.Fatalf("读取迁移脚本失败: %v", err)
+	}
+	if !strings.Contains(string(content), `ADD COLUMN "author" varchar(32)`) {
+		t.Errorf("迁移脚本缺少新增字段:\n%s", content)
+	}
+}
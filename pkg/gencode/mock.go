@@ -0,0 +1,521 @@
+package gencode
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MockConfig 模拟数据配置
+type MockConfig struct {
+	Rows int   `json:"rows"` // 每个表生成的行数，为 0 时不生成模拟数据
+	Seed int64 `json:"seed"` // 随机数种子，相同的种子和表结构生成相同的数据
+}
+
+// MockTable 一个表的模拟数据
+type MockTable struct {
+	Table Table
+	// Rows 按 Table.Fields 顺序排列的列值，取值为 nil（NULL）、bool、int64、
+	// json.Number（小数）或 string（字符串、日期和时间）
+	Rows [][]any
+}
+
+// mockEpoch 模拟时间的起点，时间取值为其后一年内，保证相同种子生成相同的数据
+var mockEpoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// commentEnumPattern 匹配注释中的枚举项，如 状态（0正常 1停用）、性别 0:男 1:女、type: a=文章,b=视频
+var commentEnumPattern = regexp.MustCompile(`(?:^|[\s(（,，;；:：、|/])(-?\d+|[A-Za-z_][A-Za-z0-9_]*)\s*([:：=\-])?\s*([^\s\d,，;；、|/()（）:：=\-][^\s,，;；、|/()（）:：=]*)`)
+
+var (
+	mockSurnames  = []rune("张王李赵刘陈杨黄周吴徐孙马朱胡郭何林高罗")
+	mockGivens    = []rune("伟芳娜敏静丽强磊军洋勇艳杰娟涛明超秀霞平刚英华")
+	mockCities    = []string{"北京市", "上海市", "广州市", "深圳市", "杭州市", "成都市", "武汉市", "南京市"}
+	mockRoads     = []string{"人民路", "中山路", "解放路", "建设路", "和平路", "长江路"}
+	mockSentences = []string{"这是一条示例数据", "用于前端联调和演示", "数据由代码生成器随机生成", "请勿在生产环境使用", "可以按需修改或删除"}
+	mockAlphabet  = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789./"
+)
+
+// MockData 为每个表生成 rows 行模拟数据，按引用关系排序，被引用的表在前。
+// 取值根据字段类型和列名推断，如 email 生成邮箱、phone 生成手机号；
+// enum 类型和注释中列举的枚举只取可选值；引用其他表的列取被引用表已生成的主键
+func MockData(tables []Table, rows int, seed int64) []MockTable {
+	r := rand.New(rand.NewPCG(uint64(seed), 0))
+	relations := DetectRelations(tables)
+
+	keys := make(map[string][]any) // 各表已生成的主键，键为小写表名
+	var data []MockTable
+	for _, table := range sortByReference(tables, relations) {
+		refs := make(map[string]Relation)
+		for _, relation := range relations {
+			if strings.EqualFold(relation.Table, table.TableName) {
+				refs[strings.ToLower(relation.Column)] = relation
+			}
+		}
+		unique := make(map[string]map[string]bool)
+		for _, index := range table.Indexes {
+			if index.Unique && len(index.Columns) == 1 {
+				unique[strings.ToLower(index.Columns[0])] = make(map[string]bool)
+			}
+		}
+
+		mock := MockTable{Table: table}
+		tableKey := strings.ToLower(table.TableName)
+		pk := fieldIndex(table, table.PrimaryKey.ColumnName) // 没有主键的表不记录主键，引用它的列按无可选值处理
+		for n := 1; n <= rows; n++ {
+			row := make([]any, len(table.Fields))
+			for i, field := range table.Fields {
+				column := strings.ToLower(field.ColumnName)
+				if relation, ok := refs[column]; ok {
+					row[i] = mockReference(r, field, relation, keys)
+					continue
+				}
+				value := mockValue(r, table, field, n)
+				if seen, ok := unique[column]; ok {
+					value = mockUnique(value, seen, n)
+				}
+				row[i] = value
+			}
+			mock.Rows = append(mock.Rows, row)
+			if pk >= 0 {
+				keys[tableKey] = append(keys[tableKey], row[pk])
+			}
+		}
+		data = append(data, mock)
+	}
+	return data
+}
+
+// sortByReference 按引用关系排序，被引用的表在前，循环引用时保持原有顺序
+func sortByReference(tables []Table, relations []Relation) []Table {
+	var sorted []Table
+	state := make(map[string]int) // 1 访问中，2 已排序
+	var visit func(table Table)
+	visit = func(table Table) {
+		key := strings.ToLower(table.TableName)
+		if state[key] != 0 {
+			return
+		}
+		state[key] = 1
+		for _, relation := range relations {
+			if !strings.EqualFold(relation.Table, table.TableName) || strings.EqualFold(relation.RefTable, table.TableName) {
+				continue
+			}
+			if ref, ok := findTable(tables, relation.RefTable); ok {
+				visit(ref)
+			}
+		}
+		state[key] = 2
+		sorted = append(sorted, table)
+	}
+	for _, table := range tables {
+		visit(table)
+	}
+	return sorted
+}
+
+// fieldIndex 获取列在表中的位置
+func fieldIndex(table Table, column string) int {
+	for i, field := range table.Fields {
+		if strings.EqualFold(field.ColumnName, column) {
+			return i
+		}
+	}
+	return -1
+}
+
+// mockReference 从被引用表已生成的主键中取值，自引用时只取之前的行，部分行作为根节点
+func mockReference(r *rand.Rand, field Field, relation Relation, keys map[string][]any) any {
+	candidates := keys[strings.ToLower(relation.RefTable)]
+	if strings.EqualFold(relation.Table, relation.RefTable) && r.IntN(3) == 0 {
+		candidates = nil
+	}
+	if len(candidates) == 0 {
+		if field.IsNullable {
+			return nil
+		}
+		return mockZero(field)
+	}
+	return candidates[r.IntN(len(candidates))]
+}
+
+// mockZero 不可空的引用列没有可引用的行时的取值，与树形表根节点的 parent_id = 0 一致
+func mockZero(field Field) any {
+	switch field.GoType {
+	case "string":
+		return ""
+	default:
+		return int64(0)
+	}
+}
+
+// mockUnique 保证唯一索引列的取值不重复，重复时以行号结尾
+func mockUnique(value any, seen map[string]bool, n int) any {
+	s, ok := value.(string)
+	if !ok {
+		return value
+	}
+	if seen[s] {
+		suffix := strconv.Itoa(n)
+		s = truncateRunes(s, utf8.RuneCountInString(s)-len(suffix)) + suffix
+	}
+	seen[s] = true
+	return s
+}
+
+// mockValue 根据字段类型、列名和注释生成第 n 行的取值
+func mockValue(r *rand.Rand, table Table, field Field, n int) any {
+	column := strings.ToLower(field.ColumnName)
+	columnType := strings.ToLower(field.ColumnType)
+	if field.IsPrimaryKey {
+		if field.GoType == "string" {
+			return truncateRunes(fmt.Sprintf("%s%06d", strings.ToUpper(column[:1]), n), field.Length)
+		}
+		return int64(n)
+	}
+	if field.LogicDelete {
+		if field.GoType == "bool" {
+			return false
+		}
+		return int64(0)
+	}
+	if field.Version {
+		return int64(1)
+	}
+	if field.IsNullable && r.IntN(10) == 0 {
+		return nil
+	}
+
+	if len(field.EnumValues) > 0 {
+		return field.EnumValues[r.IntN(len(field.EnumValues))]
+	}
+	if values := commentEnumValues(field.ColumnComment); len(values) > 0 {
+		value := values[r.IntN(len(values))]
+		if field.GoType == "string" {
+			return value
+		}
+		if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return i
+		}
+	}
+
+	switch columnType {
+	case "bit", "bool", "boolean":
+		return r.IntN(2) == 1
+	case "tinyint":
+		return int64(r.IntN(2))
+	case "smallint", "mediumint", "int", "integer", "bigint", "year":
+		return mockInt(r, column, columnType, n)
+	case "decimal", "numeric", "float", "double", "real":
+		return mockDecimal(r, column, field.Scale)
+	case "date":
+		return mockTime(r).Format(time.DateOnly)
+	case "datetime", "timestamp":
+		return mockTime(r).Format(time.DateTime)
+	case "time":
+		return mockTime(r).Format(time.TimeOnly)
+	case "json":
+		return "{}"
+	case "blob", "tinyblob", "mediumblob", "longblob", "binary", "varbinary":
+		if field.IsNullable {
+			return nil
+		}
+		return ""
+	}
+	return truncateRunes(mockString(r, table, field, n), field.Length)
+}
+
+// mockInt 根据列名生成整数
+func mockInt(r *rand.Rand, column, columnType string, n int) int64 {
+	switch {
+	case columnType == "year":
+		return int64(2000 + r.IntN(25))
+	case mockWord(column, "age"):
+		return int64(18 + r.IntN(43))
+	case strings.Contains(column, "sort") || strings.Contains(column, "order_num") || strings.Contains(column, "seq"):
+		return int64(n)
+	case strings.Contains(column, "status") || strings.Contains(column, "type") || strings.Contains(column, "state"):
+		return int64(r.IntN(2))
+	case strings.Contains(column, "stock") || strings.Contains(column, "quantity") || strings.Contains(column, "count") || strings.Contains(column, "num"):
+		return int64(r.IntN(500))
+	case columnType == "smallint":
+		return int64(r.IntN(100))
+	default:
+		return int64(1 + r.IntN(1000))
+	}
+}
+
+// mockDecimal 根据列名生成保留 scale 位小数的数值
+func mockDecimal(r *rand.Rand, column string, scale int) json.Number {
+	value := r.Float64() * 100
+	switch {
+	case strings.Contains(column, "price") || strings.Contains(column, "amount") || strings.Contains(column, "money") ||
+		strings.Contains(column, "fee") || strings.Contains(column, "cost") || strings.Contains(column, "salary"):
+		value = 1 + r.Float64()*999
+	case strings.Contains(column, "rate") || strings.Contains(column, "ratio") || strings.Contains(column, "percent"):
+		value = r.Float64()
+	case strings.Contains(column, "longitude") || mockWord(column, "lng", "lon"):
+		value = 73 + r.Float64()*62
+	case strings.Contains(column, "latitude") || mockWord(column, "lat"):
+		value = 18 + r.Float64()*35
+	}
+	if scale == 0 {
+		scale = 2
+	}
+	return json.Number(strconv.FormatFloat(value, 'f', scale, 64))
+}
+
+// mockTime 生成 mockEpoch 之后一年内的时间
+func mockTime(r *rand.Rand) time.Time {
+	return mockEpoch.Add(time.Duration(r.IntN(365*24*3600)) * time.Second)
+}
+
+// mockString 根据列名生成字符串
+func mockString(r *rand.Rand, table Table, field Field, n int) string {
+	column := strings.ToLower(field.ColumnName)
+	has := func(words ...string) bool {
+		for _, word := range words {
+			if strings.Contains(column, word) {
+				return true
+			}
+		}
+		return false
+	}
+	switch {
+	case has("email", "mail"):
+		return fmt.Sprintf("user%d@example.com", n)
+	case has("phone", "mobile") || mockWord(column, "tel"):
+		return fmt.Sprintf("1%d%09d", 3+r.IntN(7), r.IntN(1000000000))
+	case has("password", "passwd", "pwd", "secret"):
+		// BCrypt 格式的随机串，不对应任何明文
+		b := []byte("$2a$10$")
+		for i := 0; i < 53; i++ {
+			b = append(b, mockAlphabet[r.IntN(len(mockAlphabet))])
+		}
+		return string(b)
+	case has("avatar", "image", "photo", "logo", "cover") || mockWord(column, "img", "pic"):
+		return fmt.Sprintf("https://picsum.photos/seed/%s%d/200", table.TableName, n)
+	case has("website", "homepage") || mockWord(column, "url", "link"):
+		return fmt.Sprintf("https://example.com/%s/%d", table.TableName, n)
+	case mockWord(column, "ip"):
+		return fmt.Sprintf("192.168.%d.%d", r.IntN(256), 1+r.IntN(254))
+	case has("id_card", "idcard", "id_no"):
+		return fmt.Sprintf("110101%04d%02d%02d%04d", 1970+r.IntN(35), 1+r.IntN(12), 1+r.IntN(28), r.IntN(10000))
+	case has("address", "addr"):
+		return fmt.Sprintf("%s%s%d号", mockCities[r.IntN(len(mockCities))], mockRoads[r.IntN(len(mockRoads))], 1+r.IntN(999))
+	case has("city"):
+		return mockCities[r.IntN(len(mockCities))]
+	case has("color"):
+		return fmt.Sprintf("#%06x", r.IntN(0x1000000))
+	case has("username", "user_name", "account", "login"):
+		return fmt.Sprintf("user%d", n)
+	case has("real_name", "nick_name", "nickname", "full_name", "contact", "person", "author", "owner"),
+		column == "name" && mockPersonTable(table.TableName):
+		return mockPersonName(r)
+	case has("remark", "desc", "content", "note", "memo", "comment", "summary", "intro"):
+		return mockSentences[r.IntN(len(mockSentences))]
+	case has("code", "number") || mockWord(column, "sn", "no"):
+		return fmt.Sprintf("%s%06d", strings.ToUpper(table.TableName[:1]), n)
+	case has("title"):
+		return fmt.Sprintf("%s标题%d", tableLabel(table), n)
+	case has("name"):
+		return fmt.Sprintf("%s%d", tableLabel(table), n)
+	default:
+		return fmt.Sprintf("%s%d", field.FieldName, n)
+	}
+}
+
+// mockWord 列名按下划线分隔后是否包含其中的单词，用于较短的单词，避免 ip 匹配 description
+func mockWord(column string, words ...string) bool {
+	for _, part := range strings.Split(column, "_") {
+		if slices.Contains(words, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// mockPersonTable 表名是否表示人员，此类表的 name 列生成姓名
+func mockPersonTable(tableName string) bool {
+	name := strings.ToLower(tableName)
+	for _, word := range []string{"user", "member", "employee", "customer", "staff", "person", "student", "teacher"} {
+		if strings.Contains(name, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// mockPersonName 生成两到三个字的中文姓名
+func mockPersonName(r *rand.Rand) string {
+	name := []rune{mockSurnames[r.IntN(len(mockSurnames))], mockGivens[r.IntN(len(mockGivens))]}
+	if r.IntN(2) == 0 {
+		name = append(name, mockGivens[r.IntN(len(mockGivens))])
+	}
+	return string(name)
+}
+
+// tableLabel 表的显示名称，用作名称类字段的前缀和菜单名，取表注释去掉“表”“信息表”后缀，没有注释时取表名
+func tableLabel(table Table) string {
+	label := strings.TrimSuffix(strings.TrimSuffix(table.TableComment, "表"), "信息")
+	if label == "" {
+		return table.TableName
+	}
+	return label
+}
+
+// commentEnumValues 解析注释中列举的枚举值，如 状态（0正常 1停用）返回 0 和 1，少于两项时不视为枚举
+func commentEnumValues(comment string) []string {
+	var values []string
+	for pos := 0; pos < len(comment); {
+		loc := commentEnumPattern.FindStringSubmatchIndex(comment[pos:])
+		if loc == nil {
+			break
+		}
+		key, separator, end := comment[pos+loc[2]:pos+loc[3]], loc[4] >= 0, pos+loc[1]
+		// 取值后紧跟分隔符时取值本身是下一项的键，如 类型: a=文章 中的 a
+		if next, _ := utf8.DecodeRuneInString(comment[end:]); strings.ContainsRune(":：=", next) {
+			pos += loc[6]
+			continue
+		}
+		pos = end
+		// 字母开头的键必须有分隔符，避免把普通英文单词当作枚举
+		if !separator && !strings.ContainsAny(key[:1], "-0123456789") {
+			continue
+		}
+		values = append(values, key)
+	}
+	if len(values) < 2 {
+		return nil
+	}
+	return values
+}
+
+// truncateRunes 按字符截断字符串，length 不大于 0 时不截断
+func truncateRunes(s string, length int) string {
+	if length <= 0 || utf8.RuneCountInString(s) <= length {
+		return s
+	}
+	return string([]rune(s)[:length])
+}
+
+// MockInsertSQL 生成模拟数据的 INSERT 语句，PostgreSQL 自增主键的序列同步到最大值
+func MockInsertSQL(dialect string, data []MockTable) (string, error) {
+	if err := checkDialect(dialect); err != nil {
+		return "", err
+	}
+	var blocks []string
+	for _, mock := range data {
+		if len(mock.Rows) == 0 {
+			continue
+		}
+		var b strings.Builder
+		table := mock.Table
+		columns := make([]string, len(table.Fields))
+		for i, field := range table.Fields {
+			columns[i] = quoteIdent(dialect, field.ColumnName)
+		}
+		if table.TableComment != "" {
+			b.WriteString("-- " + strings.ReplaceAll(table.TableComment, "\n", " ") + "\n")
+		}
+		fmt.Fprintf(&b, "INSERT INTO %s (%s) VALUES\n", quoteIdent(dialect, table.TableName), strings.Join(columns, ", "))
+		for i, row := range mock.Rows {
+			values := make([]string, len(row))
+			for j, value := range row {
+				values[j] = sqlLiteral(value)
+			}
+			b.WriteString("(" + strings.Join(values, ", ") + ")")
+			if i < len(mock.Rows)-1 {
+				b.WriteString(",\n")
+			} else {
+				b.WriteString(";\n")
+			}
+		}
+		if dialect == DialectPostgres && isAutoIncrement(table, table.PrimaryKey) {
+			pk := quoteIdent(dialect, table.PrimaryKey.ColumnName)
+			fmt.Fprintf(&b, "SELECT setval(pg_get_serial_sequence(%s, %s), (SELECT MAX(%s) FROM %s));\n",
+				quoteString(quoteIdent(dialect, table.TableName)), quoteString(table.PrimaryKey.ColumnName), pk, quoteIdent(dialect, table.TableName))
+		}
+		blocks = append(blocks, b.String())
+	}
+	return strings.Join(blocks, "\n"), nil
+}
+
+// sqlLiteral 生成取值的 SQL 字面量
+func sqlLiteral(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "NULL"
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	case string:
+		return quoteString(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// JSON 生成 JSON 数组形式的数据，对象的键为字段名，与接口返回的字段一致
+func (m MockTable) JSON() ([]byte, error) {
+	objects := make([]map[string]any, len(m.Rows))
+	for i, row := range m.Rows {
+		objects[i] = make(map[string]any, len(row))
+		for j, value := range row {
+			objects[i][m.Table.Fields[j].FieldName] = value
+		}
+	}
+	content, err := json.MarshalIndent(objects, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(content, '\n'), nil
+}
+
+// mockDir 模拟数据的输出目录
+func (g *Generator) mockDir() string {
+	if g.target() == TargetJava {
+		return g.modulePath("src/main/resources/db/mock")
+	}
+	return "mock"
+}
+
+// generateMock 输出模拟数据的 INSERT 脚本和每个表的 JSON 数据
+func (g *Generator) generateMock(tables []Table) error {
+	mockConfig := g.Config.GenConfig.Mock
+	if mockConfig.Rows <= 0 {
+		return nil
+	}
+	data := MockData(tables, mockConfig.Rows, mockConfig.Seed)
+
+	sql, err := MockInsertSQL(g.dialect(), data)
+	if err != nil {
+		return err
+	}
+	content := fmt.Sprintf("-- 模拟数据，由代码生成器以种子 %d 生成，仅用于开发和演示\n\n%s", mockConfig.Seed, sql)
+	err = g.emitFile("mock", "", filepath.FromSlash(path.Join(g.mockDir(), "data.sql")), []byte(content))
+	if err != nil {
+		return err
+	}
+
+	for _, mock := range data {
+		content, err := mock.JSON()
+		if err != nil {
+			return err
+		}
+		err = g.emitFile("mock", mock.Table.TableName, filepath.FromSlash(path.Join(g.mockDir(), mock.Table.TableName+".json")), content)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package gencode
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"gen_code/pkg/gencode/gencodetest"
+)
+
+func TestCommentEnumValues(t *testing.T) {
+	testCases := []struct {
+		comment string
+		values  []string
+	}{
+		{"状态（0正常 1停用）", []string{"0", "1"}},
+		{"性别 0:男 1:女 2:未知", []string{"0", "1", "2"}},
+		{"类型：1-文章，2-视频", []string{"1", "2"}},
+		{"类型: a=文章, b=视频", []string{"a", "b"}},
+		{"订单状态 -1=已取消 0=待支付 1=已支付", []string{"-1", "0", "1"}},
+		{"用户名", nil},
+		{"库存 最多 100件", nil},
+		{"description of the user", nil},
+	}
+	for _, tc := range testCases {
+		if values := commentEnumValues(tc.comment); !reflect.DeepEqual(values, tc.values) {
+			t.Errorf("commentEnumValues(%q) = %v, want %v", tc.comment, values, tc.values)
+		}
+	}
+}
+
+func TestMockData(t *testing.T) {
+	ddl, err := os.ReadFile(filepath.Join("testdata", "golden", "fixture.sql"))
+	if err != nil {
+		t.Fatalf("读取表结构失败: %v", err)
+	}
+	tables, err := ParseDDL(string(ddl))
+	if err != nil {
+		t.Fatalf("解析表结构失败: %v", err)
+	}
+	// 被引用的部门表排在后面，生成时应先生成部门
+	slices.Reverse(tables)
+	tables[0].Fields[7].ColumnComment = "状态（0正常 1停用 2锁定）"
+	for i := range tables {
+		tables[i] = Conventions{}.Apply(tables[i])
+	}
+
+	data := MockData(tables, 20, 1)
+	if len(data) != 2 || data[0].Table.TableName != "sys_dept" || data[1].Table.TableName != "sys_user" {
+		t.Fatalf("表顺序错误: %v", data)
+	}
+	if !reflect.DeepEqual(data, MockData(tables, 20, 1)) {
+		t.Errorf("相同的种子应生成相同的数据")
+	}
+	if reflect.DeepEqual(data, MockData(tables, 20, 2)) {
+		t.Errorf("不同的种子应生成不同的数据")
+	}
+
+	depts := map[any]bool{}
+	for i, row := range data[0].Rows {
+		depts[row[0]] = true
+		// 上级部门只引用之前生成的部门
+		if parent := row[1]; parent != nil && parent.(int64) >= int64(i+1) {
+			t.Errorf("第 %d 个部门的上级部门 = %v", i+1, parent)
+		}
+	}
+	email := regexp.MustCompile(`^user\d+@example\.com$`)
+	usernames := map[any]bool{}
+	statuses := map[any]bool{}
+	for _, row := range data[1].Rows {
+		if row[1] != nil && !depts[row[1]] {
+			t.Errorf("部门ID %v 不存在", row[1])
+		}
+		usernames[row[2]] = true
+		if s, ok := row[4].(string); ok && !email.MatchString(s) {
+			t.Errorf("邮箱格式错误: %s", s)
+		}
+		if row[5] != nil && row[5] != "male" && row[5] != "female" {
+			t.Errorf("性别不在可选值中: %v", row[5])
+		}
+		statuses[row[7]] = true
+		if row[8] != int64(0) || row[9] != int64(1) {
+			t.Errorf("逻辑删除和版本号 = %v, %v", row[8], row[9])
+		}
+	}
+	if len(usernames) != 20 {
+		t.Errorf("唯一索引列存在重复: %v", usernames)
+	}
+	for status := range statuses {
+		if !slices.Contains([]any{int64(0), int64(1), int64(2)}, status) {
+			t.Errorf("状态不在注释的枚举中: %v", status)
+		}
+	}
+
+	var objects []map[string]any
+	content, err := data[1].JSON()
+	if err != nil {
+		t.Fatalf("生成 JSON 失败: %v", err)
+	}
+	if err := json.Unmarshal(content, &objects); err != nil || len(objects) != 20 {
+		t.Fatalf("解析 JSON 失败: %v", err)
+	}
+	if _, ok := objects[0]["deptId"]; !ok {
+		t.Errorf("JSON 的键应为字段名: %v", objects[0])
+	}
+
+	files := map[string][]byte{}
+	for _, dialect := range dialects {
+		sql, err := MockInsertSQL(dialect, MockData(tables, 3, 1))
+		if err != nil {
+			t.Fatalf("生成 INSERT 语句失败: %v", err)
+		}
+		files[dialect+".sql"] = []byte(sql)
+	}
+	gencodetest.Golden(t, filepath.Join("testdata", "mock"), files, *update)
+}
+
+func TestGenerateMock(t *testing.T) {
+	config := testConfig(t.TempDir())
+	config.GenConfig.Mock = MockConfig{Rows: 5, Seed: 7}
+	files, err := NewGenerator(config, testTables()).RenderFiles()
+	if err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+	sql := string(files["src/main/resources/db/mock/data.sql"])
+	if !strings.Contains(sql, "种子 7") || strings.Count(sql, "INSERT INTO") != 2 {
+		t.Errorf("模拟数据脚本错误\n%s", sql)
+	}
+	for _, table := range testTables() {
+		if _, ok := files["src/main/resources/db/mock/"+table.TableName+".json"]; !ok {
+			t.Errorf("缺少 %s 的 JSON 数据", table.TableName)
+		}
+	}
+
+	config.GenConfig.Mock = MockConfig{}
+	files, err = NewGenerator(config, testTables()).RenderFiles()
+	if err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+	for name := range files {
+		if strings.Contains(name, "/mock/") {
+			t.Errorf("未开启时不应生成模拟数据: %s", name)
+		}
+	}
+}
+
+func TestMockDataWithoutPrimaryKey(t *testing.T) {
+	table := CompleteTable(Table{TableName: "t_log", Fields: []Field{{ColumnName: "msg", ColumnType: "varchar"}}})
+	data := MockData([]Table{table}, 3, 1)
+	if len(data) != 1 || len(data[0].Rows) != 3 {
+		t.Fatalf("MockData = %v", data)
+	}
+	for _, dialect := range dialects {
+		sql, err := MockInsertSQL(dialect, data)
+		if err != nil || strings.Count(sql, "INSERT INTO") != 1 {
+			t.Errorf("生成 %s INSERT 语句失败: %v\n%s", dialect, err, sql)
+		}
+	}
+}
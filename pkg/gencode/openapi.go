@@ -0,0 +1,410 @@
+package gencode
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// xlsxMediaType xlsx 文件的媒体类型
+const xlsxMediaType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+// openAPIDoc OpenAPI 3 文档，字段顺序即输出顺序
+type openAPIDoc struct {
+	OpenAPI    string                     `yaml:"openapi"`
+	Info       openAPIInfo                `yaml:"info"`
+	Tags       []openAPITag               `yaml:"tags,omitempty"`
+	Paths      map[string]openAPIPathItem `yaml:"paths"`
+	Components openAPIComponents          `yaml:"components"`
+}
+
+type openAPIInfo struct {
+	Title       string `yaml:"title"`
+	Description string `yaml:"description,omitempty"`
+	Version     string `yaml:"version"`
+}
+
+type openAPITag struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+}
+
+type openAPIPathItem struct {
+	Get    *openAPIOperation `yaml:"get,omitempty"`
+	Put    *openAPIOperation `yaml:"put,omitempty"`
+	Post   *openAPIOperation `yaml:"post,omitempty"`
+	Delete *openAPIOperation `yaml:"delete,omitempty"`
+}
+
+type openAPIOperation struct {
+	Tags        []string                   `yaml:"tags"`
+	Summary     string                     `yaml:"summary"`
+	OperationID string                     `yaml:"operationId"`
+	Parameters  []openAPIParameter         `yaml:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody        `yaml:"requestBody,omitempty"`
+	Responses   map[string]openAPIResponse `yaml:"responses"`
+}
+
+type openAPIParameter struct {
+	Name        string         `yaml:"name"`
+	In          string         `yaml:"in"`
+	Description string         `yaml:"description,omitempty"`
+	Required    bool           `yaml:"required,omitempty"`
+	Schema      *openAPISchema `yaml:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Required bool                        `yaml:"required"`
+	Content  map[string]openAPIMediaType `yaml:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                      `yaml:"description"`
+	Content     map[string]openAPIMediaType `yaml:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `yaml:"schema"`
+}
+
+type openAPIComponents struct {
+	Schemas map[string]*openAPISchema `yaml:"schemas"`
+}
+
+// openAPISchema OpenAPI 数据结构，Properties 保持字段定义顺序
+type openAPISchema struct {
+	Ref         string         `yaml:"$ref,omitempty"`
+	Type        string         `yaml:"type,omitempty"`
+	Format      string         `yaml:"format,omitempty"`
+	Description string         `yaml:"description,omitempty"`
+	Enum        []string       `yaml:"enum,omitempty"`
+	MaxLength   int            `yaml:"maxLength,omitempty"`
+	Example     string         `yaml:"example,omitempty"`
+	Default     any            `yaml:"default,omitempty"`
+	Items       *openAPISchema `yaml:"items,omitempty"`
+	Required    []string       `yaml:"required,omitempty"`
+	Properties  *yaml.Node     `yaml:"properties,omitempty"`
+	HtmlType    string         `yaml:"x-html-type,omitempty"`   // 表单控件类型，供前端生成表单
+	DictType    string         `yaml:"x-dict-type,omitempty"`   // 绑定的字典类型，供前端渲染下拉选项
+	ListFields  []string       `yaml:"x-list-fields,omitempty"` // 列表中显示的字段，供前端生成表格列
+}
+
+// OpenAPISpec 生成 Java 控制器的 OpenAPI 3 文档（YAML），包含每个表的列表、分页、详情、新增、修改和删除接口
+func OpenAPISpec(config Config, tables []Table) ([]byte, error) {
+	doc := openAPIDoc{
+		OpenAPI: "3.0.3",
+		Info: openAPIInfo{
+			Title:       config.ProjectName + " API",
+			Description: "由代码生成器生成",
+			Version:     "1.0.0",
+		},
+		Paths:      make(map[string]openAPIPathItem),
+		Components: openAPIComponents{Schemas: make(map[string]*openAPISchema)},
+	}
+
+	for _, table := range tables {
+		className := toPascalCase(table.TableName)
+		name := lowerFirst(className)
+		comment := table.TableComment
+		doc.Tags = append(doc.Tags, openAPITag{Name: className, Description: comment})
+
+		voRef := schemaRef(className + "VO")
+		boolean := &openAPISchema{Type: "boolean"}
+		idParam := openAPIParameter{
+			Name:        "id",
+			In:          "path",
+			Description: table.PrimaryKey.ColumnComment,
+			Required:    true,
+			Schema:      fieldSchema(table.PrimaryKey),
+		}
+		pageParams := []openAPIParameter{
+			{Name: "current", In: "query", Description: "当前页", Schema: &openAPISchema{Type: "integer", Format: "int64", Default: 1}},
+			{Name: "size", In: "query", Description: "每页条数", Schema: &openAPISchema{Type: "integer", Format: "int64", Default: 10}},
+		}
+
+		base := "/" + table.TableName
+		doc.Paths[base+"/list"] = openAPIPathItem{
+			Get: &openAPIOperation{
+				Tags:        []string{className},
+				Summary:     "查询" + comment + "列表",
+				OperationID: name + "List",
+				Parameters:  queryParameters(table),
+				Responses:   jsonResponse(&openAPISchema{Type: "array", Items: voRef}),
+			},
+		}
+		doc.Paths[base+"/page"] = openAPIPathItem{
+			Get: &openAPIOperation{
+				Tags:        []string{className},
+				Summary:     "查询" + comment + "分页列表",
+				OperationID: name + "Page",
+				Parameters:  append(pageParams, queryParameters(table)...),
+				Responses:   jsonResponse(schemaRef(className + "Page")),
+			},
+		}
+		doc.Paths[base+"/{id}"] = openAPIPathItem{
+			Get: &openAPIOperation{
+				Tags:        []string{className},
+				Summary:     "获取" + comment + "详细信息",
+				OperationID: name + "Get",
+				Parameters:  []openAPIParameter{idParam},
+				Responses:   jsonResponse(voRef),
+			},
+			Delete: &openAPIOperation{
+				Tags:        []string{className},
+				Summary:     "删除" + comment,
+				OperationID: name + "Delete",
+				Parameters:  []openAPIParameter{idParam},
+				Responses:   jsonResponse(boolean),
+			},
+		}
+		doc.Paths[base] = openAPIPathItem{
+			Post: &openAPIOperation{
+				Tags:        []string{className},
+				Summary:     "新增" + comment,
+				OperationID: name + "Create",
+				RequestBody: jsonBody(schemaRef(className + "CreateDTO")),
+				Responses:   jsonResponse(boolean),
+			},
+			Put: &openAPIOperation{
+				Tags:        []string{className},
+				Summary:     "修改" + comment,
+				OperationID: name + "Update",
+				RequestBody: jsonBody(schemaRef(className + "UpdateDTO")),
+				Responses:   jsonResponse(boolean),
+			},
+		}
+
+		if config.GenConfig.EnableExcel {
+			addExcelPaths(doc.Paths, table, className, name, comment)
+		}
+
+		// 修改参数包含主键、可编辑字段和乐观锁版本字段
+		updateFields := []Field{table.PrimaryKey}
+		updateFields = append(updateFields, table.FormFields()...)
+		if table.HasVersion() {
+			updateFields = append(updateFields, table.VersionField())
+		}
+		schemas := doc.Components.Schemas
+		vo := objectSchema(comment+"视图", table.ResponseFields(), nil)
+		for _, f := range table.ListFields() {
+			vo.ListFields = append(vo.ListFields, f.FieldName)
+		}
+		schemas[className+"VO"] = vo
+		schemas[className+"CreateDTO"] = objectSchema(comment+"新增参数", table.FormFields(), Field.IsRequired)
+		schemas[className+"UpdateDTO"] = objectSchema(comment+"修改参数", updateFields, func(f Field) bool {
+			return f.IsPrimaryKey || (!f.Version && !f.Sensitive && f.IsRequired())
+		})
+		schemas[className+"Page"] = pageSchema(comment, voRef)
+	}
+
+	if config.GenConfig.EnableExcel {
+		addExcelSchemas(doc.Components.Schemas)
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return nil, fmt.Errorf("生成OpenAPI文档失败: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// addExcelPaths 添加表的导出接口，必填字段均可导入时添加导入模板下载和导入接口
+func addExcelPaths(paths map[string]openAPIPathItem, table Table, className, name, comment string) {
+	xlsx := map[string]openAPIResponse{
+		"200": {Description: "xlsx 文件", Content: map[string]openAPIMediaType{
+			xlsxMediaType: {Schema: &openAPISchema{Type: "string", Format: "binary"}},
+		}},
+	}
+	base := "/" + table.TableName
+	paths[base+"/export"] = openAPIPathItem{
+		Get: &openAPIOperation{
+			Tags:        []string{className},
+			Summary:     "导出" + comment,
+			OperationID: name + "Export",
+			Parameters:  queryParameters(table),
+			Responses:   xlsx,
+		},
+	}
+	if !table.CanImport() {
+		return
+	}
+	paths[base+"/import-template"] = openAPIPathItem{
+		Get: &openAPIOperation{
+			Tags:        []string{className},
+			Summary:     "下载" + comment + "导入模板",
+			OperationID: name + "ImportTemplate",
+			Responses:   xlsx,
+		},
+	}
+
+	form := &openAPISchema{Type: "object", Required: []string{"file"}, Properties: &yaml.Node{Kind: yaml.MappingNode}}
+	form.addProperty("file", &openAPISchema{Type: "string", Format: "binary", Description: "xlsx 文件"})
+	paths[base+"/import"] = openAPIPathItem{
+		Post: &openAPIOperation{
+			Tags:        []string{className},
+			Summary:     "导入" + comment,
+			OperationID: name + "Import",
+			RequestBody: &openAPIRequestBody{Required: true, Content: map[string]openAPIMediaType{"multipart/form-data": {Schema: form}}},
+			Responses:   jsonResponse(schemaRef("ExcelImportResult")),
+		},
+	}
+}
+
+// addExcelSchemas 添加导入结果的数据结构
+func addExcelSchemas(schemas map[string]*openAPISchema) {
+	rowError := &openAPISchema{Type: "object", Description: "行级错误", Properties: &yaml.Node{Kind: yaml.MappingNode}}
+	rowError.addProperty("row", &openAPISchema{Type: "integer", Format: "int32", Description: "行号"})
+	rowError.addProperty("column", &openAPISchema{Type: "string", Description: "出错的列的表头"})
+	rowError.addProperty("message", &openAPISchema{Type: "string", Description: "错误信息"})
+	schemas["ExcelRowError"] = rowError
+
+	result := &openAPISchema{Type: "object", Description: "导入结果，存在错误时不导入任何数据", Properties: &yaml.Node{Kind: yaml.MappingNode}}
+	result.addProperty("count", &openAPISchema{Type: "integer", Format: "int32", Description: "导入的行数"})
+	result.addProperty("errors", &openAPISchema{Type: "array", Items: schemaRef("ExcelRowError"), Description: "行级错误"})
+	result.addProperty("success", &openAPISchema{Type: "boolean", Description: "是否导入成功"})
+	schemas["ExcelImportResult"] = result
+}
+
+// dateExample 按日期格式生成示例值，如 yyyy-MM-dd 生成 2024-01-01
+var dateExample = strings.NewReplacer("yyyy", "2024", "MM", "01", "dd", "01", "HH", "12", "mm", "00", "ss", "00")
+
+// fieldSchema 根据字段的Java类型生成数据结构，日期按 @JsonFormat 的格式传递
+func fieldSchema(field Field) *openAPISchema {
+	schema := &openAPISchema{Description: field.ColumnComment, Enum: field.EnumValues, DictType: field.DictType}
+	switch field.JavaType {
+	case "Long":
+		schema.Type, schema.Format = "integer", "int64"
+	case "Integer":
+		schema.Type, schema.Format = "integer", "int32"
+	case "Boolean":
+		schema.Type = "boolean"
+	case "BigDecimal":
+		schema.Type = "number"
+	case "Float":
+		schema.Type, schema.Format = "number", "float"
+	case "Double":
+		schema.Type, schema.Format = "number", "double"
+	case "Date", "LocalDateTime", "LocalDate", "LocalTime":
+		schema.Type, schema.Example = "string", dateExample.Replace(field.DatePattern())
+	case "byte[]":
+		schema.Type, schema.Format = "string", "byte"
+	default:
+		schema.Type = "string"
+		schema.MaxLength = field.Length
+		if strings.Contains(strings.ToLower(field.ColumnName), "email") {
+			schema.Format = "email"
+		}
+	}
+	return schema
+}
+
+// objectSchema 生成对象结构，required 为空时不标记必填字段；不为空时为请求参数，同时标记可编辑字段的表单控件
+func objectSchema(description string, fields []Field, required func(Field) bool) *openAPISchema {
+	schema := &openAPISchema{
+		Type:        "object",
+		Description: description,
+		Properties:  &yaml.Node{Kind: yaml.MappingNode},
+	}
+	for _, f := range fields {
+		property := fieldSchema(f)
+		if required != nil && f.IsEdit() {
+			property.HtmlType = f.HtmlType
+		}
+		schema.addProperty(f.FieldName, property)
+		if required != nil && required(f) {
+			schema.Required = append(schema.Required, f.FieldName)
+		}
+	}
+	return schema
+}
+
+// pageSchema 生成 MyBatis-Plus IPage 的分页结构
+func pageSchema(comment string, item *openAPISchema) *openAPISchema {
+	fields := []struct {
+		name, description string
+		schema            *openAPISchema
+	}{
+		{"records", "当前页数据", &openAPISchema{Type: "array", Items: item}},
+		{"total", "总条数", &openAPISchema{Type: "integer", Format: "int64"}},
+		{"size", "每页条数", &openAPISchema{Type: "integer", Format: "int64"}},
+		{"current", "当前页", &openAPISchema{Type: "integer", Format: "int64"}},
+		{"pages", "总页数", &openAPISchema{Type: "integer", Format: "int64"}},
+	}
+	schema := &openAPISchema{
+		Type:        "object",
+		Description: comment + "分页结果",
+		Properties:  &yaml.Node{Kind: yaml.MappingNode},
+	}
+	for _, f := range fields {
+		f.schema.Description = f.description
+		schema.addProperty(f.name, f.schema)
+	}
+	return schema
+}
+
+// addProperty 按顺序添加对象属性
+func (s *openAPISchema) addProperty(name string, property *openAPISchema) {
+	var value yaml.Node
+	if err := value.Encode(property); err != nil {
+		return
+	}
+	s.Properties.Content = append(s.Properties.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: name}, &value)
+}
+
+// queryParameters 根据查询字段生成查询参数，与 {Class}Query 的属性一致
+func queryParameters(table Table) []openAPIParameter {
+	var params []openAPIParameter
+	for _, f := range table.QueryFields() {
+		schema := fieldSchema(f)
+		schema.Description = ""
+		switch f.QueryType {
+		case QueryBetween:
+			params = append(params,
+				openAPIParameter{Name: "begin" + upperFirst(f.FieldName), In: "query", Description: f.ColumnComment + "起始", Schema: schema},
+				openAPIParameter{Name: "end" + upperFirst(f.FieldName), In: "query", Description: f.ColumnComment + "截止", Schema: schema})
+		case QueryIn:
+			params = append(params, openAPIParameter{Name: f.FieldName + "List", In: "query", Description: f.ColumnComment + "列表",
+				Schema: &openAPISchema{Type: "array", Items: schema}})
+		default:
+			params = append(params, openAPIParameter{Name: f.FieldName, In: "query", Description: f.ColumnComment, Schema: schema})
+		}
+	}
+	return params
+}
+
+// schemaRef 引用 components 中的数据结构
+func schemaRef(name string) *openAPISchema {
+	return &openAPISchema{Ref: "#/components/schemas/" + name}
+}
+
+// jsonBody 生成 JSON 请求体
+func jsonBody(schema *openAPISchema) *openAPIRequestBody {
+	return &openAPIRequestBody{Required: true, Content: map[string]openAPIMediaType{"application/json": {Schema: schema}}}
+}
+
+// jsonResponse 生成 200 JSON 响应
+func jsonResponse(schema *openAPISchema) map[string]openAPIResponse {
+	return map[string]openAPIResponse{
+		"200": {Description: "OK", Content: map[string]openAPIMediaType{"application/json": {Schema: schema}}},
+	}
+}
+
+// upperFirst 首字母大写
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// lowerFirst 首字母小写
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
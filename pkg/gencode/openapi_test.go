@@ -0,0 +1,170 @@
+package gencode
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+const openAPIDDL = `CREATE TABLE sys_user (
+  id bigint PRIMARY KEY COMMENT '主键',
+  user_name varchar(32) NOT NULL COMMENT '用户名',
+  email varchar(64) COMMENT '邮箱',
+  password varchar(64) NOT NULL COMMENT '密码',
+  status enum('on','off') NOT NULL COMMENT '状态',
+  create_time datetime COMMENT '创建时间',
+  version int NOT NULL DEFAULT 0
+) COMMENT='用户'`
+
+func TestOpenAPISpec(t *testing.T) {
+	tables, err := ParseDDL(openAPIDDL)
+	if err != nil {
+		t.Fatalf("解析DDL失败: %v", err)
+	}
+	for i := range tables {
+		tables[i] = Conventions{}.Apply(tables[i])
+	}
+	status := &tables[0].Fields[4]
+	status.HtmlType, status.DictType = HtmlSelect, "user_status"
+
+	content, err := OpenAPISpec(testConfig(""), tables)
+	if err != nil {
+		t.Fatalf("生成OpenAPI文档失败: %v", err)
+	}
+	var doc struct {
+		OpenAPI string `yaml:"openapi"`
+		Paths   map[string]map[string]struct {
+			OperationID string `yaml:"operationId"`
+			Parameters  []struct {
+				Name string `yaml:"name"`
+				In   string `yaml:"in"`
+			} `yaml:"parameters"`
+		} `yaml:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Required   []string                  `yaml:"required"`
+				ListFields []string                  `yaml:"x-list-fields"`
+				Properties map[string]map[string]any `yaml:"properties"`
+			} `yaml:"schemas"`
+		} `yaml:"components"`
+	}
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		t.Fatalf("解析OpenAPI文档失败: %v\n%s", err, content)
+	}
+	if doc.OpenAPI != "3.0.3" {
+		t.Errorf("openapi = %s", doc.OpenAPI)
+	}
+
+	operations := map[string]string{
+		"/sys_user/list get":    "sysUserList",
+		"/sys_user/page get":    "sysUserPage",
+		"/sys_user/{id} get":    "sysUserGet",
+		"/sys_user/{id} delete": "sysUserDelete",
+		"/sys_user post":        "sysUserCreate",
+		"/sys_user put":         "sysUserUpdate",
+	}
+	for key, operationID := range operations {
+		path, method, _ := strings.Cut(key, " ")
+		if op := doc.Paths[path][method]; op.OperationID != operationID {
+			t.Errorf("%s operationId = %s, expected %s", key, op.OperationID, operationID)
+		}
+	}
+
+	var params []string
+	for _, p := range doc.Paths["/sys_user/page"]["get"].Parameters {
+		params = append(params, p.In+":"+p.Name)
+	}
+	expectedParams := []string{"query:current", "query:size", "query:userName", "query:email", "query:status", "query:beginCreateTime", "query:endCreateTime"}
+	if !reflect.DeepEqual(params, expectedParams) {
+		t.Errorf("分页参数 = %v, expected %v", params, expectedParams)
+	}
+
+	schemas := doc.Components.Schemas
+	if required := schemas["SysUserCreateDTO"].Required; !reflect.DeepEqual(required, []string{"userName", "password", "status"}) {
+		t.Errorf("新增参数必填字段 = %v", required)
+	}
+	if required := schemas["SysUserUpdateDTO"].Required; !reflect.DeepEqual(required, []string{"id", "userName", "status"}) {
+		t.Errorf("修改参数必填字段 = %v", required)
+	}
+	if _, ok := schemas["SysUserUpdateDTO"].Properties["version"]; !ok {
+		t.Errorf("修改参数缺少乐观锁版本字段")
+	}
+	if _, ok := schemas["SysUserVO"].Properties["password"]; ok {
+		t.Errorf("视图不应包含敏感字段")
+	}
+	email := schemas["SysUserCreateDTO"].Properties["email"]
+	if email["format"] != "email" || email["maxLength"] != 64 {
+		t.Errorf("邮箱字段 = %v", email)
+	}
+	if status := schemas["SysUserVO"].Properties["status"]; !reflect.DeepEqual(status["enum"], []any{"on", "off"}) {
+		t.Errorf("枚举字段 = %v", status)
+	}
+	// 字段的列表、表单控件和字典设置以扩展属性输出
+	if list := schemas["SysUserVO"].ListFields; !reflect.DeepEqual(list, []string{"id", "userName", "email", "status", "createTime"}) {
+		t.Errorf("列表字段 = %v", list)
+	}
+	if status := schemas["SysUserCreateDTO"].Properties["status"]; status["x-html-type"] != HtmlSelect || status["x-dict-type"] != "user_status" {
+		t.Errorf("表单字段 = %v", status)
+	}
+	if id := schemas["SysUserUpdateDTO"].Properties["id"]; id["x-html-type"] != nil {
+		t.Errorf("主键不应标记表单控件: %v", id)
+	}
+	if _, ok := schemas["SysUserPage"].Properties["records"]; !ok {
+		t.Errorf("缺少分页结构")
+	}
+}
+
+func TestGenerateSwagger(t *testing.T) {
+	tables, err := ParseDDL(openAPIDDL)
+	if err != nil {
+		t.Fatalf("解析DDL失败: %v", err)
+	}
+
+	testCases := []struct {
+		enableSwagger bool
+		file          string
+		contains      []string
+	}{
+		{true, "src/main/java/com/example/controller/SysUserController.java", []string{
+			`@Tag(name = "SysUser", description = "用户")`,
+			`@Operation(summary = "查询用户分页列表")`,
+			`page(@Parameter(hidden = true) Page<SysUser> page, @ParameterObject SysUserQuery query)`,
+			`getInfo(@Parameter(description = "主键") @PathVariable("id") Long id)`,
+		}},
+		{true, "src/main/java/com/example/dto/SysUserCreateDTO.java", []string{
+			"@Data\n@Schema(description = \"用户新增参数\")\n",
+			"    @Schema(description = \"用户名\")\n    @NotBlank",
+		}},
+		{true, "pom.xml", []string{"<artifactId>springdoc-openapi-ui</artifactId>"}},
+		{true, "openapi.yaml", []string{"operationId: sysUserCreate"}},
+		{false, "openapi.yaml", []string{"operationId: sysUserCreate"}},
+	}
+	for _, tc := range testCases {
+		outputPath := t.TempDir()
+		config := testConfig(outputPath)
+		config.GenConfig.EnableSwagger = tc.enableSwagger
+		if err := NewGenerator(config, tables).GenerateCode(); err != nil {
+			t.Fatalf("生成代码失败: %v", err)
+		}
+		content, err := os.ReadFile(filepath.Join(outputPath, tc.file))
+		if err != nil {
+			t.Fatalf("读取文件失败: %v", err)
+		}
+		for _, e := range tc.contains {
+			if !strings.Contains(string(content), e) {
+				t.Errorf("%s 缺少: %s\n%s", tc.file, e, content)
+			}
+		}
+		if !tc.enableSwagger {
+			controller, _ := os.ReadFile(filepath.Join(outputPath, "src/main/java/com/example/controller/SysUserController.java"))
+			pom, _ := os.ReadFile(filepath.Join(outputPath, "pom.xml"))
+			if strings.Contains(string(controller), "io.swagger") || strings.Contains(string(pom), "springdoc") {
+				t.Errorf("未启用 Swagger 时不应生成 springdoc 注解和依赖")
+			}
+		}
+	}
+}
@@ -0,0 +1,624 @@
+package gencode
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ParseDDL 解析 MySQL 建表语句，返回表信息
+func ParseDDL(ddl string) ([]Table, error) {
+	var tables []Table
+	for _, stmt := range splitStatements(ddl) {
+		tokens := tokenize(stmt)
+		if len(tokens) < 2 || !strings.EqualFold(tokens[0], "CREATE") {
+			continue
+		}
+		// 跳过 CREATE TEMPORARY TABLE 之外的非建表语句
+		i := 1
+		if strings.EqualFold(tokens[i], "TEMPORARY") {
+			i++
+		}
+		if i >= len(tokens) || !strings.EqualFold(tokens[i], "TABLE") {
+			continue
+		}
+		table, err := parseCreateTable(tokens[i+1:])
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	if len(tables) == 0 {
+		return nil, fmt.Errorf("未找到建表语句")
+	}
+	return tables, nil
+}
+
+// parseCreateTable 解析 CREATE TABLE 之后的内容
+func parseCreateTable(tokens []string) (Table, error) {
+	if len(tokens) >= 3 && strings.EqualFold(tokens[0], "IF") && strings.EqualFold(tokens[1], "NOT") && strings.EqualFold(tokens[2], "EXISTS") {
+		tokens = tokens[3:]
+	}
+	if len(tokens) < 2 {
+		return Table{}, fmt.Errorf("建表语句不完整")
+	}
+
+	// 表名可能带库名前缀，如 db.table
+	table := Table{TableName: unquoteIdent(tokens[0])}
+	tokens = tokens[1:]
+	for len(tokens) >= 2 && tokens[0] == "." {
+		table.TableName = unquoteIdent(tokens[1])
+		tokens = tokens[2:]
+	}
+	if len(tokens) == 0 || tokens[0] != "(" {
+		return Table{}, fmt.Errorf("表 %s 缺少字段定义", table.TableName)
+	}
+
+	// 找到字段定义的结束括号
+	depth, end := 0, -1
+	for i, tok := range tokens {
+		if tok == "(" {
+			depth++
+		} else if tok == ")" {
+			depth--
+			if depth == 0 {
+				end = i
+				break
+			}
+		}
+	}
+	if end < 0 {
+		return Table{}, fmt.Errorf("表 %s 的字段定义括号不匹配", table.TableName)
+	}
+
+	var primaryKeys []string
+	for _, def := range splitDefinitions(tokens[1:end]) {
+		if len(def) == 0 {
+			continue
+		}
+		switch strings.ToUpper(def[0]) {
+		case "PRIMARY":
+			primaryKeys = append(primaryKeys, parseKeyColumns(def)...)
+		case "KEY", "INDEX", "UNIQUE":
+			table.Indexes = append(table.Indexes, parseIndex(def))
+		case "CONSTRAINT":
+			// CONSTRAINT [name] UNIQUE/PRIMARY/FOREIGN KEY (...)
+			i := 1
+			if i < len(def) && !isKeyword(def[i], "UNIQUE", "PRIMARY", "FOREIGN", "CHECK") {
+				i++
+			}
+			if i < len(def) && strings.EqualFold(def[i], "PRIMARY") {
+				primaryKeys = append(primaryKeys, parseKeyColumns(def)...)
+			} else if i < len(def) && strings.EqualFold(def[i], "UNIQUE") {
+				index := parseIndex(def[i:])
+				if index.Name == "" && i > 1 {
+					index.Name = unquoteIdent(def[1])
+				}
+				table.Indexes = append(table.Indexes, index)
+			} else if i < len(def) && strings.EqualFold(def[i], "FOREIGN") {
+				fk := parseForeignKey(def[i:])
+				if fk.Name == "" && i > 1 {
+					fk.Name = unquoteIdent(def[1])
+				}
+				table.ForeignKeys = append(table.ForeignKeys, fk)
+			}
+		case "FOREIGN":
+			table.ForeignKeys = append(table.ForeignKeys, parseForeignKey(def))
+		case "FULLTEXT", "SPATIAL", "CHECK":
+			// 全文索引与检查约束暂不参与代码生成
+		default:
+			field, err := parseColumn(def)
+			if err != nil {
+				return Table{}, fmt.Errorf("表 %s: %v", table.TableName, err)
+			}
+			table.Fields = append(table.Fields, field)
+		}
+	}
+
+	// 表选项，如 ENGINE=InnoDB COMMENT='用户表'
+	options := tokens[end+1:]
+	for i := 0; i < len(options); i++ {
+		if strings.EqualFold(options[i], "COMMENT") {
+			j := i + 1
+			if j < len(options) && options[j] == "=" {
+				j++
+			}
+			if j < len(options) {
+				table.TableComment = unquoteString(options[j])
+			}
+			break
+		}
+	}
+
+	for i := range table.Fields {
+		for _, pk := range primaryKeys {
+			if strings.EqualFold(table.Fields[i].ColumnName, pk) {
+				table.Fields[i].IsPrimaryKey = true
+				table.Fields[i].IsNullable = false
+			}
+		}
+		// 敏感字段不作为查询条件，其余非主键字段按类型设置默认查询方式
+		table.Fields[i].Sensitive = isSensitive(table.Fields[i].ColumnName)
+		if !table.Fields[i].IsPrimaryKey && !table.Fields[i].Sensitive {
+			table.Fields[i].QueryType = queryTypeOf(table.Fields[i])
+		}
+	}
+	table = CompleteTable(table)
+	if table.PrimaryKey.ColumnName == "" {
+		return Table{}, fmt.Errorf("表 %s 缺少主键", table.TableName)
+	}
+
+	return table, nil
+}
+
+// parseColumn 解析字段定义
+func parseColumn(def []string) (Field, error) {
+	if len(def) < 2 {
+		return Field{}, fmt.Errorf("字段定义不完整: %s", strings.Join(def, " "))
+	}
+
+	field := Field{
+		ColumnName: unquoteIdent(def[0]),
+		ColumnType: strings.ToLower(def[1]),
+		IsNullable: true,
+	}
+
+	rest := def[2:]
+	// 跳过类型参数，如 varchar(64)、decimal(10,2)，参数作为字段长度和小数位数
+	// enum('a','b') 与 set(...) 的参数作为可选值
+	if len(rest) > 0 && rest[0] == "(" {
+		if len(rest) > 1 {
+			field.Length, _ = strconv.Atoi(rest[1])
+		}
+		if len(rest) > 3 && rest[2] == "," {
+			field.Scale, _ = strconv.Atoi(rest[3])
+		}
+		for len(rest) > 0 && rest[0] != ")" {
+			if (field.ColumnType == "enum" || field.ColumnType == "set") && strings.HasPrefix(rest[0], "'") {
+				field.EnumValues = append(field.EnumValues, unquoteString(rest[0]))
+			}
+			rest = rest[1:]
+		}
+		if len(rest) > 0 {
+			rest = rest[1:]
+		}
+	}
+
+	for i := 0; i < len(rest); i++ {
+		switch strings.ToUpper(rest[i]) {
+		case "NOT":
+			if i+1 < len(rest) && strings.EqualFold(rest[i+1], "NULL") {
+				field.IsNullable = false
+				i++
+			}
+		case "PRIMARY":
+			field.IsPrimaryKey = true
+			field.IsNullable = false
+		case "AUTO_INCREMENT", "AUTOINCREMENT":
+			field.AutoIncrement = true
+		case "DEFAULT":
+			if i+1 < len(rest) {
+				field.DefaultValue, i = parseDefault(rest, i+1)
+			}
+		case "COMMENT":
+			if i+1 < len(rest) {
+				field.ColumnComment = unquoteString(rest[i+1])
+				i++
+			}
+		}
+	}
+
+	return field, nil
+}
+
+// parseDefault 解析从 start 开始的默认值表达式，如 0、'abc'、CURRENT_TIMESTAMP(3)、(uuid())
+// 返回表达式原文和最后一个词法单元的位置
+func parseDefault(tokens []string, start int) (string, int) {
+	end := start
+	if end+1 < len(tokens) && tokens[end+1] == "(" {
+		// 函数调用，如 CURRENT_TIMESTAMP(3)
+		end++
+	}
+	if tokens[end] == "(" {
+		depth := 0
+		for ; end < len(tokens); end++ {
+			if tokens[end] == "(" {
+				depth++
+			} else if tokens[end] == ")" {
+				depth--
+				if depth == 0 {
+					break
+				}
+			}
+		}
+		if end >= len(tokens) {
+			end = len(tokens) - 1
+		}
+	}
+	return strings.Join(tokens[start:end+1], ""), end
+}
+
+// CompleteTable 补全字段的Java类型、Go类型、字段名、表单控件以及表的主键
+func CompleteTable(table Table) Table {
+	for i := range table.Fields {
+		field := &table.Fields[i]
+		if field.FieldName == "" {
+			field.FieldName = toCamelCase(strings.ToLower(field.ColumnName))
+		}
+		if field.JavaType == "" {
+			field.JavaType = javaTypeOf(field.ColumnType)
+		}
+		if field.GoType == "" {
+			field.GoType = goTypeOf(field.ColumnType)
+		}
+		if field.HtmlType == "" {
+			field.HtmlType = htmlTypeOf(*field)
+		}
+		if field.IsPrimaryKey && table.PrimaryKey.ColumnName == "" {
+			table.PrimaryKey = *field
+		}
+	}
+	return table
+}
+
+// parseKeyColumns 解析 PRIMARY KEY (a, b) 中的字段名
+func parseKeyColumns(def []string) []string {
+	var columns []string
+	inParen := false
+	for _, tok := range def {
+		switch tok {
+		case "(":
+			inParen = true
+		case ")":
+			return columns
+		case ",":
+		default:
+			if inParen {
+				columns = append(columns, unquoteIdent(tok))
+			}
+		}
+	}
+	return columns
+}
+
+// parseIndex 解析索引定义，如 UNIQUE KEY uk_name (a, b)、INDEX idx_name (a(10) DESC)
+func parseIndex(def []string) Index {
+	var index Index
+	i := 0
+	if strings.EqualFold(def[i], "UNIQUE") {
+		index.Unique = true
+		i++
+	}
+	if i < len(def) && isKeyword(def[i], "KEY", "INDEX") {
+		i++
+	}
+	if i < len(def) && def[i] != "(" {
+		index.Name = unquoteIdent(def[i])
+		i++
+	}
+	// 只取括号内每项的第一个标识符，忽略前缀长度与排序方向
+	depth, first := 0, true
+	for ; i < len(def); i++ {
+		switch def[i] {
+		case "(":
+			depth++
+		case ")":
+			depth--
+			if depth == 0 {
+				return index
+			}
+		case ",":
+			if depth == 1 {
+				first = true
+			}
+		default:
+			if depth == 1 && first {
+				index.Columns = append(index.Columns, unquoteIdent(def[i]))
+				first = false
+			}
+		}
+	}
+	return index
+}
+
+// parseForeignKey 解析外键定义，如 FOREIGN KEY fk_dept (dept_id) REFERENCES sys_dept (id)
+func parseForeignKey(def []string) ForeignKey {
+	var fk ForeignKey
+	i := 1
+	if i < len(def) && strings.EqualFold(def[i], "KEY") {
+		i++
+	}
+	if i < len(def) && def[i] != "(" {
+		fk.Name = unquoteIdent(def[i])
+		i++
+	}
+	fk.Columns = parseKeyColumns(def[i:])
+	for i < len(def) && !strings.EqualFold(def[i], "REFERENCES") {
+		i++
+	}
+	// 被引用表名可能带库名前缀，如 db.table
+	if i+1 < len(def) {
+		i++
+		fk.RefTable = unquoteIdent(def[i])
+		i++
+		for i+1 < len(def) && def[i] == "." {
+			fk.RefTable = unquoteIdent(def[i+1])
+			i += 2
+		}
+		fk.RefColumns = parseKeyColumns(def[i:])
+	}
+	return fk
+}
+
+// isKeyword 判断标记是否为指定关键字之一（不区分大小写）
+func isKeyword(token string, keywords ...string) bool {
+	for _, k := range keywords {
+		if strings.EqualFold(token, k) {
+			return true
+		}
+	}
+	return false
+}
+
+// javaTypeOf 数据库类型转Java类型
+func javaTypeOf(columnType string) string {
+	switch strings.ToLower(columnType) {
+	case "bigint":
+		return "Long"
+	case "int", "integer", "mediumint", "smallint", "tinyint", "year":
+		return "Integer"
+	case "bit", "bool", "boolean":
+		return "Boolean"
+	case "decimal", "numeric":
+		return "BigDecimal"
+	case "float":
+		return "Float"
+	case "double", "real":
+		return "Double"
+	case "date", "datetime", "timestamp", "time":
+		return "Date"
+	case "blob", "tinyblob", "mediumblob", "longblob", "binary", "varbinary":
+		return "byte[]"
+	default:
+		return "String"
+	}
+}
+
+// goTypeOf 数据库类型转Go类型
+func goTypeOf(columnType string) string {
+	switch strings.ToLower(columnType) {
+	case "bigint":
+		return "int64"
+	case "int", "integer", "mediumint", "year":
+		return "int32"
+	case "smallint":
+		return "int16"
+	case "tinyint":
+		return "int8"
+	case "bit", "bool", "boolean":
+		return "bool"
+	case "decimal", "numeric", "double", "real":
+		return "float64"
+	case "float":
+		return "float32"
+	case "date", "datetime", "timestamp", "time":
+		return "time.Time"
+	case "blob", "tinyblob", "mediumblob", "longblob", "binary", "varbinary":
+		return "[]byte"
+	default:
+		return "string"
+	}
+}
+
+// splitStatements 按分号拆分SQL语句（忽略引号内的分号，与 stripComments 一样处理反斜杠转义）
+func splitStatements(sql string) []string {
+	var stmts []string
+	var current strings.Builder
+	runes := []rune(stripComments(sql))
+	var quote rune
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quote != 0:
+			if r == '\\' && i+1 < len(runes) {
+				current.WriteRune(r)
+				i++
+				r = runes[i]
+			} else if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+		case r == ';':
+			stmts = append(stmts, current.String())
+			current.Reset()
+			continue
+		}
+		current.WriteRune(r)
+	}
+	if strings.TrimSpace(current.String()) != "" {
+		stmts = append(stmts, current.String())
+	}
+	return stmts
+}
+
+// stripComments 移除 -- 、# 和 /* */ 注释
+func stripComments(sql string) string {
+	var result strings.Builder
+	runes := []rune(sql)
+	var quote rune
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if quote != 0 {
+			result.WriteRune(r)
+			if r == '\\' && i+1 < len(runes) {
+				i++
+				result.WriteRune(runes[i])
+			} else if r == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch {
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+		case r == '#' || (r == '-' && i+1 < len(runes) && runes[i+1] == '-'):
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			i += 2
+			for i+1 < len(runes) && !(runes[i] == '*' && runes[i+1] == '/') {
+				i++
+			}
+			i++
+			continue
+		}
+		if i < len(runes) {
+			result.WriteRune(runes[i])
+		}
+	}
+	return result.String()
+}
+
+// tokenize 将SQL语句拆分为词法单元，引号内容保持为一个单元
+func tokenize(stmt string) []string {
+	var tokens []string
+	runes := []rune(stmt)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')' || r == ',' || r == '=' || r == '.':
+			tokens = append(tokens, string(r))
+			i++
+		case r == '\'' || r == '"' || r == '`':
+			j := quoteEnd(runes, i)
+			tokens = append(tokens, string(runes[i:j+1]))
+			i = j + 1
+		default:
+			j := i
+			for j < len(runes) && !unicode.IsSpace(runes[j]) && !strings.ContainsRune("(),=.'\"`", runes[j]) {
+				j++
+			}
+			// 保留数值中的小数点，如 DEFAULT 0.00
+			for j+1 < len(runes) && runes[j] == '.' && unicode.IsDigit(runes[j+1]) && isNumber(string(runes[i:j])) {
+				j++
+				for j < len(runes) && unicode.IsDigit(runes[j]) {
+					j++
+				}
+			}
+			// 带前缀的字面量与引号部分作为一个标记，如 b'0'、x'FF'、_utf8mb4'abc'
+			if j < len(runes) && runes[j] == '\'' && isLiteralPrefix(string(runes[i:j])) {
+				j = quoteEnd(runes, j) + 1
+			}
+			tokens = append(tokens, string(runes[i:j]))
+			i = j
+		}
+	}
+	return tokens
+}
+
+// quoteEnd 获取从 start 开始的引号字符串的结束引号位置，支持反斜杠和连续两个引号转义
+func quoteEnd(runes []rune, start int) int {
+	quote := runes[start]
+	j := start + 1
+	for j < len(runes) {
+		if runes[j] == '\\' {
+			j += 2
+			continue
+		}
+		if runes[j] == quote {
+			if j+1 < len(runes) && runes[j+1] == quote {
+				j += 2
+				continue
+			}
+			break
+		}
+		j++
+	}
+	if j >= len(runes) {
+		j = len(runes) - 1
+	}
+	return j
+}
+
+// isLiteralPrefix 判断是否为字面量前缀：位串 b、十六进制 x、国家字符集 N 或字符集引导符如 _utf8mb4
+func isLiteralPrefix(word string) bool {
+	return isKeyword(word, "b", "x", "n") || (len(word) > 1 && word[0] == '_')
+}
+
+// splitDefinitions 按顶层逗号拆分字段与索引定义
+func splitDefinitions(tokens []string) [][]string {
+	var defs [][]string
+	var current []string
+	depth := 0
+	for _, tok := range tokens {
+		switch tok {
+		case "(":
+			depth++
+		case ")":
+			depth--
+		case ",":
+			if depth == 0 {
+				defs = append(defs, current)
+				current = nil
+				continue
+			}
+		}
+		current = append(current, tok)
+	}
+	if len(current) > 0 {
+		defs = append(defs, current)
+	}
+	return defs
+}
+
+// unquoteIdent 去除标识符两侧的反引号或双引号
+func unquoteIdent(s string) string {
+	if len(s) >= 2 && (s[0] == '`' || s[0] == '"') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// unquoteString 去除字符串字面量两侧的引号并处理转义
+// 自左向右单遍处理反斜杠转义和连续两个引号，避免逐个替换时相互影响
+func unquoteString(s string) string {
+	if len(s) < 2 || (s[0] != '\'' && s[0] != '"') || s[len(s)-1] != s[0] {
+		return s
+	}
+	quote := s[0]
+	s = s[1 : len(s)-1]
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && strings.IndexByte(`\'"`, s[i+1]) >= 0:
+			// \\、\'、\" 还原为字符本身，\n 等其余转义原样保留，避免注释中出现换行
+			i++
+			b.WriteByte(s[i])
+		case c == quote && i+1 < len(s) && s[i+1] == quote:
+			i++
+			b.WriteByte(quote)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// isNumber 判断是否为整数字面量
+func isNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range strings.TrimPrefix(s, "-") {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
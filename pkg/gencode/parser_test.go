@@ -0,0 +1,183 @@
+package gencode
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDDL(t *testing.T) {
+	ddl := `
+-- 用户表
+CREATE TABLE IF NOT EXISTS ` + "`demo`.`user_info`" + ` (
+  ` + "`id`" + ` bigint NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+  ` + "`user_name`" + ` varchar(64) NOT NULL DEFAULT '' COMMENT '用户名',
+  ` + "`balance`" + ` decimal(10,2) DEFAULT 0.00 COMMENT '余额; 单位：元',
+  ` + "`created_time`" + ` datetime DEFAULT CURRENT_TIMESTAMP COMMENT 'it''s time',
+  PRIMARY KEY (` + "`id`" + `),
+  UNIQUE KEY ` + "`uk_user_name`" + ` (` + "`user_name`" + `)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户信息';
+
+/* 产品表 */
+create table product (id int primary key, name text);
+`
+	tables, err := ParseDDL(ddl)
+	if err != nil {
+		t.Fatalf("解析DDL失败: %v", err)
+	}
+	if len(tables) != 2 {
+		t.Fatalf("表数量 = %d, expected 2", len(tables))
+	}
+
+	user := tables[0]
+	if user.TableName != "user_info" || user.TableComment != "用户信息" {
+		t.Errorf("表信息不正确: %s %s", user.TableName, user.TableComment)
+	}
+	if len(user.Fields) != 4 {
+		t.Fatalf("字段数量 = %d, expected 4", len(user.Fields))
+	}
+	if user.PrimaryKey.ColumnName != "id" || user.PrimaryKey.JavaType != "Long" {
+		t.Errorf("主键不正确: %+v", user.PrimaryKey)
+	}
+
+	testCases := []struct {
+		field    Field
+		expected Field
+	}{
+		{user.Fields[1], Field{ColumnName: "user_name", ColumnType: "varchar", ColumnComment: "用户名", JavaType: "String", GoType: "string", FieldName: "userName", Length: 64, DefaultValue: "''", HtmlType: HtmlInput, QueryType: QueryLike}},
+		{user.Fields[2], Field{ColumnName: "balance", ColumnType: "decimal", ColumnComment: "余额; 单位：元", IsNullable: true, JavaType: "BigDecimal", GoType: "float64", FieldName: "balance", Length: 10, Scale: 2, DefaultValue: "0.00", HtmlType: HtmlNumber, QueryType: QueryBetween}},
+		{user.Fields[3], Field{ColumnName: "created_time", ColumnType: "datetime", ColumnComment: "it's time", IsNullable: true, JavaType: "Date", GoType: "time.Time", FieldName: "createdTime", DefaultValue: "CURRENT_TIMESTAMP", HtmlType: HtmlDatetime, QueryType: QueryBetween}},
+	}
+	for _, tc := range testCases {
+		if !reflect.DeepEqual(tc.field, tc.expected) {
+			t.Errorf("字段解析结果 = %+v, expected %+v", tc.field, tc.expected)
+		}
+	}
+
+	if len(user.Indexes) != 1 || user.Indexes[0].Name != "uk_user_name" || !user.Indexes[0].Unique || user.Indexes[0].Columns[0] != "user_name" {
+		t.Errorf("索引解析不正确: %+v", user.Indexes)
+	}
+
+	product := tables[1]
+	if product.PrimaryKey.ColumnName != "id" || product.PrimaryKey.GoType != "int32" {
+		t.Errorf("内联主键解析不正确: %+v", product.PrimaryKey)
+	}
+
+	order, err := ParseDDL(`CREATE TABLE t_order (
+  id bigint PRIMARY KEY,
+  user_id bigint NOT NULL,
+  status enum('new','paid','it''s done') NOT NULL DEFAULT 'new',
+  create_time datetime(3) DEFAULT CURRENT_TIMESTAMP(3),
+  uuid varchar(36) DEFAULT (uuid()),
+  FOREIGN KEY (user_id) REFERENCES demo.user_info (id) ON DELETE CASCADE,
+  CONSTRAINT fk_order_status FOREIGN KEY (status) REFERENCES t_status (code)
+)`)
+	if err != nil {
+		t.Fatalf("解析DDL失败: %v", err)
+	}
+	status := order[0].Fields[2]
+	if !reflect.DeepEqual(status.EnumValues, []string{"new", "paid", "it's done"}) || status.DefaultValue != "'new'" {
+		t.Errorf("枚举字段解析不正确: %+v", status)
+	}
+	if d := order[0].Fields[3].DefaultValue; d != "CURRENT_TIMESTAMP(3)" {
+		t.Errorf("函数默认值 = %s", d)
+	}
+	if d := order[0].Fields[4].DefaultValue; d != "(uuid())" {
+		t.Errorf("表达式默认值 = %s", d)
+	}
+	expectedKeys := []ForeignKey{
+		{Columns: []string{"user_id"}, RefTable: "user_info", RefColumns: []string{"id"}},
+		{Name: "fk_order_status", Columns: []string{"status"}, RefTable: "t_status", RefColumns: []string{"code"}},
+	}
+	if !reflect.DeepEqual(order[0].ForeignKeys, expectedKeys) {
+		t.Errorf("外键解析不正确: %+v", order[0].ForeignKeys)
+	}
+
+	if _, err := ParseDDL("CREATE TABLE t (name varchar(10))"); err == nil {
+		t.Errorf("缺少主键时应返回错误")
+	}
+}
+
+func TestParsePrefixedDefault(t *testing.T) {
+	tables, err := ParseDDL(`CREATE TABLE t_flag (
+  id bigint PRIMARY KEY,
+  enabled bit(1) NOT NULL DEFAULT b'1',
+  deleted bit(1) NOT NULL DEFAULT B'0',
+  mask int NOT NULL DEFAULT b'101',
+  name varchar(32) DEFAULT _utf8mb4'it''s',
+  title varchar(32) DEFAULT N'标题',
+  data varbinary(4) DEFAULT x'FF'
+)`)
+	if err != nil {
+		t.Fatalf("解析DDL失败: %v", err)
+	}
+	fields := tables[0].Fields
+	testCases := []struct {
+		field                   Field
+		value, postgres, sqlite string
+	}{
+		{fields[1], "b'1'", "TRUE", "TRUE"},
+		{fields[2], "B'0'", "FALSE", "FALSE"},
+		{fields[3], "b'101'", "5", "5"},
+		{fields[4], "_utf8mb4'it''s'", "'it''s'", "'it''s'"},
+		{fields[5], "N'标题'", "'标题'", "'标题'"},
+		{fields[6], "x'FF'", `'\xFF'`, "x'FF'"},
+	}
+	for _, tc := range testCases {
+		if tc.field.DefaultValue != tc.value {
+			t.Errorf("%s 默认值 = %s, expected %s", tc.field.ColumnName, tc.field.DefaultValue, tc.value)
+		}
+		if got := defaultSQL(DialectMySQL, tc.field); got != tc.value {
+			t.Errorf("%s MySQL 默认值 = %s", tc.field.ColumnName, got)
+		}
+		if got := defaultSQL(DialectPostgres, tc.field); got != tc.postgres {
+			t.Errorf("%s PostgreSQL 默认值 = %s, expected %s", tc.field.ColumnName, got, tc.postgres)
+		}
+		if got := defaultSQL(DialectSQLite, tc.field); got != tc.sqlite {
+			t.Errorf("%s SQLite 默认值 = %s, expected %s", tc.field.ColumnName, got, tc.sqlite)
+		}
+	}
+}
+
+func TestParseEscapedQuote(t *testing.T) {
+	// 引号内反斜杠转义的引号不结束字符串，其后的分号不拆分语句
+	tables, err := ParseDDL(`CREATE TABLE t_note (
+  id bigint PRIMARY KEY,
+  content varchar(64) COMMENT 'it\'s; ok'
+) COMMENT='备注\\';
+CREATE TABLE t_tag (
+  id bigint PRIMARY KEY
+);`)
+	if err != nil {
+		t.Fatalf("解析DDL失败: %v", err)
+	}
+	if len(tables) != 2 || tables[1].TableName != "t_tag" {
+		t.Fatalf("解析表 = %+v", tables)
+	}
+	if comment := tables[0].Fields[1].ColumnComment; comment != "it's; ok" {
+		t.Errorf("字段注释 = %s", comment)
+	}
+	if comment := tables[0].TableComment; comment != `备注\` {
+		t.Errorf("表注释 = %s", comment)
+	}
+}
+
+func TestUnquoteString(t *testing.T) {
+	testCases := []struct {
+		literal, expected string
+	}{
+		{`'C:\\path'`, `C:\path`},
+		{`'it\'s'`, `it's`},
+		{`'it''s'`, `it's`},
+		{`'a\\''b'`, `a\'b`},
+		{`'a\\\'b'`, `a\'b`},
+		{`"say \"hi\""`, `say "hi"`},
+		{`"it''s"`, `it''s`},
+		{`'line\nbreak'`, `line\nbreak`},
+		{`plain`, `plain`},
+	}
+	for _, tc := range testCases {
+		if got := unquoteString(tc.literal); got != tc.expected {
+			t.Errorf("unquoteString(%s) = %s, expected %s", tc.literal, got, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package gencode
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// 权限框架，仅对 java 目标生效
+const (
+	AuthSecurity = "spring-security" // Spring Security，控制器方法标注 @PreAuthorize
+	AuthSaToken  = "sa-token"        // Sa-Token，控制器方法标注 @SaCheckPermission
+)
+
+// 权限操作，与权限标识的最后一段一致
+const (
+	ActionList   = "list"   // 列表和分页查询，同时是菜单的权限标识
+	ActionQuery  = "query"  // 详情
+	ActionCreate = "create" // 新增
+	ActionUpdate = "update" // 修改
+	ActionDelete = "delete" // 删除
+	ActionExport = "export" // 导出，开启 Excel 导入导出时生成
+	ActionImport = "import" // 导入及下载导入模板，开启 Excel 导入导出且表可导入时生成
+)
+
+// 菜单类型
+const (
+	MenuTypeMenu   = "menu"   // 菜单
+	MenuTypeButton = "button" // 按钮
+)
+
+// actionNames 权限操作的按钮名
+var actionNames = map[string]string{
+	ActionQuery:  "查询",
+	ActionCreate: "新增",
+	ActionUpdate: "修改",
+	ActionDelete: "删除",
+	ActionExport: "导出",
+	ActionImport: "导入",
+}
+
+// MenuEntry 菜单或按钮，按钮是所属菜单的子节点
+type MenuEntry struct {
+	Name       string      `json:"name"`
+	Type       string      `json:"type"`                // menu/button
+	Path       string      `json:"path,omitempty"`      // 路由地址，仅菜单
+	Component  string      `json:"component,omitempty"` // 前端组件路径，仅菜单
+	Permission string      `json:"permission"`          // 权限标识，如 sys:user:list
+	Sort       int         `json:"sort"`
+	Children   []MenuEntry `json:"children,omitempty"`
+}
+
+// PermissionPrefix 表的权限标识前缀，表名中的下划线替换为冒号，如 sys_user 为 sys:user
+func (t Table) PermissionPrefix() string {
+	return strings.ReplaceAll(t.TableName, "_", ":")
+}
+
+// Permission 表的操作权限标识，如 sys:user:list
+func (t Table) Permission(action string) string {
+	return t.PermissionPrefix() + ":" + action
+}
+
+// auths 支持的权限框架
+var auths = []string{AuthSecurity, AuthSaToken}
+
+// checkAuth 校验权限框架，为空时不生成权限控制。不支持的权限框架会生成菜单但控制器没有权限校验，需直接报错
+func checkAuth(auth string) error {
+	if auth == "" || slices.Contains(auths, auth) {
+		return nil
+	}
+	return fmt.Errorf("不支持的权限框架: %s，可选值: %s", auth, strings.Join(auths, "/"))
+}
+
+// auth 获取权限框架，为空时不生成权限控制
+func (g *Generator) auth() string {
+	return g.Config.GenConfig.Auth
+}
+
+// permissionAnnotation 生成控制器方法的权限注解，未配置权限框架时为空
+func (g *Generator) permissionAnnotation(table Table, action string) string {
+	switch g.auth() {
+	case AuthSecurity:
+		return fmt.Sprintf(`@PreAuthorize("hasAuthority('%s')")`, table.Permission(action))
+	case AuthSaToken:
+		return fmt.Sprintf(`@SaCheckPermission("%s")`, table.Permission(action))
+	default:
+		return ""
+	}
+}
+
+// Menus 为每个表生成一个菜单及其操作按钮，菜单的权限标识为列表查询权限，excel 为 true 时包含导出按钮，表可导入时包含导入按钮
+func Menus(tables []Table, excel bool) []MenuEntry {
+	var menus []MenuEntry
+	for i, table := range tables {
+		actions := []string{ActionQuery, ActionCreate, ActionUpdate, ActionDelete}
+		if excel {
+			actions = append(actions, ActionExport)
+		}
+		if excel && table.CanImport() {
+			actions = append(actions, ActionImport)
+		}
+		label := tableLabel(table)
+		menu := MenuEntry{
+			Name:       label,
+			Type:       MenuTypeMenu,
+			Path:       lowerFirst(toPascalCase(table.TableName)),
+			Component:  strings.ReplaceAll(table.TableName, "_", "/") + "/index",
+			Permission: table.Permission(ActionList),
+			Sort:       i + 1,
+		}
+		for j, action := range actions {
+			menu.Children = append(menu.Children, MenuEntry{
+				Name:       label + actionNames[action],
+				Type:       MenuTypeButton,
+				Permission: table.Permission(action),
+				Sort:       j + 1,
+			})
+		}
+		menus = append(menus, menu)
+	}
+	return menus
+}
+
+// MenuSQL 生成写入若依风格 sys_menu 表的语句，菜单挂在顶级，按钮按权限标识查找所属菜单
+func MenuSQL(dialect string, menus []MenuEntry) (string, error) {
+	if err := checkDialect(dialect); err != nil {
+		return "", err
+	}
+	columns := []string{"menu_name", "parent_id", "order_num", "path", "component", "perms", "menu_type", "visible", "status", "icon"}
+	for i, column := range columns {
+		columns[i] = quoteIdent(dialect, column)
+	}
+	insert := fmt.Sprintf("INSERT INTO %s (%s)\n", quoteIdent(dialect, "sys_menu"), strings.Join(columns, ", "))
+
+	var blocks []string
+	for _, menu := range menus {
+		var b strings.Builder
+		fmt.Fprintf(&b, "-- %s\n", menu.Name)
+		b.WriteString(insert)
+		fmt.Fprintf(&b, "VALUES (%s, 0, %d, %s, %s, %s, 'C', '0', '0', '#');\n",
+			quoteString(menu.Name), menu.Sort, quoteString(menu.Path), quoteString(menu.Component), quoteString(menu.Permission))
+		for _, button := range menu.Children {
+			b.WriteString(insert)
+			fmt.Fprintf(&b, "SELECT %s, %s, %d, '', '', %s, 'F', '0', '0', '#' FROM %s WHERE %s = %s AND %s = 'C';\n",
+				quoteString(button.Name), quoteIdent(dialect, "menu_id"), button.Sort, quoteString(button.Permission),
+				quoteIdent(dialect, "sys_menu"), quoteIdent(dialect, "perms"), quoteString(menu.Permission), quoteIdent(dialect, "menu_type"))
+		}
+		blocks = append(blocks, b.String())
+	}
+	return strings.Join(blocks, "\n"), nil
+}
+
+// MenuJSON 生成菜单和按钮的 JSON，供其他控制台导入
+func MenuJSON(menus []MenuEntry) (string, error) {
+	content, err := json.MarshalIndent(menus, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(content) + "\n", nil
+}
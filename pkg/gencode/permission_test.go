@@ -0,0 +1,138 @@
+package gencode
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gen_code/pkg/gencode/gencodetest"
+)
+
+func TestMenus(t *testing.T) {
+	table := Table{TableName: "sys_user", TableComment: "用户信息表"}
+	if got := table.Permission(ActionList); got != "sys:user:list" {
+		t.Errorf("Permission = %s", got)
+	}
+
+	menus := Menus([]Table{table}, false)
+	if len(menus) != 1 || len(menus[0].Children) != 4 {
+		t.Fatalf("Menus = %+v", menus)
+	}
+	menu := menus[0]
+	if menu.Name != "用户" || menu.Path != "sysUser" || menu.Component != "sys/user/index" || menu.Permission != "sys:user:list" {
+		t.Errorf("菜单 = %+v", menu)
+	}
+	if button := menu.Children[1]; button.Name != "用户新增" || button.Type != MenuTypeButton || button.Permission != "sys:user:create" {
+		t.Errorf("按钮 = %+v", button)
+	}
+	// 开启 Excel 导入导出时增加导出和导入按钮
+	if menus := Menus([]Table{table}, true); len(menus[0].Children) != 6 || menus[0].Children[5].Permission != "sys:user:import" {
+		t.Errorf("Menus(excel) = %+v", menus)
+	}
+
+	tables := testTables()
+	for i := range tables {
+		tables[i] = Conventions{}.Apply(tables[i])
+	}
+	files := map[string][]byte{}
+	for _, dialect := range dialects {
+		sql, err := MenuSQL(dialect, Menus(tables, false))
+		if err != nil {
+			t.Fatalf("生成菜单语句失败: %v", err)
+		}
+		files[dialect+".sql"] = []byte(sql)
+	}
+	gencodetest.Golden(t, filepath.Join("testdata", "menu"), files, *update)
+
+	if _, err := MenuSQL("oracle", menus); err == nil {
+		t.Errorf("不支持的方言应返回错误")
+	}
+}
+
+func TestGeneratePermission(t *testing.T) {
+	config := testConfig(t.TempDir())
+	config.GenConfig.Auth = AuthSecurity
+	config.GenConfig.Layout = LayoutMavenMulti
+	config.GenConfig.Profile = ProfileBoot3
+	files, err := NewGenerator(config, testTables()).RenderFiles()
+	if err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+
+	web := "gentest-web/src/main/java/com/example/"
+	checks := map[string][]string{
+		web + "controller/UserController.java": {
+			"import org.springframework.security.access.prepost.PreAuthorize;",
+			`@PreAuthorize("hasAuthority('user:list')")`,
+			`@PreAuthorize("hasAuthority('user:delete')")`,
+		},
+		web + "security/MethodSecurityConfig.java": {"@EnableMethodSecurity"},
+		"gentest-web/src/test/java/com/example/controller/UserControllerTest.java": {
+			"@AutoConfigureMockMvc(addFilters = false)",
+			`@WithMockUser(authorities = {"user:list", "user:create"})`,
+		},
+		"gentest-web/pom.xml":                            {"spring-boot-starter-security", "spring-security-test"},
+		"gentest-domain/src/main/resources/db/menu.sql":  {"'user:list', 'C'", "'product:update', 'F'"},
+		"gentest-domain/src/main/resources/db/menu.json": {`"permission": "product:create"`},
+	}
+	for name, contents := range checks {
+		content, ok := files[name]
+		if !ok {
+			t.Errorf("缺少 %s", name)
+			continue
+		}
+		for _, s := range contents {
+			if !strings.Contains(string(content), s) {
+				t.Errorf("%s 缺少 %s", name, s)
+			}
+		}
+	}
+	var menus []MenuEntry
+	if err := json.Unmarshal(files["gentest-domain/src/main/resources/db/menu.json"], &menus); err != nil || len(menus) != 2 {
+		t.Errorf("解析菜单 JSON 失败: %v", err)
+	}
+
+	config.GenConfig.Auth = AuthSaToken
+	config.GenConfig.Layout = LayoutMaven
+	config.GenConfig.Profile = ProfileBoot2
+	files, err = NewGenerator(config, testTables()).RenderFiles()
+	if err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+	checks = map[string][]string{
+		"src/main/java/com/example/controller/ProductController.java": {
+			"import cn.dev33.satoken.annotation.SaCheckPermission;",
+			`@SaCheckPermission("product:query")`,
+		},
+		"src/main/java/com/example/security/SaTokenConfig.java": {`@Profile("!test")`},
+		"pom.xml": {"<artifactId>sa-token-spring-boot-starter</artifactId>", "<sa-token.version>1.37.0</sa-token.version>"},
+	}
+	for name, contents := range checks {
+		for _, s := range contents {
+			if !strings.Contains(string(files[name]), s) {
+				t.Errorf("%s 缺少 %s", name, s)
+			}
+		}
+	}
+	if _, ok := files["src/main/java/com/example/security/MethodSecurityConfig.java"]; ok {
+		t.Errorf("Sa-Token 不应生成 Spring Security 配置")
+	}
+
+	// 未配置权限框架时不生成权限控制和菜单
+	config.GenConfig.Auth = ""
+	files, err = NewGenerator(config, testTables()).RenderFiles()
+	if err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+	for name, content := range files {
+		if strings.Contains(name, "/security/") || strings.Contains(name, "/menu.") || strings.Contains(string(content), "Permission(") {
+			t.Errorf("未配置时 %s 不应包含权限控制", name)
+		}
+	}
+	// 不支持的权限框架返回错误，而不是生成没有权限校验的控制器
+	config.GenConfig.Auth = "shiro"
+	if _, err := NewGenerator(config, testTables()).RenderFiles(); err == nil || !strings.Contains(err.Error(), "spring-security/sa-token") {
+		t.Errorf("不支持的权限框架应返回错误: %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package gencode
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+)
+
+// Plugin 外部插件，与 protoc 插件类似：生成器启动子进程，向标准输入写入 JSON 编码的 PluginRequest，
+// 从标准输出读取 JSON 编码的 PluginResponse，返回的文件与模板生成的文件一同输出并记入清单
+type Plugin struct {
+	Name      string   // 插件名，记入清单并用于错误信息
+	Command   string   // 可执行文件
+	Args      []string // 命令行参数
+	Parameter string   // 原样传给插件的参数
+}
+
+// PluginRequest 插件的输入
+type PluginRequest struct {
+	GeneratorVersion string   `json:"generator_version"`
+	Parameter        string   `json:"parameter"`
+	Config           Config   `json:"config"` // 展开环境变量后的配置
+	Tables           []Table  `json:"tables"` // 按约定和钩子处理后的表结构，字段名与清单中的表结构快照相同
+	Files            []string `json:"files"`  // 模板已生成的文件，相对输出目录的路径
+}
+
+// PluginResponse 插件的输出
+type PluginResponse struct {
+	Error string       `json:"error"` // 插件处理失败的原因，不为空时中止生成
+	Files []PluginFile `json:"files"` // 额外生成的文件
+}
+
+// PluginFile 插件生成的文件
+type PluginFile struct {
+	Path    string `json:"path"` // 相对输出目录的路径
+	Content string `json:"content"`
+}
+
+// runPlugins 依次执行插件并输出插件生成的文件
+func (g *Generator) runPlugins(ctx context.Context, tables []Table) error {
+	for _, plugin := range g.Plugins {
+		request := PluginRequest{
+			GeneratorVersion: GeneratorVersion,
+			Parameter:        plugin.Parameter,
+			Config:           g.Config,
+			Tables:           tables,
+			Files:            append([]string{}, g.outputs...),
+		}
+		response, err := plugin.run(ctx, request)
+		if err != nil {
+			return fmt.Errorf("执行插件失败 [%s]: %v", plugin.Name, err)
+		}
+		for _, file := range response.Files {
+			err := g.emitFile("plugin:"+plugin.Name, "", filepath.FromSlash(file.Path), []byte(file.Content))
+			if err != nil {
+				return fmt.Errorf("输出插件文件失败 [%s]: %v", plugin.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// run 启动插件进程并交换请求与响应
+func (p Plugin) run(ctx context.Context, request PluginRequest) (*PluginResponse, error) {
+	input, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.Command, p.Args...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err = cmd.Run()
+	if err != nil {
+		return nil, fmt.Errorf("%v\n%s", err, stderr.String())
+	}
+
+	var response PluginResponse
+	err = json.Unmarshal(stdout.Bytes(), &response)
+	if err != nil {
+		return nil, fmt.Errorf("解析插件输出失败: %v", err)
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+	return &response, nil
+}
@@ -0,0 +1,134 @@
+package gencode
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Java 版本配置，仅对 java 目标生效
+const (
+	ProfileBoot2 = "boot2" // Spring Boot 2 + Java 8，使用 javax 与 java.util.Date
+	ProfileBoot3 = "boot3" // Spring Boot 3 + Java 17，使用 jakarta 与 java.time
+)
+
+// JavaProfile Java 版本配置对应的依赖版本、包名和日期类型
+type JavaProfile struct {
+	Name                        string
+	JavaVersion                 string // 如 1.8、17
+	JDK                         string // 流水线构建镜像的 JDK 版本，如 8、17
+	SpringBootVersion           string
+	DependencyManagementVersion string // Gradle io.spring.dependency-management 插件版本
+	MybatisPlusArtifact         string
+	MybatisPlusVersion          string
+	MysqlGroupID                string
+	MysqlArtifact               string
+	SpringdocArtifact           string
+	SpringdocVersion            string
+	SpringdocPackage            string // @ParameterObject 所在的包
+	SwaggerAnnotationsArtifact  string
+	SwaggerAnnotationsVersion   string
+	SaTokenArtifact             string
+	EEPackage                   string // Jakarta EE 规范的包名前缀 javax/jakarta，如校验注解
+	DateType                    string // 日期时间字段的 Java 类型
+	DateImport                  string // 日期时间类型的完整类名
+	LocalDateType               string // date 字段的 Java 类型
+	LocalTimeType               string // time 字段的 Java 类型
+	BaseImage                   string // Dockerfile 基础镜像
+}
+
+// javaProfiles 所有 Java 版本配置
+var javaProfiles = map[string]JavaProfile{
+	ProfileBoot2: {
+		Name:                        ProfileBoot2,
+		JavaVersion:                 "1.8",
+		JDK:                         "8",
+		SpringBootVersion:           "2.6.13",
+		DependencyManagementVersion: "1.0.15.RELEASE",
+		MybatisPlusArtifact:         "mybatis-plus-boot-starter",
+		MybatisPlusVersion:          "3.5.3.1",
+		MysqlGroupID:                "mysql",
+		MysqlArtifact:               "mysql-connector-java",
+		SpringdocArtifact:           "springdoc-openapi-ui",
+		SpringdocVersion:            "1.7.0",
+		SpringdocPackage:            "org.springdoc.api.annotations",
+		SwaggerAnnotationsArtifact:  "swagger-annotations",
+		SwaggerAnnotationsVersion:   "2.2.9",
+		SaTokenArtifact:             "sa-token-spring-boot-starter",
+		EEPackage:                   "javax",
+		DateType:                    "Date",
+		DateImport:                  "java.util.Date",
+		LocalDateType:               "Date",
+		LocalTimeType:               "Date",
+		BaseImage:                   "eclipse-temurin:8-jre-alpine",
+	},
+	ProfileBoot3: {
+		Name:                        ProfileBoot3,
+		JavaVersion:                 "17",
+		JDK:                         "17",
+		SpringBootVersion:           "3.2.5",
+		DependencyManagementVersion: "1.1.4",
+		MybatisPlusArtifact:         "mybatis-plus-spring-boot3-starter",
+		MybatisPlusVersion:          "3.5.5",
+		MysqlGroupID:                "com.mysql",
+		MysqlArtifact:               "mysql-connector-j",
+		SpringdocArtifact:           "springdoc-openapi-starter-webmvc-ui",
+		SpringdocVersion:            "2.5.0",
+		SpringdocPackage:            "org.springdoc.core.annotations",
+		SwaggerAnnotationsArtifact:  "swagger-annotations-jakarta",
+		SwaggerAnnotationsVersion:   "2.2.21",
+		SaTokenArtifact:             "sa-token-spring-boot3-starter",
+		EEPackage:                   "jakarta",
+		DateType:                    "LocalDateTime",
+		DateImport:                  "java.time.LocalDateTime",
+		LocalDateType:               "LocalDate",
+		LocalTimeType:               "LocalTime",
+		BaseImage:                   "eclipse-temurin:17-jre-alpine",
+	},
+}
+
+// checkProfile 校验 Java 版本配置，为空时使用 Spring Boot 2
+func checkProfile(profile string) error {
+	if _, ok := javaProfiles[profile]; ok || profile == "" {
+		return nil
+	}
+	return fmt.Errorf("不支持的 Java 版本配置: %s，可选值: %s/%s", profile, ProfileBoot2, ProfileBoot3)
+}
+
+// profile 获取 Java 版本配置，默认 Spring Boot 2
+func (g *Generator) profile() JavaProfile {
+	if profile, ok := javaProfiles[g.Config.GenConfig.Profile]; ok {
+		return profile
+	}
+	return javaProfiles[ProfileBoot2]
+}
+
+// applyProfile 按 Java 版本配置替换日期时间字段的类型，如 Spring Boot 3 将 date、time 和 datetime
+// 分别替换为 LocalDate、LocalTime 和 LocalDateTime
+func (g *Generator) applyProfile(table Table) Table {
+	profile := g.profile()
+	fields := make([]Field, len(table.Fields))
+	for i, f := range table.Fields {
+		if f.JavaType == "Date" {
+			switch strings.ToLower(f.ColumnType) {
+			case "date":
+				f.JavaType = profile.LocalDateType
+			case "time":
+				f.JavaType = profile.LocalTimeType
+			default:
+				f.JavaType = profile.DateType
+			}
+		}
+		fields[i] = f
+	}
+	table.Fields = fields
+	return table
+}
+
+// isDateTime 判断 Java 类型是否为日期时间类型
+func isDateTime(javaType string) bool {
+	switch javaType {
+	case "Date", "LocalDateTime", "LocalDate", "LocalTime":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,121 @@
+package gencode
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerateProfile(t *testing.T) {
+	tables, err := ParseDDL(`CREATE TABLE sys_user (
+  id bigint NOT NULL AUTO_INCREMENT COMMENT '主键',
+  username varchar(64) NOT NULL COMMENT '用户名',
+  login_time datetime DEFAULT NULL COMMENT '登录时间',
+  birthday date DEFAULT NULL COMMENT '生日',
+  remind_time time DEFAULT NULL COMMENT '提醒时间',
+  PRIMARY KEY (id)
+) COMMENT='用户';`)
+	if err != nil {
+		t.Fatalf("解析DDL失败: %v", err)
+	}
+
+	testCases := []struct {
+		profile  string
+		contains map[string][]string
+		excludes map[string][]string
+	}{
+		{
+			profile: "",
+			contains: map[string][]string{
+				"pom.xml":    {"<version>2.6.13</version>", "<java.version>1.8</java.version>", "<artifactId>springdoc-openapi-ui</artifactId>"},
+				"Dockerfile": {"FROM eclipse-temurin:8-jre-alpine"},
+				"src/main/java/com/example/entity/SysUser.java":               {"import java.util.Date;", "private Date loginTime;", "private Date birthday;", "private Date remindTime;"},
+				"src/main/java/com/example/vo/SysUserVO.java":                 {"@JsonFormat(pattern = \"yyyy-MM-dd\", timezone = \"GMT+8\")\n    private Date birthday;"},
+				"src/main/java/com/example/dto/SysUserCreateDTO.java":         {"import javax.validation.constraints.*;"},
+				"src/main/java/com/example/controller/SysUserController.java": {"import org.springdoc.api.annotations.ParameterObject;"},
+			},
+			excludes: map[string][]string{
+				"src/main/resources/application.yml":            {"jpa:", "MySQL8Dialect"},
+				"src/main/java/com/example/entity/SysUser.java": {"java.time."},
+			},
+		},
+		{
+			profile: ProfileBoot3,
+			contains: map[string][]string{
+				"pom.xml": {
+					"<version>3.2.5</version>",
+					"<java.version>17</java.version>",
+					"<artifactId>mybatis-plus-spring-boot3-starter</artifactId>",
+					"<groupId>com.mysql</groupId>\n            <artifactId>mysql-connector-j</artifactId>",
+					"<artifactId>springdoc-openapi-starter-webmvc-ui</artifactId>",
+				},
+				"Dockerfile": {"FROM eclipse-temurin:17-jre-alpine"},
+				"src/main/java/com/example/entity/SysUser.java": {
+					"import java.time.LocalDateTime;\nimport java.time.LocalDate;\nimport java.time.LocalTime;",
+					"private LocalDateTime loginTime;",
+					"private LocalDate birthday;",
+					"private LocalTime remindTime;",
+				},
+				"src/main/java/com/example/vo/SysUserVO.java": {
+					"@JsonFormat(pattern = \"yyyy-MM-dd HH:mm:ss\", timezone = \"GMT+8\")\n    private LocalDateTime loginTime;",
+					"@JsonFormat(pattern = \"yyyy-MM-dd\", timezone = \"GMT+8\")\n    private LocalDate birthday;",
+					"@JsonFormat(pattern = \"HH:mm:ss\", timezone = \"GMT+8\")\n    private LocalTime remindTime;",
+				},
+				// date 和 time 字段的查询参数和 Excel 列只包含日期或时间
+				"src/main/java/com/example/query/SysUserQuery.java": {
+					"@DateTimeFormat(pattern = \"yyyy-MM-dd\")\n    private LocalDate beginBirthday;",
+					"@DateTimeFormat(pattern = \"HH:mm:ss\")\n    private LocalTime endRemindTime;",
+				},
+				"src/main/java/com/example/excel/SysUserExcel.java": {
+					"@DateTimeFormat(\"yyyy-MM-dd\")\n    private LocalDate birthday;",
+					"@DateTimeFormat(\"HH:mm:ss\")\n    private LocalTime remindTime;",
+				},
+				"src/main/java/com/example/dto/SysUserCreateDTO.java":         {"import jakarta.validation.constraints.*;"},
+				"src/main/java/com/example/controller/SysUserController.java": {"import org.springdoc.core.annotations.ParameterObject;"},
+			},
+			excludes: map[string][]string{
+				"src/main/java/com/example/entity/SysUser.java":       {"java.util.Date"},
+				"src/main/java/com/example/dto/SysUserUpdateDTO.java": {"javax."},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		outputPath := t.TempDir()
+		config := testConfig(outputPath)
+		config.GenConfig.Profile = tc.profile
+		config.GenConfig.EnableSwagger = true
+		config.GenConfig.EnableExcel = true
+		if err := NewGenerator(config, tables).GenerateCode(); err != nil {
+			t.Fatalf("生成代码失败: %v", err)
+		}
+		for file, contains := range tc.contains {
+			content, err := os.ReadFile(filepath.Join(outputPath, file))
+			if err != nil {
+				t.Fatalf("读取文件失败: %v", err)
+			}
+			for _, e := range contains {
+				if !strings.Contains(string(content), e) {
+					t.Errorf("[%s] %s 缺少: %s\n%s", tc.profile, file, e, content)
+				}
+			}
+		}
+		for file, excludes := range tc.excludes {
+			content, err := os.ReadFile(filepath.Join(outputPath, file))
+			if err != nil {
+				t.Fatalf("读取文件失败: %v", err)
+			}
+			for _, e := range excludes {
+				if strings.Contains(string(content), e) {
+					t.Errorf("[%s] %s 不应包含: %s", tc.profile, file, e)
+				}
+			}
+		}
+	}
+	// 不支持的版本配置返回错误，而不是按 Spring Boot 2 生成
+	config := testConfig("")
+	config.GenConfig.Profile = "boot-3"
+	if _, err := NewGenerator(config, tables).RenderFiles(); err == nil || !strings.Contains(err.Error(), "boot2/boot3") {
+		t.Errorf("不支持的版本配置应返回错误: %v", err)
+	}
+}
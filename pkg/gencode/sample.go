@@ -0,0 +1,81 @@
+package gencode
+
+import (
+	"fmt"
+	"strings"
+)
+
+// sampleString 根据字段名和长度生成示例字符串，邮箱字段生成合法邮箱
+func sampleString(field Field) string {
+	if strings.Contains(strings.ToLower(field.ColumnName), "email") {
+		return "test@example.com"
+	}
+	value := field.FieldName
+	if field.Length > 0 && len(value) > field.Length {
+		value = value[:field.Length]
+	}
+	return value
+}
+
+// javaSample 根据字段的Java类型生成示例值的Java表达式
+func javaSample(field Field) string {
+	switch field.JavaType {
+	case "String":
+		return fmt.Sprintf("%q", sampleString(field))
+	case "Long":
+		return "1L"
+	case "Integer":
+		return "1"
+	case "Float":
+		return "1.5F"
+	case "Double":
+		return "1.5D"
+	case "BigDecimal":
+		return `new BigDecimal("1.50")`
+	case "Boolean":
+		return "Boolean.TRUE"
+	case "Date":
+		return "new Date()"
+	case "LocalDateTime", "LocalDate", "LocalTime":
+		return field.JavaType + ".now()"
+	case "byte[]":
+		return "new byte[]{1}"
+	default:
+		return "null"
+	}
+}
+
+// goSample 根据字段的Go类型生成示例值的Go表达式
+func goSample(field Field) string {
+	switch field.GoType {
+	case "string":
+		return fmt.Sprintf("%q", sampleString(field))
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		return "1"
+	case "float32", "float64":
+		return "1.5"
+	case "bool":
+		return "true"
+	case "time.Time":
+		return "time.Now()"
+	case "[]byte":
+		return "[]byte{1}"
+	default:
+		return fmt.Sprintf("*new(%s)", field.GoType)
+	}
+}
+
+// h2Type 获取字段在 H2（MySQL 兼容模式）测试库中的列类型
+func h2Type(field Field) string {
+	switch columnType := strings.ToLower(field.ColumnType); columnType {
+	case "enum", "set":
+		return "varchar(255)"
+	case "varchar", "char", "varbinary", "binary":
+		if field.Length > 0 {
+			return fmt.Sprintf("%s(%d)", columnType, field.Length)
+		}
+		return columnType + "(255)"
+	default:
+		return columnType
+	}
+}
@@ -0,0 +1,93 @@
+package gencode
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSample(t *testing.T) {
+	testCases := []struct {
+		field  Field
+		java   string
+		goExpr string
+		h2     string
+	}{
+		{Field{ColumnName: "user_name", ColumnType: "varchar", Length: 4, FieldName: "userName", JavaType: "String", GoType: "string"}, `"user"`, `"user"`, "varchar(4)"},
+		{Field{ColumnName: "email", ColumnType: "varchar", FieldName: "email", JavaType: "String", GoType: "string"}, `"test@example.com"`, `"test@example.com"`, "varchar(255)"},
+		{Field{ColumnName: "amount", ColumnType: "decimal", Length: 10, JavaType: "BigDecimal", GoType: "float64"}, `new BigDecimal("1.50")`, "1.5", "decimal"},
+		{Field{ColumnName: "status", ColumnType: "enum", JavaType: "String", GoType: "string", FieldName: "status"}, `"status"`, `"status"`, "varchar(255)"},
+		{Field{ColumnName: "birthday", ColumnType: "date", JavaType: "Date", GoType: "time.Time"}, "new Date()", "time.Now()", "date"},
+	}
+	for _, tc := range testCases {
+		if got := javaSample(tc.field); got != tc.java {
+			t.Errorf("%s Java示例值 = %s, expected %s", tc.field.ColumnName, got, tc.java)
+		}
+		if got := goSample(tc.field); got != tc.goExpr {
+			t.Errorf("%s Go示例值 = %s, expected %s", tc.field.ColumnName, got, tc.goExpr)
+		}
+		if got := h2Type(tc.field); got != tc.h2 {
+			t.Errorf("%s H2类型 = %s, expected %s", tc.field.ColumnName, got, tc.h2)
+		}
+	}
+}
+
+func TestGenerateTests(t *testing.T) {
+	tables, err := ParseDDL(`CREATE TABLE article (
+  id bigint PRIMARY KEY AUTO_INCREMENT,
+  title varchar(64) NOT NULL,
+  deleted tinyint NOT NULL,
+  publish_time datetime
+) COMMENT '文章表'`)
+	if err != nil {
+		t.Fatalf("解析DDL失败: %v", err)
+	}
+
+	outputPath := t.TempDir()
+	if err := NewGenerator(testConfig(outputPath), tables).GenerateCode(); err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+	config := testConfig(t.TempDir())
+	config.ProjectName = "example.com/demo"
+	config.GenConfig.Target = TargetKratos
+	if err := NewGenerator(config, tables).GenerateCode(); err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+
+	expectedFiles := map[string][]string{
+		filepath.Join(outputPath, "src/test/resources/schema-h2.sql"): {
+			"id bigint AUTO_INCREMENT PRIMARY KEY,",
+			"title varchar(64) NOT NULL,",
+			"deleted tinyint DEFAULT 0 NOT NULL,",
+		},
+		filepath.Join(outputPath, "src/test/java/com/example/service/ArticleServiceTest.java"): {
+			`entity.setTitle("title");`,
+			`entity.setPublishTime(new Date());`,
+			`assertEquals(entity.getTitle(), saved.getTitle());`,
+		},
+		filepath.Join(outputPath, "src/test/java/com/example/controller/ArticleControllerTest.java"): {
+			`dto.setTitle("title");`,
+			`void addInvalid() throws Exception {`,
+		},
+		filepath.Join(config.GenConfig.OutputPath, "internal/data/article_test.go"): {
+			`client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")`,
+			`Title:       "title",`,
+			`name: "zero values",`,
+		},
+	}
+	for file, expected := range expectedFiles {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("读取生成文件失败: %v", err)
+		}
+		for _, e := range expected {
+			if !strings.Contains(string(content), e) {
+				t.Errorf("%s 缺少: %s", filepath.Base(file), e)
+			}
+		}
+		if strings.Contains(string(content), "setDeleted") {
+			t.Errorf("%s: 逻辑删除字段不应设置示例值", filepath.Base(file))
+		}
+	}
+}
@@ -0,0 +1,83 @@
+@@Meta.Output="/data-dictionary.html"
+<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+  <meta charset="UTF-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1.0">
+  <title>{{html .Config.ProjectName}} 数据字典</title>
+  <style>
+    body { font-family: -apple-system, "Segoe UI", "PingFang SC", "Microsoft YaHei", sans-serif; margin: 0 auto; max-width: 1200px; padding: 24px; color: #24292f; }
+    h1 { border-bottom: 1px solid #d0d7de; padding-bottom: 8px; }
+    h2 { margin-top: 40px; }
+    .meta { color: #57606a; }
+    table { border-collapse: collapse; width: 100%; margin: 12px 0; font-size: 14px; }
+    th, td { border: 1px solid #d0d7de; padding: 6px 10px; text-align: left; vertical-align: top; }
+    th { background: #f6f8fa; }
+    tr:nth-child(even) td { background: #fbfcfd; }
+    code { font-family: SFMono-Regular, Consolas, monospace; }
+    .key { color: #cf222e; font-weight: 600; }
+    pre.mermaid { background: #f6f8fa; padding: 16px; overflow: auto; }
+  </style>
+</head>
+<body>
+<h1>{{html .Config.ProjectName}} 数据字典</h1>
+<p class="meta">作者：{{html .Config.GenConfig.Author}}，生成日期：{{html .Config.GenConfig.Date}}，共 {{len .Tables}} 张表</p>
+
+<h2>目录</h2>
+<ul>
+{{- range .Tables}}
+  <li><a href="#{{html .TableName}}">{{html .TableName}}</a>{{with .TableComment}} {{html .}}{{end}}</li>
+{{- end}}
+</ul>
+{{- range .Tables}}
+{{- $table := .}}
+
+<h2 id="{{html .TableName}}">{{html .TableName}}{{with .TableComment}}（{{html .}}）{{end}}</h2>
+<table>
+  <thead>
+    <tr><th>列名</th><th>类型</th><th>可空</th><th>默认值</th><th>键</th><th>可选值</th><th>注释</th></tr>
+  </thead>
+  <tbody>
+{{- range .Fields}}
+    <tr><td><code>{{html .ColumnName}}</code></td><td>{{html (sqlType .)}}</td><td>{{if .IsNullable}}是{{else}}否{{end}}</td><td>{{html (defaultSQL .)}}</td><td class="key">{{columnKeys $.Tables $table .}}</td><td>{{html (join .EnumValues ", ")}}</td><td>{{html .ColumnComment}}</td></tr>
+{{- end}}
+  </tbody>
+</table>
+{{- with .Indexes}}
+<h3>索引</h3>
+<table>
+  <thead>
+    <tr><th>名称</th><th>列</th><th>唯一</th></tr>
+  </thead>
+  <tbody>
+{{- range .}}
+    <tr><td>{{html .Name}}</td><td>{{html (join .Columns ", ")}}</td><td>{{if .Unique}}是{{else}}否{{end}}</td></tr>
+{{- end}}
+  </tbody>
+</table>
+{{- end}}
+{{- with relations $.Tables $table}}
+<h3>关联</h3>
+<table>
+  <thead>
+    <tr><th>列</th><th>引用</th><th>来源</th></tr>
+  </thead>
+  <tbody>
+{{- range .}}
+    <tr><td>{{html .Column}}</td><td><a href="#{{html .RefTable}}">{{html .RefTable}}</a>.{{html .RefColumn}}</td><td>{{if .Declared}}外键{{else}}列名约定{{end}}</td></tr>
+{{- end}}
+  </tbody>
+</table>
+{{- end}}
+{{- end}}
+
+<h2>ER 图</h2>
+<pre class="mermaid">
+{{html (erDiagram .Tables)}}</pre>
+<script type="module">
+  // 无法加载 Mermaid 时仍显示 ER 图源码
+  import mermaid from "https://cdn.jsdelivr.net/npm/mermaid@10/dist/mermaid.esm.min.mjs";
+  mermaid.initialize({ startOnLoad: true });
+</script>
+</body>
+</html>
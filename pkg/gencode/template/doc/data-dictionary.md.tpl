@@ -0,0 +1,47 @@
+@@Meta.Output="/data-dictionary.md"
+# {{.Config.ProjectName}} 数据字典
+
+> 作者：{{.Config.GenConfig.Author}}，生成日期：{{.Config.GenConfig.Date}}，共 {{len .Tables}} 张表
+
+## 目录
+{{range .Tables}}
+- [{{.TableName}}](#{{.TableName}}){{with .TableComment}} {{markdownCell .}}{{end}}
+{{- end}}
+{{- range .Tables}}
+{{- $table := .}}
+
+<a id="{{.TableName}}"></a>
+
+## {{.TableName}}{{with .TableComment}}（{{markdownCell .}}）{{end}}
+
+| 列名 | 类型 | 可空 | 默认值 | 键 | 可选值 | 注释 |
+| --- | --- | --- | --- | --- | --- | --- |
+{{- range .Fields}}
+| {{.ColumnName}} | {{sqlType . | markdownCell}} | {{if .IsNullable}}是{{else}}否{{end}} | {{defaultSQL . | markdownCell}} | {{columnKeys $.Tables $table .}} | {{markdownCell (join .EnumValues ", ")}} | {{markdownCell .ColumnComment}} |
+{{- end}}
+{{- with .Indexes}}
+
+**索引**
+
+| 名称 | 列 | 唯一 |
+| --- | --- | --- |
+{{- range .}}
+| {{.Name}} | {{join .Columns ", "}} | {{if .Unique}}是{{else}}否{{end}} |
+{{- end}}
+{{- end}}
+{{- with relations $.Tables $table}}
+
+**关联**
+
+| 列 | 引用 | 来源 |
+| --- | --- | --- |
+{{- range .}}
+| {{.Column}} | [{{.RefTable}}](#{{.RefTable}}).{{.RefColumn}} | {{if .Declared}}外键{{else}}列名约定{{end}} |
+{{- end}}
+{{- end}}
+{{- end}}
+
+## ER 图
+
+```mermaid
+{{erDiagram .Tables}}```
@@ -0,0 +1,49 @@
+@@Meta.Output="/.drone.yml"
+
+{{if eq ci "drone" -}}
+# 需要配置 Secrets：registry_username、registry_password 和 kube_config（kubeconfig 文件内容）
+# 通过 drone build promote <repo> <build> <环境> 部署到对应环境
+kind: pipeline
+type: docker
+name: default
+
+steps:
+  - name: build
+    image: {{builderImage}}
+    commands:
+      - {{buildCommand}}
+
+  - name: test
+    image: {{builderImage}}
+    commands:
+      - {{testCommand}}
+
+  - name: image
+    image: plugins/docker
+    settings:
+      registry: {{.Deploy.Registry}}
+      repo: {{imageName}}
+      tags: ${DRONE_COMMIT_SHA:0:8}
+      username:
+        from_secret: registry_username
+      password:
+        from_secret: registry_password
+    when:
+      event: [push, tag, promote]
+{{- range .Deploy.Environments}}
+
+  - name: deploy-{{.Name}}
+    image: dtzar/helm-kubectl:3.14
+    environment:
+      IMAGE_TAG: ${DRONE_COMMIT_SHA:0:8}
+      KUBE_CONFIG:
+        from_secret: kube_config
+    commands:
+      - echo "$$KUBE_CONFIG" > /tmp/kubeconfig
+      - export KUBECONFIG=/tmp/kubeconfig
+      - {{deployCommand .Name | replace "$" "$$"}}
+    when:
+      event: promote
+      target: {{.Name}}
+{{- end}}
+{{- end}}
@@ -0,0 +1,58 @@
+@@Meta.Output="/.github/workflows/ci.yml"
+
+{{if eq ci "github" -}}
+# 需要配置仓库 Secrets：REGISTRY_USERNAME、REGISTRY_PASSWORD 和 KUBE_CONFIG（kubeconfig 文件内容）
+name: ci
+
+on:
+  push:
+    branches: [main, master]
+  pull_request:
+  workflow_dispatch:
+    inputs:
+      environment:
+        description: 部署环境
+        type: choice
+        options: [{{range $i, $env := .Deploy.Environments}}{{if $i}}, {{end}}{{$env.Name}}{{end}}]
+
+env:
+  IMAGE: {{imageName}}
+  IMAGE_TAG: {{"${{ github.sha }}"}}
+
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+      - uses: actions/setup-java@v4
+        with:
+          distribution: temurin
+          java-version: "{{.Profile.JDK}}"
+          cache: {{if eq layout "gradle"}}gradle{{else}}maven{{end}}
+      - name: Build
+        run: {{buildCommand}}
+      - name: Test
+        run: {{testCommand}}
+      - name: Build image
+        if: github.event_name != 'pull_request'
+        run: |
+          echo "{{"${{ secrets.REGISTRY_PASSWORD }}"}}" | docker login {{.Deploy.Registry}} -u "{{"${{ secrets.REGISTRY_USERNAME }}"}}" --password-stdin
+          docker build -t $IMAGE:$IMAGE_TAG .
+          docker push $IMAGE:$IMAGE_TAG
+
+  deploy:
+    if: github.event_name == 'workflow_dispatch'
+    needs: build
+    runs-on: ubuntu-latest
+    environment: {{"${{ inputs.environment }}"}}
+    steps:
+      - uses: actions/checkout@v4
+      - name: Deploy
+        env:
+          KUBE_CONFIG: {{"${{ secrets.KUBE_CONFIG }}"}}
+          DEPLOY_ENV: {{"${{ inputs.environment }}"}}
+        run: |
+          echo "$KUBE_CONFIG" > "$RUNNER_TEMP/kubeconfig"
+          export KUBECONFIG="$RUNNER_TEMP/kubeconfig"
+          {{deployCommand "$DEPLOY_ENV"}}
+{{- end}}
@@ -1,3 +1,5 @@
 @@Meta.Output="/.gitignore"
 
-.idea
\ No newline at end of file
+.idea{{if eq layout "gradle"}}
+.gradle
+build{{end}}
\ No newline at end of file
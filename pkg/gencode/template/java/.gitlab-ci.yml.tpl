@@ -0,0 +1,56 @@
+@@Meta.Output="/.gitlab-ci.yml"
+
+{{if eq ci "gitlab" -}}
+# 需要配置 CI/CD 变量：REGISTRY_USERNAME、REGISTRY_PASSWORD 和文件类型的 KUBECONFIG
+stages:
+  - build
+  - test
+  - image
+  - deploy
+
+variables:
+  IMAGE: {{imageName}}
+  IMAGE_TAG: $CI_COMMIT_SHORT_SHA
+
+build:
+  stage: build
+  image: {{builderImage}}
+  script:
+    - {{buildCommand}}
+  artifacts:
+    paths:
+      - {{jarPath}}
+
+test:
+  stage: test
+  image: {{builderImage}}
+  script:
+    - {{testCommand}}
+
+image:
+  stage: image
+  image: docker:24
+  services:
+    - docker:24-dind
+  rules:
+    - if: $CI_PIPELINE_SOURCE != "merge_request_event"
+  script:
+    - echo "$REGISTRY_PASSWORD" | docker login {{.Deploy.Registry}} -u "$REGISTRY_USERNAME" --password-stdin
+    - docker build -t $IMAGE:$IMAGE_TAG .
+    - docker push $IMAGE:$IMAGE_TAG
+
+.deploy:
+  stage: deploy
+  image: dtzar/helm-kubectl:3.14
+  rules:
+    - if: $CI_PIPELINE_SOURCE != "merge_request_event"
+      when: manual
+{{- range .Deploy.Environments}}
+
+deploy-{{.Name}}:
+  extends: .deploy
+  environment: {{.Name}}
+  script:
+    - {{deployCommand .Name}}
+{{- end}}
+{{- end}}
@@ -1,9 +1,9 @@
 @@Meta.Output="/Dockerfile"
 
-FROM java:8u92-jre-alpine
+FROM {{.Profile.BaseImage}}
 
 WORKDIR /home
 
-COPY target/*.jar /home
+COPY {{jarPath}} /home
 
 ENTRYPOINT java -jar *.jar
\ No newline at end of file
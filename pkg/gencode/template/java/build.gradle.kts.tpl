@@ -0,0 +1,73 @@
+@@Meta.Output="/build.gradle.kts"
+
+{{if eq layout "gradle" -}}
+plugins {
+    java
+    id("org.springframework.boot") version "{{.Profile.SpringBootVersion}}"
+    id("io.spring.dependency-management") version "{{.Profile.DependencyManagementVersion}}"
+}
+
+group = "com.example"
+version = "0.0.1-SNAPSHOT"
+description = "Auto generated Spring Boot project"
+
+java {
+    sourceCompatibility = JavaVersion.VERSION_{{.Profile.JavaVersion | replace "." "_"}}
+}
+
+// Maven仓库配置
+repositories {
+    maven("https://maven.aliyun.com/repository/central")
+    maven("https://maven.aliyun.com/repository/public")
+    mavenCentral()
+}
+
+val mybatisPlusVersion = "{{.Profile.MybatisPlusVersion}}"
+{{- if .Config.GenConfig.EnableSwagger}}
+val springdocVersion = "{{.Profile.SpringdocVersion}}"
+{{- end}}
+{{- if .Config.GenConfig.EnableExcel}}
+val easyexcelVersion = "3.3.4"
+{{- end}}
+{{- if eq auth "sa-token"}}
+val saTokenVersion = "1.37.0"
+{{- end}}
+
+dependencies {
+    implementation("org.springframework.boot:spring-boot-starter-web")
+    implementation("org.springframework.boot:spring-boot-starter-validation")
+    implementation("com.baomidou:{{.Profile.MybatisPlusArtifact}}:$mybatisPlusVersion")
+{{- if .Config.GenConfig.EnableSwagger}}
+    implementation("org.springdoc:{{.Profile.SpringdocArtifact}}:$springdocVersion")
+{{- end}}
+{{- if .Config.GenConfig.EnableExcel}}
+    implementation("com.alibaba:easyexcel:$easyexcelVersion")
+{{- end}}
+{{- if eq auth "spring-security"}}
+    implementation("org.springframework.boot:spring-boot-starter-security")
+{{- else if eq auth "sa-token"}}
+    implementation("cn.dev33:{{.Profile.SaTokenArtifact}}:$saTokenVersion")
+{{- end}}
+    runtimeOnly("{{.Profile.MysqlGroupID}}:{{.Profile.MysqlArtifact}}")
+
+    compileOnly("org.projectlombok:lombok")
+    annotationProcessor("org.projectlombok:lombok")
+    testCompileOnly("org.projectlombok:lombok")
+    testAnnotationProcessor("org.projectlombok:lombok")
+
+    testImplementation("org.springframework.boot:spring-boot-starter-test")
+{{- if eq auth "spring-security"}}
+    testImplementation("org.springframework.security:spring-security-test")
+{{- end}}
+    testRuntimeOnly("com.h2database:h2")
+}
+
+// 只输出可执行 jar，便于 Dockerfile 复制
+tasks.jar {
+    enabled = false
+}
+
+tasks.test {
+    useJUnitPlatform()
+}
+{{- end}}
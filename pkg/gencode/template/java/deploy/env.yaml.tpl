@@ -0,0 +1,93 @@
+@@Meta.Output="/deploy/{{.Environment.Name}}.yaml"
+
+{{if not .Deploy.Helm -}}
+{{- $name := printf "%s-%s" artifactId .Environment.Name -}}
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  labels:
+    app: {{artifactId}}
+    env: {{.Environment.Name}}
+  name: {{$name}}
+  namespace: {{.Environment.Namespace}}
+spec:
+  progressDeadlineSeconds: 600
+  replicas: {{.Environment.Replicas}}
+  selector:
+    matchLabels:
+      app: {{artifactId}}
+      env: {{.Environment.Name}}
+  template:
+    metadata:
+      labels:
+        app: {{artifactId}}
+        env: {{.Environment.Name}}
+    spec:
+      containers:
+        - env:
+            - name: SPRING_PROFILES_ACTIVE
+              value: {{.Environment.Name}}
+{{- range $key, $value := .Environment.Env}}
+            - name: {{$key}}
+              value: {{printf "%q" $value}}
+{{- end}}
+          image: {{.Deploy.Registry}}/{{.Deploy.RegistryNamespace}}/{{artifactId}}:$IMAGE_TAG
+          readinessProbe:
+{{- template "probe" .Deploy}}
+          livenessProbe:
+{{- template "probe" .Deploy}}
+          resources:
+            requests:
+              cpu: {{.Deploy.Resources.CPURequest}}
+              memory: {{.Deploy.Resources.MemoryRequest}}
+            limits:
+              cpu: {{.Deploy.Resources.CPULimit}}
+              memory: {{.Deploy.Resources.MemoryLimit}}
+          imagePullPolicy: Always
+          name: {{artifactId}}
+          ports:
+            - containerPort: {{.Deploy.Port}}
+              protocol: TCP
+          terminationMessagePath: /dev/termination-log
+          terminationMessagePolicy: File
+      dnsPolicy: ClusterFirst
+      restartPolicy: Always
+      terminationGracePeriodSeconds: 30
+
+---
+apiVersion: v1
+kind: Service
+metadata:
+  labels:
+    app: {{artifactId}}
+    env: {{.Environment.Name}}
+  name: {{$name}}
+  namespace: {{.Environment.Namespace}}
+spec:
+  ports:
+    - name: http
+      port: {{.Deploy.ServicePort}}
+      protocol: TCP
+      targetPort: {{.Deploy.Port}}
+  selector:
+    app: {{artifactId}}
+    env: {{.Environment.Name}}
+  sessionAffinity: None
+  type: {{.Deploy.ServiceType}}
+{{- end}}
+{{- define "probe"}}
+{{- if .Probe.Path}}
+            httpGet:
+              path: {{.Probe.Path}}
+              port: {{.Port}}
+{{- else}}
+            tcpSocket:
+              port: {{.Port}}
+{{- end}}
+{{- if .Probe.InitialDelaySeconds}}
+            initialDelaySeconds: {{.Probe.InitialDelaySeconds}}
+{{- end}}
+            timeoutSeconds: {{.Probe.TimeoutSeconds}}
+            failureThreshold: {{.Probe.FailureThreshold}}
+            periodSeconds: {{.Probe.PeriodSeconds}}
+{{- end}}
@@ -0,0 +1,10 @@
+@@Meta.Output="/deploy/helm/{{artifactId}}/Chart.yaml"
+
+{{if .Deploy.Helm -}}
+apiVersion: v2
+name: {{artifactId}}
+description: Helm chart of {{.Config.ProjectName}}, generated by the code generator
+type: application
+version: 0.1.0
+appVersion: "0.0.1-SNAPSHOT"
+{{- end}}
@@ -0,0 +1,56 @@
+@@Meta.Output="/deploy/helm/{{artifactId}}/templates/deployment.yaml"
+
+{{if .Deploy.Helm -}}
+{{`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .Release.Name }}
+  namespace: {{ .Values.namespace | default .Release.Namespace }}
+  labels:
+    app: {{ .Chart.Name }}
+    release: {{ .Release.Name }}
+spec:
+  replicas: {{ .Values.replicaCount }}
+  selector:
+    matchLabels:
+      app: {{ .Chart.Name }}
+      release: {{ .Release.Name }}
+  template:
+    metadata:
+      labels:
+        app: {{ .Chart.Name }}
+        release: {{ .Release.Name }}
+    spec:
+      containers:
+        - name: {{ .Chart.Name }}
+          image: "{{ .Values.image.repository }}:{{ .Values.image.tag }}"
+          imagePullPolicy: {{ .Values.image.pullPolicy }}
+          {{- with .Values.env }}
+          env:
+            {{- range $key, $value := . }}
+            - name: {{ $key }}
+              value: {{ $value | quote }}
+            {{- end }}
+          {{- end }}
+          ports:
+            - name: http
+              containerPort: {{ .Values.containerPort }}
+              protocol: TCP
+          {{- range list "readinessProbe" "livenessProbe" }}
+          {{ . }}:
+            {{- if $.Values.probe.path }}
+            httpGet:
+              path: {{ $.Values.probe.path }}
+              port: http
+            {{- else }}
+            tcpSocket:
+              port: http
+            {{- end }}
+            initialDelaySeconds: {{ $.Values.probe.initialDelaySeconds }}
+            periodSeconds: {{ $.Values.probe.periodSeconds }}
+            timeoutSeconds: {{ $.Values.probe.timeoutSeconds }}
+            failureThreshold: {{ $.Values.probe.failureThreshold }}
+          {{- end }}
+          resources:
+            {{- toYaml .Values.resources | nindent 12 }}`}}
+{{- end}}
@@ -0,0 +1,22 @@
+@@Meta.Output="/deploy/helm/{{artifactId}}/templates/service.yaml"
+
+{{if .Deploy.Helm -}}
+{{`apiVersion: v1
+kind: Service
+metadata:
+  name: {{ .Release.Name }}
+  namespace: {{ .Values.namespace | default .Release.Namespace }}
+  labels:
+    app: {{ .Chart.Name }}
+    release: {{ .Release.Name }}
+spec:
+  type: {{ .Values.service.type }}
+  ports:
+    - name: http
+      port: {{ .Values.service.port }}
+      targetPort: http
+      protocol: TCP
+  selector:
+    app: {{ .Chart.Name }}
+    release: {{ .Release.Name }}`}}
+{{- end}}
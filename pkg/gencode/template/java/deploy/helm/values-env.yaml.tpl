@@ -0,0 +1,12 @@
+@@Meta.Output="/deploy/helm/{{artifactId}}/values-{{.Environment.Name}}.yaml"
+
+{{if .Deploy.Helm -}}
+replicaCount: {{.Environment.Replicas}}
+namespace: {{.Environment.Namespace}}
+
+env:
+  SPRING_PROFILES_ACTIVE: {{.Environment.Name}}
+{{- range $key, $value := .Environment.Env}}
+  {{$key}}: {{printf "%q" $value}}
+{{- end}}
+{{- end}}
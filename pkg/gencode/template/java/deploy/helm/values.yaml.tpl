@@ -0,0 +1,36 @@
+@@Meta.Output="/deploy/helm/{{artifactId}}/values.yaml"
+
+{{if .Deploy.Helm -}}
+# 各环境的覆盖值见 values-<环境>.yaml
+replicaCount: {{.Deploy.Replicas}}
+namespace: {{.Deploy.Namespace}}
+
+image:
+  repository: {{.Deploy.Registry}}/{{.Deploy.RegistryNamespace}}/{{artifactId}}
+  tag: latest
+  pullPolicy: Always
+
+containerPort: {{.Deploy.Port}}
+
+service:
+  type: {{.Deploy.ServiceType}}
+  port: {{.Deploy.ServicePort}}
+
+# path 为空时使用 TCP 端口探测
+probe:
+  path: "{{.Deploy.Probe.Path}}"
+  initialDelaySeconds: {{.Deploy.Probe.InitialDelaySeconds}}
+  periodSeconds: {{.Deploy.Probe.PeriodSeconds}}
+  timeoutSeconds: {{.Deploy.Probe.TimeoutSeconds}}
+  failureThreshold: {{.Deploy.Probe.FailureThreshold}}
+
+resources:
+  requests:
+    cpu: {{.Deploy.Resources.CPURequest}}
+    memory: {{.Deploy.Resources.MemoryRequest}}
+  limits:
+    cpu: {{.Deploy.Resources.CPULimit}}
+    memory: {{.Deploy.Resources.MemoryLimit}}
+
+env: {}
+{{- end}}
@@ -0,0 +1,4 @@
+@@Meta.Output="/docker/mysql/init.sql"
+-- {{.Config.ProjectName}} 本地数据库初始化脚本，由 docker-compose.yml 挂载到 MySQL 容器首次启动时执行
+{{range .Tables}}
+{{mysqlTable .}}{{end}}
\ No newline at end of file
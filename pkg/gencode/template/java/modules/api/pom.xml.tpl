@@ -0,0 +1,42 @@
+@@Meta.Output="/{{module "api"}}/pom.xml"
+
+{{if eq layout "maven-multi" -}}
+<?xml version="1.0" encoding="UTF-8"?>
+<project xmlns="http://maven.apache.org/POM/4.0.0" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
+         xsi:schemaLocation="http://maven.apache.org/POM/4.0.0 https://maven.apache.org/xsd/maven-4.0.0.xsd">
+    <modelVersion>4.0.0</modelVersion>
+
+    <parent>
+        <groupId>com.example</groupId>
+        <artifactId>{{artifactId}}</artifactId>
+        <version>0.0.1-SNAPSHOT</version>
+    </parent>
+
+    <artifactId>{{module "api"}}</artifactId>
+    <name>{{module "api"}}</name>
+    <description>请求参数、响应视图和查询条件</description>
+
+    <dependencies>
+        <!-- Spring Boot Validation -->
+        <dependency>
+            <groupId>org.springframework.boot</groupId>
+            <artifactId>spring-boot-starter-validation</artifactId>
+        </dependency>
+
+        <!-- Jackson 注解 -->
+        <dependency>
+            <groupId>com.fasterxml.jackson.core</groupId>
+            <artifactId>jackson-annotations</artifactId>
+        </dependency>
+{{- if .Config.GenConfig.EnableSwagger}}
+
+        <!-- OpenAPI 注解 -->
+        <dependency>
+            <groupId>io.swagger.core.v3</groupId>
+            <artifactId>{{.Profile.SwaggerAnnotationsArtifact}}</artifactId>
+        </dependency>
+{{- end}}
+    </dependencies>
+
+</project>
+{{- end}}
\ No newline at end of file
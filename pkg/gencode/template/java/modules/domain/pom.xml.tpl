@@ -0,0 +1,48 @@
+@@Meta.Output="/{{module "domain"}}/pom.xml"
+
+{{if eq layout "maven-multi" -}}
+<?xml version="1.0" encoding="UTF-8"?>
+<project xmlns="http://maven.apache.org/POM/4.0.0" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
+         xsi:schemaLocation="http://maven.apache.org/POM/4.0.0 https://maven.apache.org/xsd/maven-4.0.0.xsd">
+    <modelVersion>4.0.0</modelVersion>
+
+    <parent>
+        <groupId>com.example</groupId>
+        <artifactId>{{artifactId}}</artifactId>
+        <version>0.0.1-SNAPSHOT</version>
+    </parent>
+
+    <artifactId>{{module "domain"}}</artifactId>
+    <name>{{module "domain"}}</name>
+    <description>实体、Mapper、服务及数据库脚本</description>
+
+    <dependencies>
+        <dependency>
+            <groupId>${project.groupId}</groupId>
+            <artifactId>{{module "api"}}</artifactId>
+        </dependency>
+
+        <!-- MyBatis Plus Starter -->
+        <dependency>
+            <groupId>com.baomidou</groupId>
+            <artifactId>{{.Profile.MybatisPlusArtifact}}</artifactId>
+        </dependency>
+
+        <!-- MySQL Connector -->
+        <dependency>
+            <groupId>{{.Profile.MysqlGroupID}}</groupId>
+            <artifactId>{{.Profile.MysqlArtifact}}</artifactId>
+            <scope>runtime</scope>
+        </dependency>
+{{- if .Config.GenConfig.EnableExcel}}
+
+        <!-- EasyExcel 导入导出 -->
+        <dependency>
+            <groupId>com.alibaba</groupId>
+            <artifactId>easyexcel</artifactId>
+        </dependency>
+{{- end}}
+    </dependencies>
+
+</project>
+{{- end}}
\ No newline at end of file
@@ -0,0 +1,164 @@
+@@Meta.Output="/pom.xml"
+
+{{if eq layout "maven-multi" -}}
+<?xml version="1.0" encoding="UTF-8"?>
+<project xmlns="http://maven.apache.org/POM/4.0.0" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
+         xsi:schemaLocation="http://maven.apache.org/POM/4.0.0 https://maven.apache.org/xsd/maven-4.0.0.xsd">
+    <modelVersion>4.0.0</modelVersion>
+
+    <groupId>com.example</groupId>
+    <artifactId>{{artifactId}}</artifactId>
+    <version>0.0.1-SNAPSHOT</version>
+    <packaging>pom</packaging>
+    <name>{{artifactId}}</name>
+    <description>Auto generated Spring Boot project</description>
+
+    <!-- 子模块：web 依赖 domain，domain 依赖 api -->
+    <modules>
+        <module>{{module "api"}}</module>
+        <module>{{module "domain"}}</module>
+        <module>{{module "web"}}</module>
+    </modules>
+
+    <properties>
+        <java.version>{{.Profile.JavaVersion}}</java.version>
+        <mybatis-plus.version>{{.Profile.MybatisPlusVersion}}</mybatis-plus.version>
+        <lombok.version>1.18.24</lombok.version>
+{{- if .Config.GenConfig.EnableSwagger}}
+        <springdoc.version>{{.Profile.SpringdocVersion}}</springdoc.version>
+        <swagger-annotations.version>{{.Profile.SwaggerAnnotationsVersion}}</swagger-annotations.version>
+{{- end}}
+{{- if .Config.GenConfig.EnableExcel}}
+        <easyexcel.version>3.3.4</easyexcel.version>
+{{- end}}
+{{- if eq auth "sa-token"}}
+        <sa-token.version>1.37.0</sa-token.version>
+{{- end}}
+    </properties>
+
+    <!-- Spring Boot 启动父依赖 -->
+    <parent>
+        <groupId>org.springframework.boot</groupId>
+        <artifactId>spring-boot-starter-parent</artifactId>
+        <version>{{.Profile.SpringBootVersion}}</version>
+    </parent>
+    <!--Maven仓库配置-->
+    <repositories>
+        <repository>
+            <id>aliyun-central</id>
+            <name>Aliyun Central</name>
+            <url>https://maven.aliyun.com/repository/central</url>
+            <releases>
+                <enabled>true</enabled>
+            </releases>
+            <snapshots>
+                <enabled>false</enabled>
+            </snapshots>
+        </repository>
+        <repository>
+            <id>aliyun-public</id>
+            <name>Aliyun Public</name>
+            <url>https://maven.aliyun.com/repository/public</url>
+            <releases>
+                <enabled>true</enabled>
+            </releases>
+            <snapshots>
+                <enabled>false</enabled>
+            </snapshots>
+        </repository>
+        <repository>
+            <id>central</id>
+            <name>Maven Central</name>
+            <url>https://repo1.maven.org/maven2</url>
+            <releases>
+                <enabled>true</enabled>
+            </releases>
+            <snapshots>
+                <enabled>false</enabled>
+            </snapshots>
+        </repository>
+    </repositories>
+
+    <pluginRepositories>
+        <pluginRepository>
+            <id>aliyun-central</id>
+            <name>Aliyun Central</name>
+            <url>https://maven.aliyun.com/repository/central</url>
+            <releases>
+                <enabled>true</enabled>
+            </releases>
+            <snapshots>
+                <enabled>false</enabled>
+            </snapshots>
+        </pluginRepository>
+        <pluginRepository>
+            <id>central</id>
+            <name>Maven Central</name>
+            <url>https://repo1.maven.org/maven2</url>
+            <releases>
+                <enabled>true</enabled>
+            </releases>
+            <snapshots>
+                <enabled>false</enabled>
+            </snapshots>
+        </pluginRepository>
+    </pluginRepositories>
+
+    <!-- 子模块及第三方依赖版本统一管理 -->
+    <dependencyManagement>
+        <dependencies>
+            <dependency>
+                <groupId>${project.groupId}</groupId>
+                <artifactId>{{module "api"}}</artifactId>
+                <version>${project.version}</version>
+            </dependency>
+            <dependency>
+                <groupId>${project.groupId}</groupId>
+                <artifactId>{{module "domain"}}</artifactId>
+                <version>${project.version}</version>
+            </dependency>
+            <dependency>
+                <groupId>com.baomidou</groupId>
+                <artifactId>{{.Profile.MybatisPlusArtifact}}</artifactId>
+                <version>${mybatis-plus.version}</version>
+            </dependency>
+{{- if .Config.GenConfig.EnableSwagger}}
+            <dependency>
+                <groupId>io.swagger.core.v3</groupId>
+                <artifactId>{{.Profile.SwaggerAnnotationsArtifact}}</artifactId>
+                <version>${swagger-annotations.version}</version>
+            </dependency>
+            <dependency>
+                <groupId>org.springdoc</groupId>
+                <artifactId>{{.Profile.SpringdocArtifact}}</artifactId>
+                <version>${springdoc.version}</version>
+            </dependency>
+{{- end}}
+{{- if .Config.GenConfig.EnableExcel}}
+            <dependency>
+                <groupId>com.alibaba</groupId>
+                <artifactId>easyexcel</artifactId>
+                <version>${easyexcel.version}</version>
+            </dependency>
+{{- end}}
+{{- if eq auth "sa-token"}}
+            <dependency>
+                <groupId>cn.dev33</groupId>
+                <artifactId>{{.Profile.SaTokenArtifact}}</artifactId>
+                <version>${sa-token.version}</version>
+            </dependency>
+{{- end}}
+        </dependencies>
+    </dependencyManagement>
+
+    <dependencies>
+        <!-- Lombok -->
+        <dependency>
+            <groupId>org.projectlombok</groupId>
+            <artifactId>lombok</artifactId>
+            <optional>true</optional>
+        </dependency>
+    </dependencies>
+
+</project>
+{{- end}}
\ No newline at end of file
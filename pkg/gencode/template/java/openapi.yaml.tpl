@@ -0,0 +1,3 @@
+@@Meta.Output="/openapi.yaml"
+# 由代码生成器生成，描述 {{.Config.ProjectName}} 的 REST 接口
+{{openapi .Tables}}
\ No newline at end of file
@@ -1,28 +1,37 @@
 @@Meta.Output="/pom.xml"
 
+{{if eq layout "maven" -}}
 <?xml version="1.0" encoding="UTF-8"?>
 <project xmlns="http://maven.apache.org/POM/4.0.0" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
          xsi:schemaLocation="http://maven.apache.org/POM/4.0.0 https://maven.apache.org/xsd/maven-4.0.0.xsd">
     <modelVersion>4.0.0</modelVersion>
 
     <groupId>com.example</groupId>
-    <artifactId>generated-project</artifactId>
+    <artifactId>{{artifactId}}</artifactId>
     <version>0.0.1-SNAPSHOT</version>
-    <name>generated-project</name>
+    <name>{{artifactId}}</name>
     <description>Auto generated Spring Boot project</description>
 
     <properties>
-        <java.version>1.8</java.version>
-        <mybatis-plus.version>3.5.3.1</mybatis-plus.version>
+        <java.version>{{.Profile.JavaVersion}}</java.version>
+        <mybatis-plus.version>{{.Profile.MybatisPlusVersion}}</mybatis-plus.version>
         <lombok.version>1.18.24</lombok.version>
-        <swagger.version>3.0.0</swagger.version>
+{{- if .Config.GenConfig.EnableSwagger}}
+        <springdoc.version>{{.Profile.SpringdocVersion}}</springdoc.version>
+{{- end}}
+{{- if .Config.GenConfig.EnableExcel}}
+        <easyexcel.version>3.3.4</easyexcel.version>
+{{- end}}
+{{- if eq auth "sa-token"}}
+        <sa-token.version>1.37.0</sa-token.version>
+{{- end}}
     </properties>
 
     <!-- Spring Boot 启动父依赖 -->
     <parent>
         <groupId>org.springframework.boot</groupId>
         <artifactId>spring-boot-starter-parent</artifactId>
-        <version>2.6.13</version>
+        <version>{{.Profile.SpringBootVersion}}</version>
     </parent>
     <!--Maven仓库配置-->
     <repositories>
@@ -93,17 +102,23 @@
             <artifactId>spring-boot-starter-web</artifactId>
         </dependency>
 
+        <!-- Spring Boot Validation -->
+        <dependency>
+            <groupId>org.springframework.boot</groupId>
+            <artifactId>spring-boot-starter-validation</artifactId>
+        </dependency>
+
         <!-- MyBatis Plus Starter -->
         <dependency>
             <groupId>com.baomidou</groupId>
-            <artifactId>mybatis-plus-boot-starter</artifactId>
+            <artifactId>{{.Profile.MybatisPlusArtifact}}</artifactId>
             <version>${mybatis-plus.version}</version>
         </dependency>
 
         <!-- MySQL Connector -->
         <dependency>
-            <groupId>mysql</groupId>
-            <artifactId>mysql-connector-java</artifactId>
+            <groupId>{{.Profile.MysqlGroupID}}</groupId>
+            <artifactId>{{.Profile.MysqlArtifact}}</artifactId>
             <scope>runtime</scope>
         </dependency>
 
@@ -113,7 +128,39 @@
             <artifactId>lombok</artifactId>
             <optional>true</optional>
         </dependency>
+{{- if .Config.GenConfig.EnableSwagger}}
+
+        <!-- SpringDoc OpenAPI（Swagger UI） -->
+        <dependency>
+            <groupId>org.springdoc</groupId>
+            <artifactId>{{.Profile.SpringdocArtifact}}</artifactId>
+            <version>${springdoc.version}</version>
+        </dependency>
+{{- end}}{{- if .Config.GenConfig.EnableExcel}}
+
+        <!-- EasyExcel 导入导出 -->
+        <dependency>
+            <groupId>com.alibaba</groupId>
+            <artifactId>easyexcel</artifactId>
+            <version>${easyexcel.version}</version>
+        </dependency>
+{{- end}}
+{{- if eq auth "spring-security"}}
+
+        <!-- Spring Security 权限注解 -->
+        <dependency>
+            <groupId>org.springframework.boot</groupId>
+            <artifactId>spring-boot-starter-security</artifactId>
+        </dependency>
+{{- else if eq auth "sa-token"}}
 
+        <!-- Sa-Token 权限注解 -->
+        <dependency>
+            <groupId>cn.dev33</groupId>
+            <artifactId>{{.Profile.SaTokenArtifact}}</artifactId>
+            <version>${sa-token.version}</version>
+        </dependency>
+{{- end}}
 
         <!-- Spring Boot Test -->
         <dependency>
@@ -121,6 +168,22 @@
             <artifactId>spring-boot-starter-test</artifactId>
             <scope>test</scope>
         </dependency>
+{{- if eq auth "spring-security"}}
+
+        <!-- Spring Security Test -->
+        <dependency>
+            <groupId>org.springframework.security</groupId>
+            <artifactId>spring-security-test</artifactId>
+            <scope>test</scope>
+        </dependency>
+{{- end}}
+
+        <!-- H2 Database for tests -->
+        <dependency>
+            <groupId>com.h2database</groupId>
+            <artifactId>h2</artifactId>
+            <scope>test</scope>
+        </dependency>
     </dependencies>
 
     <build>
@@ -142,4 +205,4 @@
         </plugins>
     </build>
 
-</project>
\ No newline at end of file
+</project>{{end}}
\ No newline at end of file
@@ -0,0 +1,5 @@
+@@Meta.Output="/settings.gradle.kts"
+
+{{if eq layout "gradle" -}}
+rootProject.name = "{{artifactId}}"
+{{- end}}
@@ -0,0 +1,58 @@
+@@Meta.Output="/src/main/java/{{.Config.PackageConfig.BasePackage | replace "." "/"}}/config/AuditMetaObjectHandler.java"
+
+{{- with fillFields .Tables}}
+package {{$.Config.PackageConfig.BasePackage}}.config;
+
+import com.baomidou.mybatisplus.core.handlers.MetaObjectHandler;
+import org.apache.ibatis.reflection.MetaObject;
+import org.springframework.stereotype.Component;
+import java.time.LocalDate;
+import java.time.LocalDateTime;
+import java.time.LocalTime;
+import java.util.Date;
+
+/**
+ * 审计字段自动填充
+ *
+ * @author {{$.Config.GenConfig.Author}}
+ * @date {{$.Config.GenConfig.Date}}
+ */
+@Component
+public class AuditMetaObjectHandler implements MetaObjectHandler {
+
+    @Override
+    public void insertFill(MetaObject metaObject) {
+{{- range .}}
+{{- if eq .JavaType "Date"}}
+        this.strictInsertFill(metaObject, "{{.FieldName}}", Date.class, new Date());
+{{- else if isDateTime .JavaType}}
+        this.strictInsertFill(metaObject, "{{.FieldName}}", {{.JavaType}}.class, {{.JavaType}}.now());
+{{- else}}
+        this.fillStrategy(metaObject, "{{.FieldName}}", currentUser());
+{{- end}}
+{{- end}}
+    }
+
+    @Override
+    public void updateFill(MetaObject metaObject) {
+{{- range .}}
+{{- if eq .Fill "INSERT_UPDATE"}}
+{{- if eq .JavaType "Date"}}
+        this.setFieldValByName("{{.FieldName}}", new Date(), metaObject);
+{{- else if isDateTime .JavaType}}
+        this.setFieldValByName("{{.FieldName}}", {{.JavaType}}.now(), metaObject);
+{{- else}}
+        this.setFieldValByName("{{.FieldName}}", currentUser(), metaObject);
+{{- end}}
+{{- end}}
+{{- end}}
+    }
+
+    /**
+     * 当前操作人，接入认证后返回登录用户标识
+     */
+    protected Object currentUser() {
+        return null;
+    }
+}
+{{- end}}
@@ -0,0 +1,34 @@
+@@Meta.Output="/src/main/java/{{.Config.PackageConfig.BasePackage | replace "." "/"}}/config/MybatisPlusConfig.java"
+
+package {{.Config.PackageConfig.BasePackage}}.config;
+
+import com.baomidou.mybatisplus.annotation.DbType;
+import com.baomidou.mybatisplus.extension.plugins.MybatisPlusInterceptor;
+{{- if hasVersion .Tables}}
+import com.baomidou.mybatisplus.extension.plugins.inner.OptimisticLockerInnerInterceptor;
+{{- end}}
+import com.baomidou.mybatisplus.extension.plugins.inner.PaginationInnerInterceptor;
+import org.springframework.context.annotation.Bean;
+import org.springframework.context.annotation.Configuration;
+
+/**
+ * MyBatis-Plus配置
+ *
+ * @author {{.Config.GenConfig.Author}}
+ * @date {{.Config.GenConfig.Date}}
+ */
+@Configuration
+public class MybatisPlusConfig {
+
+    @Bean
+    public MybatisPlusInterceptor mybatisPlusInterceptor() {
+        MybatisPlusInterceptor interceptor = new MybatisPlusInterceptor();
+{{- if hasVersion .Tables}}
+        // 乐观锁，更新时校验并递增 @Version 字段
+        interceptor.addInnerInterceptor(new OptimisticLockerInnerInterceptor());
+{{- end}}
+        // 分页
+        interceptor.addInnerInterceptor(new PaginationInnerInterceptor(DbType.MYSQL));
+        return interceptor;
+    }
+}
@@ -3,11 +3,47 @@
 package {{.ControllerPackage}};
 
 import org.springframework.web.bind.annotation.*;
+import org.springframework.validation.annotation.Validated;
+import com.baomidou.mybatisplus.core.metadata.IPage;
 import com.baomidou.mybatisplus.extension.plugins.pagination.Page;
 import org.springframework.beans.factory.annotation.Autowired;
 import java.util.List;
+{{- if .EnableExcel}}
+import com.alibaba.excel.EasyExcel;
+{{- if .Table.CanImport}}
+import org.springframework.web.multipart.MultipartFile;
+{{- end}}
+import {{.Profile.EEPackage}}.servlet.http.HttpServletResponse;
+import java.io.IOException;
+{{- if .Table.CanImport}}
+import java.io.InputStream;
+{{- end}}
+import java.net.URLEncoder;
+{{- if .Table.CanImport}}
+import java.util.Collections;
+import {{.ExcelPackage}}.ExcelImportResult;
+{{- end}}
+import {{.ExcelPackage}}.{{.ClassName}}Excel;
+{{- end}}
 import {{.EntityPackage}}.{{.ClassName}};
+import {{.ConverterPackage}}.{{.ClassName}}Converter;
+import {{.DtoPackage}}.{{.ClassName}}CreateDTO;
+import {{.DtoPackage}}.{{.ClassName}}UpdateDTO;
+import {{.QueryPackage}}.{{.ClassName}}Query;
+import {{.VoPackage}}.{{.ClassName}}VO;
 import {{.ServicePackage}}.I{{.ClassName}}Service;
+{{- if eq auth "spring-security"}}
+import org.springframework.security.access.prepost.PreAuthorize;
+{{- else if eq auth "sa-token"}}
+import cn.dev33.satoken.annotation.SaCheckPermission;
+{{- end}}
+{{- if .EnableSwagger}}
+import io.swagger.v3.oas.annotations.Operation;
+import io.swagger.v3.oas.annotations.Parameter;
+import io.swagger.v3.oas.annotations.enums.ParameterIn;
+import io.swagger.v3.oas.annotations.tags.Tag;
+import {{.Profile.SpringdocPackage}}.ParameterObject;
+{{- end}}
 
 /**
  * {{.Table.TableComment}}Controller
@@ -15,6 +51,9 @@ import {{.ServicePackage}}.I{{.ClassName}}Service;
  * @date {{.Date}}
  */
 @RestController
+{{- if .EnableSwagger}}
+@Tag(name = "{{.ClassName}}", description = "{{.Table.TableComment}}")
+{{- end}}
 @RequestMapping("/{{.Table.TableName}}")
 public class {{.ClassName}}Controller {
 
@@ -25,48 +64,145 @@ public class {{.ClassName}}Controller {
      * 查询{{.Table.TableComment}}列表
      */
     @GetMapping("/list")
-    public List<{{.ClassName}}> list({{.ClassName}} {{.Table.TableName}}) {
-        return {{.Table.TableName}}Service.list();
+{{- with permission .Table "list"}}
+    {{.}}
+{{- end}}
+{{- if .EnableSwagger}}
+    @Operation(summary = "查询{{.Table.TableComment}}列表")
+{{- end}}
+    public List<{{.ClassName}}VO> list({{if .EnableSwagger}}@ParameterObject {{end}}{{.ClassName}}Query query) {
+        return {{.ClassName}}Converter.toVOList({{.Table.TableName}}Service.queryList(query));
     }
 
     /**
      * 查询{{.Table.TableComment}}分页列表
      */
     @GetMapping("/page")
-    public Page<{{.ClassName}}> page(Page<{{.ClassName}}> page, {{.ClassName}} {{.Table.TableName}}) {
-        return {{.Table.TableName}}Service.page(page);
+{{- with permission .Table "list"}}
+    {{.}}
+{{- end}}
+{{- if .EnableSwagger}}
+    @Operation(summary = "查询{{.Table.TableComment}}分页列表")
+    @Parameter(name = "current", in = ParameterIn.QUERY, description = "当前页")
+    @Parameter(name = "size", in = ParameterIn.QUERY, description = "每页条数")
+{{- end}}
+    public IPage<{{.ClassName}}VO> page({{if .EnableSwagger}}@Parameter(hidden = true) {{end}}Page<{{.ClassName}}> page, {{if .EnableSwagger}}@ParameterObject {{end}}{{.ClassName}}Query query) {
+        return {{.Table.TableName}}Service.queryPage(page, query).convert({{.ClassName}}Converter::toVO);
     }
 
     /**
      * 获取{{.Table.TableComment}}详细信息
      */
     @GetMapping("/{id}")
-    public {{.ClassName}} getInfo(@PathVariable("id") {{.Table.PrimaryKey.JavaType}} id) {
-        return {{.Table.TableName}}Service.getById(id);
+{{- with permission .Table "query"}}
+    {{.}}
+{{- end}}
+{{- if .EnableSwagger}}
+    @Operation(summary = "获取{{.Table.TableComment}}详细信息")
+{{- end}}
+    public {{.ClassName}}VO getInfo({{if .EnableSwagger}}@Parameter(description = "{{.Table.PrimaryKey.ColumnComment}}") {{end}}@PathVariable("id") {{.Table.PrimaryKey.JavaType}} id) {
+        return {{.ClassName}}Converter.toVO({{.Table.TableName}}Service.getById(id));
     }
 
     /**
      * 新增{{.Table.TableComment}}
      */
     @PostMapping
-    public boolean add(@RequestBody {{.ClassName}} {{.Table.TableName}}) {
-        return {{.Table.TableName}}Service.save({{.Table.TableName}});
+{{- with permission .Table "create"}}
+    {{.}}
+{{- end}}
+{{- if .EnableSwagger}}
+    @Operation(summary = "新增{{.Table.TableComment}}")
+{{- end}}
+    public boolean add(@Validated @RequestBody {{.ClassName}}CreateDTO dto) {
+        return {{.Table.TableName}}Service.save({{.ClassName}}Converter.toEntity(dto));
     }
 
     /**
      * 修改{{.Table.TableComment}}
      */
     @PutMapping
-    public boolean edit(@RequestBody {{.ClassName}} {{.Table.TableName}}) {
-        return {{.Table.TableName}}Service.updateById({{.Table.TableName}});
+{{- with permission .Table "update"}}
+    {{.}}
+{{- end}}
+{{- if .EnableSwagger}}
+    @Operation(summary = "修改{{.Table.TableComment}}")
+{{- end}}
+    public boolean edit(@Validated @RequestBody {{.ClassName}}UpdateDTO dto) {
+        return {{.Table.TableName}}Service.updateById({{.ClassName}}Converter.toEntity(dto));
     }
 
     /**
      * 删除{{.Table.TableComment}}
      */
     @DeleteMapping("/{id}")
-    public boolean delete(@PathVariable("id") {{.Table.PrimaryKey.JavaType}} id) {
+{{- with permission .Table "delete"}}
+    {{.}}
+{{- end}}
+{{- if .EnableSwagger}}
+    @Operation(summary = "删除{{.Table.TableComment}}")
+{{- end}}
+    public boolean delete({{if .EnableSwagger}}@Parameter(description = "{{.Table.PrimaryKey.ColumnComment}}") {{end}}@PathVariable("id") {{.Table.PrimaryKey.JavaType}} id) {
         return {{.Table.TableName}}Service.removeById(id);
     }
+{{- if .EnableExcel}}
+
+    /**
+     * 按条件导出{{.Table.TableComment}}
+     */
+    @GetMapping("/export")
+{{- with permission .Table "export"}}
+    {{.}}
+{{- end}}
+{{- if .EnableSwagger}}
+    @Operation(summary = "导出{{.Table.TableComment}}")
+{{- end}}
+    public void export(HttpServletResponse response, {{if .EnableSwagger}}@ParameterObject {{end}}{{.ClassName}}Query query) throws IOException {
+        writeExcel(response, "{{.Table.TableComment}}", {{.Table.TableName}}Service.exportList(query));
+    }
+{{- if .Table.CanImport}}
+
+    /**
+     * 下载{{.Table.TableComment}}导入模板
+     */
+    @GetMapping("/import-template")
+{{- with permission .Table "import"}}
+    {{.}}
+{{- end}}
+{{- if .EnableSwagger}}
+    @Operation(summary = "下载{{.Table.TableComment}}导入模板")
+{{- end}}
+    public void importTemplate(HttpServletResponse response) throws IOException {
+        writeExcel(response, "{{.Table.TableComment}}导入模板", Collections.emptyList());
+    }
+
+    /**
+     * 导入{{.Table.TableComment}}，返回行级校验错误
+     */
+    @PostMapping("/import")
+{{- with permission .Table "import"}}
+    {{.}}
+{{- end}}
+{{- if .EnableSwagger}}
+    @Operation(summary = "导入{{.Table.TableComment}}")
+{{- end}}
+    public ExcelImportResult importExcel(@RequestParam("file") MultipartFile file) throws IOException {
+        try (InputStream inputStream = file.getInputStream()) {
+            return {{.Table.TableName}}Service.importExcel(inputStream);
+        }
+    }
+{{- end}}
+
+    /**
+     * 将数据写入 xlsx 格式的响应
+     */
+    private void writeExcel(HttpServletResponse response, String name, List<{{.ClassName}}Excel> data) throws IOException {
+        response.setContentType("application/vnd.openxmlformats-officedocument.spreadsheetml.sheet");
+        response.setCharacterEncoding("utf-8");
+        String fileName = URLEncoder.encode(name, "UTF-8").replace("+", "%20");
+        response.setHeader("Content-Disposition", "attachment; filename*=utf-8''" + fileName + ".xlsx");
+        EasyExcel.write(response.getOutputStream(), {{.ClassName}}Excel.class).sheet(name).doWrite(data);
+    }
+{{- end}}
 
 }
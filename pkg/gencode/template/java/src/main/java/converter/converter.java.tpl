@@ -0,0 +1,106 @@
+@@Meta.Output="/src/main/java/{{.ConverterPackage | replace "." "/"}}/{{.ClassName}}Converter.java"
+
+package {{.ConverterPackage}};
+
+import java.util.List;
+import java.util.stream.Collectors;
+import {{.EntityPackage}}.{{.ClassName}};
+import {{.DtoPackage}}.{{.ClassName}}CreateDTO;
+import {{.DtoPackage}}.{{.ClassName}}UpdateDTO;
+{{- if .EnableExcel}}
+import {{.ExcelPackage}}.{{.ClassName}}Excel;
+{{- end}}
+import {{.VoPackage}}.{{.ClassName}}VO;
+
+/**
+ * {{.Table.TableComment}}对象转换
+ * @author {{.Author}}
+ * @date {{.Date}}
+ */
+public final class {{.ClassName}}Converter {
+
+    private {{.ClassName}}Converter() {
+    }
+
+    /**
+     * 新增参数转实体
+     */
+    public static {{.ClassName}} toEntity({{.ClassName}}CreateDTO dto) {
+        {{.ClassName}} entity = new {{.ClassName}}();
+{{- range .Table.FormFields}}
+        entity.set{{.FieldName | upperFirst}}(dto.get{{.FieldName | upperFirst}}());
+{{- end}}
+        return entity;
+    }
+
+    /**
+     * 修改参数转实体
+     */
+    public static {{.ClassName}} toEntity({{.ClassName}}UpdateDTO dto) {
+        {{.ClassName}} entity = new {{.ClassName}}();
+        entity.set{{.Table.PrimaryKey.FieldName | upperFirst}}(dto.get{{.Table.PrimaryKey.FieldName | upperFirst}}());
+{{- range .Table.FormFields}}
+        entity.set{{.FieldName | upperFirst}}(dto.get{{.FieldName | upperFirst}}());
+{{- end}}
+{{- if .Table.HasVersion}}
+        entity.set{{.Table.VersionField.FieldName | upperFirst}}(dto.get{{.Table.VersionField.FieldName | upperFirst}}());
+{{- end}}
+        return entity;
+    }
+
+    /**
+     * 实体转视图，敏感字段不返回
+     */
+    public static {{.ClassName}}VO toVO({{.ClassName}} entity) {
+        if (entity == null) {
+            return null;
+        }
+        {{.ClassName}}VO vo = new {{.ClassName}}VO();
+{{- range .Table.ResponseFields}}
+        vo.set{{.FieldName | upperFirst}}(entity.get{{.FieldName | upperFirst}}());
+{{- end}}
+        return vo;
+    }
+
+    /**
+     * 实体列表转视图列表
+     */
+    public static List<{{.ClassName}}VO> toVOList(List<{{.ClassName}}> entities) {
+        return entities.stream().map({{.ClassName}}Converter::toVO).collect(Collectors.toList());
+    }
+{{- if .EnableExcel}}
+
+    /**
+     * 实体转导出模型
+     */
+    public static {{.ClassName}}Excel toExcel({{.ClassName}} entity) {
+        {{.ClassName}}Excel excel = new {{.ClassName}}Excel();
+{{- range .Table.ExcelFields}}
+        excel.set{{.FieldName | upperFirst}}(entity.get{{.FieldName | upperFirst}}());
+{{- end}}
+        return excel;
+    }
+
+    /**
+     * 实体列表转导出模型列表
+     */
+    public static List<{{.ClassName}}Excel> toExcelList(List<{{.ClassName}}> entities) {
+        return entities.stream().map({{.ClassName}}Converter::toExcel).collect(Collectors.toList());
+    }
+{{- if .Table.CanImport}}
+
+    /**
+     * 导入模型转新增参数，敏感字段不从 Excel 导入
+     */
+    public static {{.ClassName}}CreateDTO toCreateDTO({{.ClassName}}Excel excel) {
+        {{.ClassName}}CreateDTO dto = new {{.ClassName}}CreateDTO();
+{{- range .Table.FormFields}}
+{{- if .IsExcel}}
+        dto.set{{.FieldName | upperFirst}}(excel.get{{.FieldName | upperFirst}}());
+{{- end}}
+{{- end}}
+        return dto;
+    }
+{{- end}}
+{{- end}}
+}
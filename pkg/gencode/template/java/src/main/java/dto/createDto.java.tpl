@@ -0,0 +1,60 @@
+@@Meta.Output="/src/main/java/{{.DtoPackage | replace "." "/"}}/{{.ClassName}}CreateDTO.java"
+
+package {{.DtoPackage}};
+
+{{if .EnableLombok}}import lombok.Data;
+{{end}}
+import com.fasterxml.jackson.annotation.JsonFormat;
+import {{.Profile.EEPackage}}.validation.constraints.*;
+{{- if .EnableSwagger}}
+import io.swagger.v3.oas.annotations.media.Schema;
+{{- end}}
+import java.io.Serializable;
+import java.math.BigDecimal;
+import {{.Profile.DateImport}};
+{{- if .Table.HasJavaType "LocalDate"}}
+import java.time.LocalDate;
+{{- end}}
+{{- if .Table.HasJavaType "LocalTime"}}
+import java.time.LocalTime;
+{{- end}}
+
+/**
+ * {{.Table.TableComment}}新增参数
+ * @author {{.Author}}
+ * @date {{.Date}}
+ */
+{{if .EnableLombok}}@Data
+{{end}}
+{{- if .EnableSwagger}}@Schema(description = "{{.Table.TableComment}}新增参数")
+{{end}}
+public class {{.ClassName}}CreateDTO implements Serializable {
+
+    private static final long serialVersionUID = 1L;
+{{- range .Table.FormFields}}
+
+    /** {{.ColumnComment}} */
+{{- if $.EnableSwagger}}
+    @Schema(description = "{{.ColumnComment}}")
+{{- end}}
+{{- range .Validations}}
+    {{.}}
+{{- end}}
+{{- if isDateTime .JavaType}}
+    @JsonFormat(pattern = "{{.DatePattern}}", timezone = "GMT+8")
+{{- end}}
+    private {{.JavaType}} {{.FieldName}};
+{{- end}}
+{{- if not .EnableLombok}}
+{{- range .Table.FormFields}}
+
+    public {{.JavaType}} get{{.FieldName | upperFirst}}() {
+        return {{.FieldName}};
+    }
+
+    public void set{{.FieldName | upperFirst}}({{.JavaType}} {{.FieldName}}) {
+        this.{{.FieldName}} = {{.FieldName}};
+    }
+{{- end}}
+{{- end}}
+}
@@ -0,0 +1,93 @@
+@@Meta.Output="/src/main/java/{{.DtoPackage | replace "." "/"}}/{{.ClassName}}UpdateDTO.java"
+
+package {{.DtoPackage}};
+
+{{if .EnableLombok}}import lombok.Data;
+{{end}}
+import com.fasterxml.jackson.annotation.JsonFormat;
+import {{.Profile.EEPackage}}.validation.constraints.*;
+{{- if .EnableSwagger}}
+import io.swagger.v3.oas.annotations.media.Schema;
+{{- end}}
+import java.io.Serializable;
+import java.math.BigDecimal;
+import {{.Profile.DateImport}};
+{{- if .Table.HasJavaType "LocalDate"}}
+import java.time.LocalDate;
+{{- end}}
+{{- if .Table.HasJavaType "LocalTime"}}
+import java.time.LocalTime;
+{{- end}}
+
+/**
+ * {{.Table.TableComment}}修改参数
+ * @author {{.Author}}
+ * @date {{.Date}}
+ */
+{{if .EnableLombok}}@Data
+{{end}}
+{{- if .EnableSwagger}}@Schema(description = "{{.Table.TableComment}}修改参数")
+{{end}}
+public class {{.ClassName}}UpdateDTO implements Serializable {
+
+    private static final long serialVersionUID = 1L;
+
+    /** {{.Table.PrimaryKey.ColumnComment}} */
+{{- if .EnableSwagger}}
+    @Schema(description = "{{.Table.PrimaryKey.ColumnComment}}")
+{{- end}}
+    @NotNull(message = "{{with .Table.PrimaryKey.ColumnComment}}{{.}}{{else}}{{.Table.PrimaryKey.FieldName}}{{end}}不能为空")
+    private {{.Table.PrimaryKey.JavaType}} {{.Table.PrimaryKey.FieldName}};
+{{- range .Table.FormFields}}
+
+    /** {{.ColumnComment}} */
+{{- if $.EnableSwagger}}
+    @Schema(description = "{{.ColumnComment}}")
+{{- end}}
+{{- range .UpdateValidations}}
+    {{.}}
+{{- end}}
+{{- if isDateTime .JavaType}}
+    @JsonFormat(pattern = "{{.DatePattern}}", timezone = "GMT+8")
+{{- end}}
+    private {{.JavaType}} {{.FieldName}};
+{{- end}}
+{{- if .Table.HasVersion}}
+
+    /** 版本号，用于乐观锁校验 */
+{{- if .EnableSwagger}}
+    @Schema(description = "版本号，用于乐观锁校验")
+{{- end}}
+    private {{.Table.VersionField.JavaType}} {{.Table.VersionField.FieldName}};
+{{- end}}
+{{- if not .EnableLombok}}
+
+    public {{.Table.PrimaryKey.JavaType}} get{{.Table.PrimaryKey.FieldName | upperFirst}}() {
+        return {{.Table.PrimaryKey.FieldName}};
+    }
+
+    public void set{{.Table.PrimaryKey.FieldName | upperFirst}}({{.Table.PrimaryKey.JavaType}} {{.Table.PrimaryKey.FieldName}}) {
+        this.{{.Table.PrimaryKey.FieldName}} = {{.Table.PrimaryKey.FieldName}};
+    }
+{{- range .Table.FormFields}}
+
+    public {{.JavaType}} get{{.FieldName | upperFirst}}() {
+        return {{.FieldName}};
+    }
+
+    public void set{{.FieldName | upperFirst}}({{.JavaType}} {{.FieldName}}) {
+        this.{{.FieldName}} = {{.FieldName}};
+    }
+{{- end}}
+{{- if .Table.HasVersion}}{{with .Table.VersionField}}
+
+    public {{.JavaType}} get{{.FieldName | upperFirst}}() {
+        return {{.FieldName}};
+    }
+
+    public void set{{.FieldName | upperFirst}}({{.JavaType}} {{.FieldName}}) {
+        this.{{.FieldName}} = {{.FieldName}};
+    }
+{{- end}}{{end}}
+{{- end}}
+}
@@ -8,10 +8,28 @@ import lombok.experimental.Accessors;
 {{end}}
 import com.baomidou.mybatisplus.annotation.TableName;
 import com.baomidou.mybatisplus.annotation.TableId;
+{{- if and .Table.PrimaryKey.ColumnName (not .Table.PrimaryKey.AutoIncrement)}}
+import com.baomidou.mybatisplus.annotation.IdType;
+{{- end}}
 import com.baomidou.mybatisplus.annotation.TableField;
+{{- if .Table.HasFill}}
+import com.baomidou.mybatisplus.annotation.FieldFill;
+{{- end}}
+{{- if .Table.HasLogicDelete}}
+import com.baomidou.mybatisplus.annotation.TableLogic;
+{{- end}}
+{{- if .Table.HasVersion}}
+import com.baomidou.mybatisplus.annotation.Version;
+{{- end}}
 import java.io.Serializable;
 import java.math.BigDecimal;
-import java.util.Date;
+import {{.Profile.DateImport}};
+{{- if .Table.HasJavaType "LocalDate"}}
+import java.time.LocalDate;
+{{- end}}
+{{- if .Table.HasJavaType "LocalTime"}}
+import java.time.LocalTime;
+{{- end}}
 
 /**
  * {{.Table.TableComment}}
@@ -28,10 +46,27 @@ public class {{.ClassName}} implements Serializable {
     private static final long serialVersionUID = 1L;
 
     {{range .Table.Fields}}
-    {{if .IsPrimaryKey}}@TableId("{{.ColumnName}}")
+    {{if .IsPrimaryKey}}@TableId({{if .AutoIncrement}}"{{.ColumnName}}"{{else}}value = "{{.ColumnName}}", type = IdType.ASSIGN_ID{{end}})
+    {{else if .Fill}}@TableField(value = "{{.ColumnName}}", fill = FieldFill.{{.Fill}})
     {{else}}@TableField("{{.ColumnName}}")
     {{end}}
+    {{- if .LogicDelete}}@TableLogic
+    {{end}}
+    {{- if .Version}}@Version
+    {{end}}
     private {{.JavaType}} {{.FieldName}};
 
     {{end}}
+{{- if not .EnableLombok}}
+{{- range .Table.Fields}}
+
+    public {{.JavaType}} get{{.FieldName | upperFirst}}() {
+        return {{.FieldName}};
+    }
+
+    public void set{{.FieldName | upperFirst}}({{.JavaType}} {{.FieldName}}) {
+        this.{{.FieldName}} = {{.FieldName}};
+    }
+{{- end}}
+{{- end}}
 }
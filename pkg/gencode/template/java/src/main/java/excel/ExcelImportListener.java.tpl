@@ -0,0 +1,60 @@
+@@Meta.Output="/src/main/java/{{.Config.PackageConfig.BasePackage | replace "." "/"}}/excel/ExcelImportListener.java"
+
+{{if .Config.GenConfig.EnableExcel -}}
+package {{.Config.PackageConfig.BasePackage}}.excel;
+
+import com.alibaba.excel.context.AnalysisContext;
+import com.alibaba.excel.exception.ExcelDataConvertException;
+import com.alibaba.excel.metadata.data.ReadCellData;
+import com.alibaba.excel.read.listener.ReadListener;
+import java.util.ArrayList;
+import java.util.HashMap;
+import java.util.LinkedHashMap;
+import java.util.List;
+import java.util.Map;
+
+/**
+ * 读取 Excel 中的数据行，单元格格式不正确时记为行级错误并继续读取
+ */
+public class ExcelImportListener<T> implements ReadListener<T> {
+
+    /** 表头，键为列号 */
+    private final Map<Integer, String> headers = new HashMap<>();
+
+    /** 数据行，键为 Excel 中显示的行号 */
+    private final Map<Integer, T> rows = new LinkedHashMap<>();
+
+    private final List<ExcelImportResult.RowError> errors = new ArrayList<>();
+
+    @Override
+    public void invokeHead(Map<Integer, ReadCellData<?>> headMap, AnalysisContext context) {
+        headMap.forEach((column, cell) -> headers.put(column, cell.getStringValue()));
+    }
+
+    @Override
+    public void invoke(T data, AnalysisContext context) {
+        rows.put(context.readRowHolder().getRowIndex() + 1, data);
+    }
+
+    @Override
+    public void onException(Exception exception, AnalysisContext context) throws Exception {
+        if (!(exception instanceof ExcelDataConvertException)) {
+            throw exception;
+        }
+        ExcelDataConvertException e = (ExcelDataConvertException) exception;
+        errors.add(new ExcelImportResult.RowError(e.getRowIndex() + 1, headers.get(e.getColumnIndex()), "格式不正确"));
+    }
+
+    @Override
+    public void doAfterAllAnalysed(AnalysisContext context) {
+    }
+
+    public Map<Integer, T> getRows() {
+        return rows;
+    }
+
+    public List<ExcelImportResult.RowError> getErrors() {
+        return errors;
+    }
+}
+{{- end}}
@@ -0,0 +1,69 @@
+@@Meta.Output="/src/main/java/{{.Config.PackageConfig.BasePackage | replace "." "/"}}/excel/ExcelImportResult.java"
+
+{{if .Config.GenConfig.EnableExcel -}}
+package {{.Config.PackageConfig.BasePackage}}.excel;
+
+import java.util.List;
+
+/**
+ * Excel 导入结果，存在错误时不导入任何数据
+ */
+public class ExcelImportResult {
+
+    /** 导入的行数 */
+    private final int count;
+
+    /** 行级错误，按行号排序 */
+    private final List<RowError> errors;
+
+    public ExcelImportResult(int count, List<RowError> errors) {
+        this.count = count;
+        this.errors = errors;
+    }
+
+    public int getCount() {
+        return count;
+    }
+
+    public List<RowError> getErrors() {
+        return errors;
+    }
+
+    public boolean isSuccess() {
+        return errors.isEmpty();
+    }
+
+    /**
+     * 行级错误，行号与 Excel 中显示的行号一致
+     */
+    public static class RowError {
+
+        /** 行号 */
+        private final int row;
+
+        /** 出错的列的表头 */
+        private final String column;
+
+        /** 错误信息 */
+        private final String message;
+
+        public RowError(int row, String column, String message) {
+            this.row = row;
+            this.column = column;
+            this.message = message;
+        }
+
+        public int getRow() {
+            return row;
+        }
+
+        public String getColumn() {
+            return column;
+        }
+
+        public String getMessage() {
+            return message;
+        }
+    }
+}
+{{- end}}
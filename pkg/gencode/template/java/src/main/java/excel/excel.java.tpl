@@ -0,0 +1,66 @@
+@@Meta.Output="/src/main/java/{{.ExcelPackage | replace "." "/"}}/{{.ClassName}}Excel.java"
+
+{{if .EnableExcel -}}
+package {{.ExcelPackage}};
+
+import com.alibaba.excel.annotation.ExcelIgnoreUnannotated;
+import com.alibaba.excel.annotation.ExcelProperty;
+import com.alibaba.excel.annotation.format.DateTimeFormat;
+import com.alibaba.excel.annotation.write.style.ColumnWidth;
+{{if .EnableLombok}}import lombok.Data;
+{{end}}
+import java.math.BigDecimal;
+import {{.Profile.DateImport}};
+{{- if .Table.HasJavaType "LocalDate"}}
+import java.time.LocalDate;
+{{- end}}
+{{- if .Table.HasJavaType "LocalTime"}}
+import java.time.LocalTime;
+{{- end}}
+
+/**
+ * {{.Table.TableComment}}导出、导入模型，表头为字段注释
+ * @author {{.Author}}
+ * @date {{.Date}}
+ */
+{{if .EnableLombok}}@Data
+{{end}}@ExcelIgnoreUnannotated
+@ColumnWidth(20)
+public class {{.ClassName}}Excel {
+{{- range $i, $f := .Table.ExcelFields}}
+
+    /** {{.ColumnComment}} */
+    @ExcelProperty(value = "{{or .ColumnComment .FieldName}}", index = {{$i}})
+{{- if isDateTime .JavaType}}
+    @DateTimeFormat("{{.DatePattern}}")
+{{- end}}
+    private {{.JavaType}} {{.FieldName}};
+{{- end}}
+
+    /**
+     * 获取字段的表头，用于导入时的错误信息
+     */
+    public static String header(String field) {
+        switch (field) {
+{{- range .Table.ExcelFields}}
+            case "{{.FieldName}}":
+                return "{{or .ColumnComment .FieldName}}";
+{{- end}}
+            default:
+                return field;
+        }
+    }
+{{- if not .EnableLombok}}
+{{- range .Table.ExcelFields}}
+
+    public {{.JavaType}} get{{.FieldName | upperFirst}}() {
+        return {{.FieldName}};
+    }
+
+    public void set{{.FieldName | upperFirst}}({{.JavaType}} {{.FieldName}}) {
+        this.{{.FieldName}} = {{.FieldName}};
+    }
+{{- end}}
+{{- end}}
+}
+{{- end}}
@@ -17,5 +17,12 @@
         {{.ColumnName}}{{if ne $index (sub (len $.Table.Fields) 1)}},{{end}}
         {{end}}
     </sql>
+{{- if .Table.HasLogicDelete}}
+
+    <!-- 未删除数据条件，自定义SQL需自行拼接 -->
+    <sql id="Not_Deleted">
+        {{.Table.LogicDeleteField.ColumnName}} = 0
+    </sql>
+{{- end}}
 
 </mapper>
\ No newline at end of file
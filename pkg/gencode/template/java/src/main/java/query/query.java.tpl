@@ -0,0 +1,113 @@
+@@Meta.Output="/src/main/java/{{.QueryPackage | replace "." "/"}}/{{.ClassName}}Query.java"
+
+package {{.QueryPackage}};
+
+{{if .EnableLombok}}import lombok.Data;
+{{end}}
+import org.springframework.format.annotation.DateTimeFormat;
+{{- if .EnableSwagger}}
+import io.swagger.v3.oas.annotations.media.Schema;
+{{- end}}
+import java.io.Serializable;
+import java.math.BigDecimal;
+import {{.Profile.DateImport}};
+{{- if .Table.HasJavaType "LocalDate"}}
+import java.time.LocalDate;
+{{- end}}
+{{- if .Table.HasJavaType "LocalTime"}}
+import java.time.LocalTime;
+{{- end}}
+import java.util.List;
+
+/**
+ * {{.Table.TableComment}}查询条件
+ * @author {{.Author}}
+ * @date {{.Date}}
+ */
+{{if .EnableLombok}}@Data
+{{end}}
+{{- if .EnableSwagger}}@Schema(description = "{{.Table.TableComment}}查询条件")
+{{end}}
+public class {{.ClassName}}Query implements Serializable {
+
+    private static final long serialVersionUID = 1L;
+{{- range .Table.QueryFields}}
+{{- if eq .QueryType "between"}}
+
+    /** {{.ColumnComment}}起始 */
+{{- if $.EnableSwagger}}
+    @Schema(description = "{{.ColumnComment}}起始")
+{{- end}}
+{{- if isDateTime .JavaType}}
+    @DateTimeFormat(pattern = "{{.DatePattern}}")
+{{- end}}
+    private {{.JavaType}} begin{{.FieldName | upperFirst}};
+
+    /** {{.ColumnComment}}截止 */
+{{- if $.EnableSwagger}}
+    @Schema(description = "{{.ColumnComment}}截止")
+{{- end}}
+{{- if isDateTime .JavaType}}
+    @DateTimeFormat(pattern = "{{.DatePattern}}")
+{{- end}}
+    private {{.JavaType}} end{{.FieldName | upperFirst}};
+{{- else if eq .QueryType "in"}}
+
+    /** {{.ColumnComment}}列表 */
+{{- if $.EnableSwagger}}
+    @Schema(description = "{{.ColumnComment}}列表")
+{{- end}}
+    private List<{{.JavaType}}> {{.FieldName}}List;
+{{- else}}
+
+    /** {{.ColumnComment}} */
+{{- if $.EnableSwagger}}
+    @Schema(description = "{{.ColumnComment}}")
+{{- end}}
+{{- if isDateTime .JavaType}}
+    @DateTimeFormat(pattern = "{{.DatePattern}}")
+{{- end}}
+    private {{.JavaType}} {{.FieldName}};
+{{- end}}
+{{- end}}
+{{- if not .EnableLombok}}
+{{- range .Table.QueryFields}}
+{{- if eq .QueryType "between"}}
+
+    public {{.JavaType}} getBegin{{.FieldName | upperFirst}}() {
+        return begin{{.FieldName | upperFirst}};
+    }
+
+    public void setBegin{{.FieldName | upperFirst}}({{.JavaType}} begin{{.FieldName | upperFirst}}) {
+        this.begin{{.FieldName | upperFirst}} = begin{{.FieldName | upperFirst}};
+    }
+
+    public {{.JavaType}} getEnd{{.FieldName | upperFirst}}() {
+        return end{{.FieldName | upperFirst}};
+    }
+
+    public void setEnd{{.FieldName | upperFirst}}({{.JavaType}} end{{.FieldName | upperFirst}}) {
+        this.end{{.FieldName | upperFirst}} = end{{.FieldName | upperFirst}};
+    }
+{{- else if eq .QueryType "in"}}
+
+    public List<{{.JavaType}}> get{{.FieldName | upperFirst}}List() {
+        return {{.FieldName}}List;
+    }
+
+    public void set{{.FieldName | upperFirst}}List(List<{{.JavaType}}> {{.FieldName}}List) {
+        this.{{.FieldName}}List = {{.FieldName}}List;
+    }
+{{- else}}
+
+    public {{.JavaType}} get{{.FieldName | upperFirst}}() {
+        return {{.FieldName}};
+    }
+
+    public void set{{.FieldName | upperFirst}}({{.JavaType}} {{.FieldName}}) {
+        this.{{.FieldName}} = {{.FieldName}};
+    }
+{{- end}}
+{{- end}}
+{{- end}}
+}
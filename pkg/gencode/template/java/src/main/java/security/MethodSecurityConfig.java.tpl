@@ -0,0 +1,25 @@
+@@Meta.Output="/src/main/java/{{.Config.PackageConfig.BasePackage | replace "." "/"}}/security/MethodSecurityConfig.java"
+
+{{if eq auth "spring-security" -}}
+package {{.Config.PackageConfig.BasePackage}}.security;
+
+import org.springframework.context.annotation.Configuration;
+{{- if eq .Profile.Name "boot3"}}
+import org.springframework.security.config.annotation.method.configuration.EnableMethodSecurity;
+{{- else}}
+import org.springframework.security.config.annotation.method.configuration.EnableGlobalMethodSecurity;
+{{- end}}
+
+/**
+ * 开启 @PreAuthorize 权限注解，登录用户的权限标识（如 sys:user:list）作为 GrantedAuthority 授予，
+ * 与 db/menu.sql 中菜单和按钮的 perms 一致
+ */
+@Configuration
+{{- if eq .Profile.Name "boot3"}}
+@EnableMethodSecurity
+{{- else}}
+@EnableGlobalMethodSecurity(prePostEnabled = true)
+{{- end}}
+public class MethodSecurityConfig {
+}
+{{- end}}
@@ -0,0 +1,26 @@
+@@Meta.Output="/src/main/java/{{.Config.PackageConfig.BasePackage | replace "." "/"}}/security/SaTokenConfig.java"
+
+{{if eq auth "sa-token" -}}
+package {{.Config.PackageConfig.BasePackage}}.security;
+
+import cn.dev33.satoken.interceptor.SaInterceptor;
+import org.springframework.context.annotation.Configuration;
+import org.springframework.context.annotation.Profile;
+import org.springframework.web.servlet.config.annotation.InterceptorRegistry;
+import org.springframework.web.servlet.config.annotation.WebMvcConfigurer;
+
+/**
+ * 注册 Sa-Token 拦截器，使 @SaCheckPermission 生效，测试环境不校验权限。
+ * 登录用户的权限标识（如 sys:user:list）由实现 StpInterface 的 Bean 提供，
+ * 与 db/menu.sql 中菜单和按钮的 perms 一致
+ */
+@Configuration
+@Profile("!test")
+public class SaTokenConfig implements WebMvcConfigurer {
+
+    @Override
+    public void addInterceptors(InterceptorRegistry registry) {
+        registry.addInterceptor(new SaInterceptor()).addPathPatterns("/**");
+    }
+}
+{{- end}}
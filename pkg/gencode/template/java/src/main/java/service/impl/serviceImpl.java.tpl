@@ -3,9 +3,38 @@
 package {{.ServicePackage}}.impl;
 
 import org.springframework.stereotype.Service;
+{{- if and .EnableExcel .Table.CanImport}}
+import org.springframework.beans.factory.annotation.Autowired;
+import com.alibaba.excel.EasyExcel;
+import {{.Profile.EEPackage}}.validation.ConstraintViolation;
+import {{.Profile.EEPackage}}.validation.Validator;
+import java.io.InputStream;
+import java.util.ArrayList;
+import java.util.Comparator;
+{{- end}}
+{{- if .Table.HasVersion}}
+import org.springframework.dao.OptimisticLockingFailureException;
+{{- end}}
+import com.baomidou.mybatisplus.core.conditions.query.LambdaQueryWrapper;
+{{- if .Table.HasStringLike}}
+import com.baomidou.mybatisplus.core.toolkit.StringUtils;
+{{- end}}
+import com.baomidou.mybatisplus.core.toolkit.Wrappers;
+import com.baomidou.mybatisplus.extension.plugins.pagination.Page;
 import com.baomidou.mybatisplus.extension.service.impl.ServiceImpl;
+import java.util.List;
 import {{.EntityPackage}}.{{.ClassName}};
+{{- if .EnableExcel}}
+import {{.ConverterPackage}}.{{.ClassName}}Converter;
+{{- if .Table.CanImport}}
+import {{.DtoPackage}}.{{.ClassName}}CreateDTO;
+import {{.ExcelPackage}}.ExcelImportListener;
+import {{.ExcelPackage}}.ExcelImportResult;
+{{- end}}
+import {{.ExcelPackage}}.{{.ClassName}}Excel;
+{{- end}}
 import {{.MapperPackage}}.{{.ClassName}}Mapper;
+import {{.QueryPackage}}.{{.ClassName}}Query;
 import {{.ServicePackage}}.I{{.ClassName}}Service;
 
 /**
@@ -15,5 +44,86 @@ import {{.ServicePackage}}.I{{.ClassName}}Service;
  */
 @Service
 public class {{.ClassName}}ServiceImpl extends ServiceImpl<{{.ClassName}}Mapper, {{.ClassName}}> implements I{{.ClassName}}Service {
+{{- if and .EnableExcel .Table.CanImport}}
+
+    @Autowired
+    private Validator validator;
+{{- end}}
+
+    @Override
+    public List<{{.ClassName}}> queryList({{.ClassName}}Query query) {
+        return list(buildQueryWrapper(query));
+    }
+
+    @Override
+    public Page<{{.ClassName}}> queryPage(Page<{{.ClassName}}> page, {{.ClassName}}Query query) {
+        return page(page, buildQueryWrapper(query));
+    }
+{{- if .EnableExcel}}
+
+    @Override
+    public List<{{.ClassName}}Excel> exportList({{.ClassName}}Query query) {
+        return {{.ClassName}}Converter.toExcelList(queryList(query));
+    }
+{{- if .Table.CanImport}}
+
+    @Override
+    public ExcelImportResult importExcel(InputStream inputStream) {
+        ExcelImportListener<{{.ClassName}}Excel> listener = new ExcelImportListener<>();
+        EasyExcel.read(inputStream, {{.ClassName}}Excel.class, listener).sheet().doRead();
+
+        // 按新增参数的校验规则逐行校验
+        List<ExcelImportResult.RowError> errors = new ArrayList<>(listener.getErrors());
+        List<{{.ClassName}}> entities = new ArrayList<>();
+        listener.getRows().forEach((row, excel) -> {
+            {{.ClassName}}CreateDTO dto = {{.ClassName}}Converter.toCreateDTO(excel);
+            for (ConstraintViolation<{{.ClassName}}CreateDTO> violation : validator.validate(dto)) {
+                errors.add(new ExcelImportResult.RowError(row, {{.ClassName}}Excel.header(violation.getPropertyPath().toString()), violation.getMessage()));
+            }
+            entities.add({{.ClassName}}Converter.toEntity(dto));
+        });
+        if (!errors.isEmpty()) {
+            errors.sort(Comparator.comparingInt(ExcelImportResult.RowError::getRow));
+            return new ExcelImportResult(0, errors);
+        }
+        saveBatch(entities);
+        return new ExcelImportResult(entities.size(), errors);
+    }
+{{- end}}
+{{- end}}
+{{- if .Table.HasVersion}}
+
+    /**
+     * 按版本号更新，版本号不一致时说明数据已被他人修改
+     */
+    @Override
+    public boolean updateById({{.ClassName}} entity) {
+        boolean updated = super.updateById(entity);
+        if (!updated && entity.get{{.Table.VersionField.FieldName | upperFirst}}() != null) {
+            throw new OptimisticLockingFailureException("数据已被修改，请刷新后重试");
+        }
+        return updated;
+    }
+{{- end}}
+
+    /**
+     * 构建{{.Table.TableComment}}查询条件
+     */
+    private LambdaQueryWrapper<{{.ClassName}}> buildQueryWrapper({{.ClassName}}Query query) {
+        LambdaQueryWrapper<{{.ClassName}}> wrapper = Wrappers.lambdaQuery();
+{{- range .Table.QueryFields}}
+{{- if eq .QueryType "between"}}
+        wrapper.ge(query.getBegin{{.FieldName | upperFirst}}() != null, {{$.ClassName}}::get{{.FieldName | upperFirst}}, query.getBegin{{.FieldName | upperFirst}}());
+        wrapper.le(query.getEnd{{.FieldName | upperFirst}}() != null, {{$.ClassName}}::get{{.FieldName | upperFirst}}, query.getEnd{{.FieldName | upperFirst}}());
+{{- else if eq .QueryType "in"}}
+        wrapper.in(query.get{{.FieldName | upperFirst}}List() != null && !query.get{{.FieldName | upperFirst}}List().isEmpty(), {{$.ClassName}}::get{{.FieldName | upperFirst}}, query.get{{.FieldName | upperFirst}}List());
+{{- else if eq .QueryType "like"}}
+        wrapper.like({{if eq .JavaType "String"}}StringUtils.isNotBlank(query.get{{.FieldName | upperFirst}}()){{else}}query.get{{.FieldName | upperFirst}}() != null{{end}}, {{$.ClassName}}::get{{.FieldName | upperFirst}}, query.get{{.FieldName | upperFirst}}());
+{{- else}}
+        wrapper.eq(query.get{{.FieldName | upperFirst}}() != null, {{$.ClassName}}::get{{.FieldName | upperFirst}}, query.get{{.FieldName | upperFirst}}());
+{{- end}}
+{{- end}}
+        return wrapper;
+    }
 
 }
@@ -2,8 +2,20 @@
 
 package {{.ServicePackage}};
 
+import com.baomidou.mybatisplus.extension.plugins.pagination.Page;
 import com.baomidou.mybatisplus.extension.service.IService;
+{{- if and .EnableExcel .Table.CanImport}}
+import java.io.InputStream;
+{{- end}}
+import java.util.List;
 import {{.EntityPackage}}.{{.ClassName}};
+{{- if .EnableExcel}}
+{{- if .Table.CanImport}}
+import {{.ExcelPackage}}.ExcelImportResult;
+{{- end}}
+import {{.ExcelPackage}}.{{.ClassName}}Excel;
+{{- end}}
+import {{.QueryPackage}}.{{.ClassName}}Query;
 
 /**
  * {{.Table.TableComment}}Service接口
@@ -12,4 +24,28 @@ import {{.EntityPackage}}.{{.ClassName}};
  */
 public interface I{{.ClassName}}Service extends IService<{{.ClassName}}> {
 
+    /**
+     * 按条件查询{{.Table.TableComment}}列表
+     */
+    List<{{.ClassName}}> queryList({{.ClassName}}Query query);
+
+    /**
+     * 按条件查询{{.Table.TableComment}}分页列表
+     */
+    Page<{{.ClassName}}> queryPage(Page<{{.ClassName}}> page, {{.ClassName}}Query query);
+{{- if .EnableExcel}}
+
+    /**
+     * 按条件查询导出的{{.Table.TableComment}}列表
+     */
+    List<{{.ClassName}}Excel> exportList({{.ClassName}}Query query);
+{{- if .Table.CanImport}}
+
+    /**
+     * 导入{{.Table.TableComment}}，逐行校验，存在错误时不导入任何数据
+     */
+    ExcelImportResult importExcel(InputStream inputStream);
+{{- end}}
+{{- end}}
+
 }
@@ -0,0 +1,19 @@
+@@Meta.Output="/src/main/resources/application-local.yml"
+
+# 本地开发环境，连接 docker-compose.yml 启动的 MySQL 和 Redis
+# 在宿主机上运行时使用映射到 localhost 的端口，在 compose 中运行时由环境变量指向服务名
+spring:
+  datasource:
+    url: jdbc:mysql://${DB_HOST:localhost}:${DB_PORT:3306}/${DB_NAME:{{.Config.PackageConfig.BasePackage | replace "." "_"}}}?useUnicode=true&characterEncoding=utf8&useSSL=false&allowPublicKeyRetrieval=true&serverTimezone=GMT%2B8
+    username: ${DB_USERNAME:app}
+    password: ${DB_PASSWORD:app123456}
+{{- if eq .Profile.Name "boot3"}}
+  data:
+    redis:
+      host: ${REDIS_HOST:localhost}
+      port: ${REDIS_PORT:6379}
+{{- else}}
+  redis:
+    host: ${REDIS_HOST:localhost}
+    port: ${REDIS_PORT:6379}
+{{- end}}
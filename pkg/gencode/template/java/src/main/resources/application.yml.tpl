@@ -9,19 +9,16 @@ spring:
   application:
     name: {{.Config.PackageConfig.BasePackage | replace "." "-"}}-service
   
-  # 数据源配置
+  # 未指定环境时使用 application-local.yml
+  profiles:
+    default: local
+
+  # 数据源配置，连接信息由环境变量注入
   datasource:
     driver-class-name: com.mysql.cj.jdbc.Driver
-    url: jdbc:mysql://localhost:3306/{{.Config.PackageConfig.BasePackage | replace "." "_"}}?useUnicode=true&characterEncoding=utf8&zeroDateTimeBehavior=convertToNull&useSSL=true&serverTimezone=GMT%2B8
-    username: root
-    password: 123456
-    
-  # JPA配置
-  jpa:
-    hibernate:
-      ddl-auto: update
-    show-sql: true
-    database-platform: org.hibernate.dialect.MySQL8Dialect
+    url: jdbc:mysql://${DB_HOST}:${DB_PORT:3306}/${DB_NAME}?useUnicode=true&characterEncoding=utf8&zeroDateTimeBehavior=convertToNull&useSSL=true&serverTimezone=GMT%2B8
+    username: ${DB_USERNAME}
+    password: ${DB_PASSWORD}
 
 # MyBatis Plus配置
 mybatis-plus:
@@ -34,9 +31,7 @@ mybatis-plus:
     db-config:
       # 主键策略
       id-type: auto
-      # 逻辑删除字段
-      logic-delete-field: deleted
-      # 逻辑删除值
+      # 逻辑删除值（逻辑删除字段由实体的 @TableLogic 注解标识）
       logic-delete-value: 1
       # 逻辑未删除值
       logic-not-delete-value: 0
@@ -44,6 +39,15 @@ mybatis-plus:
   mapper-locations: classpath*:mapper/*.xml
   # 实体类包路径
   type-aliases-package: {{.Config.PackageConfig.EntityPackage}}
+{{- if .Config.GenConfig.EnableSwagger}}
+
+# 接口文档配置
+springdoc:
+  api-docs:
+    path: /v3/api-docs
+  swagger-ui:
+    path: /swagger-ui.html
+{{- end}}
 
 # 日志配置
 logging:
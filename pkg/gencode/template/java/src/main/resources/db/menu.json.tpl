@@ -0,0 +1,5 @@
+@@Meta.Output="/src/main/resources/db/menu.json"
+
+{{if auth -}}
+{{menuJSON .Tables}}
+{{- end}}
@@ -0,0 +1,8 @@
+@@Meta.Output="/src/main/resources/db/menu.sql"
+
+{{if auth -}}
+-- 菜单和按钮权限，写入若依风格的 sys_menu 表，菜单挂在顶级，可按需修改 parent_id
+-- 重复执行会插入重复的菜单
+
+{{menuSQL .Tables}}
+{{- end}}
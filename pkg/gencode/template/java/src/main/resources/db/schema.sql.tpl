@@ -0,0 +1,4 @@
+@@Meta.Output="/src/main/resources/db/schema.sql"
+-- {{.Config.ProjectName}} 数据库结构，由代码生成器生成
+{{range .Tables}}
+{{createTable .}}{{end}}
\ No newline at end of file
@@ -0,0 +1,98 @@
+@@Meta.Output="/src/test/java/{{.ControllerPackage | replace "." "/"}}/{{.ClassName}}ControllerTest.java"
+
+package {{.ControllerPackage}};
+
+import com.fasterxml.jackson.databind.ObjectMapper;
+import org.junit.jupiter.api.Test;
+import org.springframework.beans.factory.annotation.Autowired;
+import org.springframework.boot.test.autoconfigure.web.servlet.AutoConfigureMockMvc;
+import org.springframework.boot.test.context.SpringBootTest;
+import org.springframework.http.MediaType;
+{{- if eq auth "spring-security"}}
+import org.springframework.security.test.context.support.WithMockUser;
+{{- end}}
+import org.springframework.test.context.ActiveProfiles;
+import org.springframework.test.web.servlet.MockMvc;
+import org.springframework.transaction.annotation.Transactional;
+import java.math.BigDecimal;
+import {{.Profile.DateImport}};
+{{- if .Table.HasJavaType "LocalDate"}}
+import java.time.LocalDate;
+{{- end}}
+{{- if .Table.HasJavaType "LocalTime"}}
+import java.time.LocalTime;
+{{- end}}
+import {{.DtoPackage}}.{{.ClassName}}CreateDTO;
+
+import static org.springframework.test.web.servlet.request.MockMvcRequestBuilders.*;
+import static org.springframework.test.web.servlet.result.MockMvcResultMatchers.*;
+
+/**
+ * {{.Table.TableComment}}Controller测试
+ * @author {{.Author}}
+ * @date {{.Date}}
+ */
+@SpringBootTest
+{{- if eq auth "spring-security"}}
+// 跳过登录和 CSRF 过滤器，以拥有权限标识的模拟用户调用接口
+@AutoConfigureMockMvc(addFilters = false)
+@WithMockUser(authorities = {"{{.Table.Permission "list"}}", "{{.Table.Permission "create"}}"})
+{{- else}}
+@AutoConfigureMockMvc
+{{- end}}
+@ActiveProfiles("test")
+@Transactional
+class {{.ClassName}}ControllerTest {
+
+    @Autowired
+    private MockMvc mockMvc;
+
+    @Autowired
+    private ObjectMapper objectMapper;
+
+    /**
+     * 根据字段类型构造示例请求
+     */
+    private {{.ClassName}}CreateDTO sample() {
+        {{.ClassName}}CreateDTO dto = new {{.ClassName}}CreateDTO();
+{{- range .Table.FormFields}}
+        dto.set{{.FieldName | upperFirst}}({{javaSample .}});
+{{- end}}
+        return dto;
+    }
+
+    @Test
+    void add() throws Exception {
+        mockMvc.perform(post("/{{.Table.TableName}}")
+                        .contentType(MediaType.APPLICATION_JSON)
+                        .content(objectMapper.writeValueAsString(sample())))
+                .andExpect(status().isOk())
+                .andExpect(content().string("true"));
+    }
+{{- $required := false}}
+{{- range .Table.FormFields}}{{if .IsRequired}}{{$required = true}}{{end}}{{end}}
+{{- if $required}}
+
+    @Test
+    void addInvalid() throws Exception {
+        mockMvc.perform(post("/{{.Table.TableName}}")
+                        .contentType(MediaType.APPLICATION_JSON)
+                        .content("{}"))
+                .andExpect(status().isBadRequest());
+    }
+{{- end}}
+
+    @Test
+    void list() throws Exception {
+        mockMvc.perform(get("/{{.Table.TableName}}/list"))
+                .andExpect(status().isOk())
+                .andExpect(jsonPath("$").isArray());
+    }
+
+    @Test
+    void page() throws Exception {
+        mockMvc.perform(get("/{{.Table.TableName}}/page"))
+                .andExpect(status().isOk())
+                .andExpect(jsonPath("$.records").isArray());
+    }
+}
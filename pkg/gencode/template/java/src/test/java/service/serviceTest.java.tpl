@@ -0,0 +1,86 @@
+@@Meta.Output="/src/test/java/{{.ServicePackage | replace "." "/"}}/{{.ClassName}}ServiceTest.java"
+
+package {{.ServicePackage}};
+
+import org.junit.jupiter.api.Test;
+import org.springframework.beans.factory.annotation.Autowired;
+import org.springframework.boot.test.context.SpringBootTest;
+import org.springframework.test.context.ActiveProfiles;
+import org.springframework.transaction.annotation.Transactional;
+import java.math.BigDecimal;
+import {{.Profile.DateImport}};
+{{- if .Table.HasJavaType "LocalDate"}}
+import java.time.LocalDate;
+{{- end}}
+{{- if .Table.HasJavaType "LocalTime"}}
+import java.time.LocalTime;
+{{- end}}
+import java.util.List;
+import {{.EntityPackage}}.{{.ClassName}};
+import {{.QueryPackage}}.{{.ClassName}}Query;
+
+import static org.junit.jupiter.api.Assertions.*;
+
+/**
+ * {{.Table.TableComment}}Service测试
+ * @author {{.Author}}
+ * @date {{.Date}}
+ */
+@SpringBootTest
+@ActiveProfiles("test")
+@Transactional
+class {{.ClassName}}ServiceTest {
+
+    @Autowired
+    private I{{.ClassName}}Service {{.ClassName | lowerFirst}}Service;
+
+    /**
+     * 根据字段类型构造示例数据
+     */
+    private {{.ClassName}} sample() {
+        {{.ClassName}} entity = new {{.ClassName}}();
+{{- range .Table.Fields}}
+{{- if .IsPrimaryKey}}
+{{- if eq .JavaType "String"}}
+        entity.set{{.FieldName | upperFirst}}({{javaSample .}});
+{{- end}}
+{{- else if not (or .Fill .LogicDelete .Version)}}
+        entity.set{{.FieldName | upperFirst}}({{javaSample .}});
+{{- end}}
+{{- end}}
+        return entity;
+    }
+
+    @Test
+    void saveAndGet() {
+        {{.ClassName}} entity = sample();
+        assertTrue({{.ClassName | lowerFirst}}Service.save(entity));
+
+        {{.ClassName}} saved = {{.ClassName | lowerFirst}}Service.getById(entity.get{{.Table.PrimaryKey.FieldName | upperFirst}}());
+        assertNotNull(saved);
+{{- range .Table.FormFields}}
+{{- if eq .JavaType "BigDecimal"}}
+        assertEquals(0, entity.get{{.FieldName | upperFirst}}().compareTo(saved.get{{.FieldName | upperFirst}}()));
+{{- else if not (or (isDateTime .JavaType) (eq .JavaType "byte[]"))}}
+        assertEquals(entity.get{{.FieldName | upperFirst}}(), saved.get{{.FieldName | upperFirst}}());
+{{- end}}
+{{- end}}
+    }
+
+    @Test
+    void queryList() {
+        {{.ClassName | lowerFirst}}Service.save(sample());
+
+        List<{{.ClassName}}> list = {{.ClassName | lowerFirst}}Service.queryList(new {{.ClassName}}Query());
+        assertFalse(list.isEmpty());
+    }
+
+    @Test
+    void removeById() {
+        {{.ClassName}} entity = sample();
+        {{.ClassName | lowerFirst}}Service.save(entity);
+
+        assertTrue({{.ClassName | lowerFirst}}Service.removeById(entity.get{{.Table.PrimaryKey.FieldName | upperFirst}}()));
+        assertNull({{.ClassName | lowerFirst}}Service.getById(entity.get{{.Table.PrimaryKey.FieldName | upperFirst}}()));
+    }
+}
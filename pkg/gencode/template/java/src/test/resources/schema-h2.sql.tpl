@@ -0,0 +1,16 @@
+@@Meta.Output="/src/test/resources/schema-h2.sql"
+
+{{- range .Tables}}
+{{- $table := .}}
+
+-- {{.TableComment}}
+DROP TABLE IF EXISTS {{.TableName}};
+CREATE TABLE {{.TableName}} (
+{{- range $i, $field := .Fields}}
+    {{.ColumnName}} {{h2Type .}}
+{{- if eq .ColumnName $table.PrimaryKey.ColumnName}}{{if .AutoIncrement}} AUTO_INCREMENT{{end}} PRIMARY KEY
+{{- else}}{{if or .LogicDelete .Version}} DEFAULT 0{{end}}{{if not .IsNullable}} NOT NULL{{end}}
+{{- end}}{{if lt (add $i 1) (len $table.Fields)}},{{end}}
+{{- end}}
+);
+{{- end}}
@@ -0,0 +1,94 @@
+@@Meta.Output="/api/{{.Table.TableName}}/v1/{{.Table.TableName}}.proto"
+
+syntax = "proto3";
+
+package {{.Table.TableName}}.v1;
+
+import "google/protobuf/empty.proto";
+{{- if .Table.HasGoType "time.Time"}}
+import "google/protobuf/timestamp.proto";
+{{- end}}
+import "google/protobuf/field_mask.proto";
+import "google/api/annotations.proto";
+import "google/api/field_behavior.proto";
+
+option go_package = "{{.Config.ProjectName}}/api/{{.Table.TableName}}/v1;v1";
+
+// {{.ClassName}} is the {{.Table.TableComment}}.
+message {{.ClassName}} {
+{{- range $i, $field := .Table.Fields}}
+{{- if $field.ColumnComment}}
+  // {{$field.ColumnComment}}
+{{- end}}
+  {{protoType $field.GoType}} {{$field.ColumnName}} = {{add $i 1}};
+{{- end}}
+}
+
+// {{.ClassName}}Set is the set of {{.Table.TableComment}}.
+message {{.ClassName}}Set {
+  repeated {{.ClassName}} {{.Table.TableName}}s = 1;
+  string next_page_token = 2;
+}
+
+// {{.ClassName}}Service is the {{.Table.TableComment}} service definition.
+service {{.ClassName}}Service {
+  rpc List{{.ClassName}}s(List{{.ClassName}}sRequest) returns ({{.ClassName}}Set) {
+    option (google.api.http) = {
+      get: "/v1/{{.Table.TableName}}s/list"
+    };
+  }
+  rpc Create{{.ClassName}}(Create{{.ClassName}}Request) returns ({{.ClassName}}) {
+    option (google.api.http) = {
+      post: "/v1/{{.Table.TableName}}s/create"
+      body: "{{.Table.TableName}}"
+    };
+  }
+  rpc Update{{.ClassName}}(Update{{.ClassName}}Request) returns ({{.ClassName}}) {
+    option (google.api.http) = {
+      put: "/v1/{{.Table.TableName}}s/update"
+      body: "{{.Table.TableName}}"
+    };
+  }
+  rpc Delete{{.ClassName}}(Delete{{.ClassName}}Request) returns (google.protobuf.Empty) {
+    option (google.api.http) = {
+      delete: "/v1/{{.Table.TableName}}s/{id}"
+    };
+  }
+  rpc Get{{.ClassName}}(Get{{.ClassName}}Request) returns ({{.ClassName}}) {
+    option (google.api.http) = {
+      get: "/v1/{{.Table.TableName}}s/{id}"
+    };
+  }
+}
+
+message Get{{.ClassName}}Request {
+  {{protoType .Table.PrimaryKey.GoType}} id = 1 [(google.api.field_behavior) = REQUIRED];
+}
+
+message List{{.ClassName}}sRequest {
+  // Optional. The number of items per page.
+  int32 page_size = 1;
+  // Optional. The page token.
+  string page_token = 2;
+  // Optional. The standard list filter, see [AIP-160](https://google.aip.dev/160).
+  // Supported fields:
+{{- range .Table.QueryFields}}
+  //    * `{{.ColumnName}}`
+{{- end}}
+  string filter = 3;
+  // Optional. A comma-separated list of fields to order by, i.e. `{{.Table.PrimaryKey.ColumnName}} desc`.
+  string order_by = 4;
+}
+
+message Create{{.ClassName}}Request {
+  {{.ClassName}} {{.Table.TableName}} = 1 [(google.api.field_behavior) = REQUIRED];
+}
+
+message Update{{.ClassName}}Request {
+  {{.ClassName}} {{.Table.TableName}} = 1 [(google.api.field_behavior) = REQUIRED];
+  google.protobuf.FieldMask update_mask = 2 [(google.api.field_behavior) = REQUIRED];
+}
+
+message Delete{{.ClassName}}Request {
+  {{protoType .Table.PrimaryKey.GoType}} id = 1 [(google.api.field_behavior) = REQUIRED];
+}
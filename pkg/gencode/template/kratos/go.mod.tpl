@@ -0,0 +1,17 @@
+@@Meta.Output="/go.mod"
+
+module {{.Config.ProjectName}}
+
+go 1.24
+
+require (
+	entgo.io/ent v0.14.5
+	github.com/go-kratos/aip-go/ents v0.0.0-20251213081434-74ffa1fc1588
+	github.com/go-kratos/kratos/v2 v2.9.2
+	github.com/google/wire v0.7.0
+	github.com/mattn/go-sqlite3 v1.14.17
+	go.einride.tech/aip v0.78.0
+	google.golang.org/genproto/googleapis/api v0.0.0-20251222181119-0a764e51fe1b
+	google.golang.org/grpc v1.78.0
+	google.golang.org/protobuf v1.36.11
+)
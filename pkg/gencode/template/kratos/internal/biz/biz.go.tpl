@@ -0,0 +1,8 @@
+@@Meta.Output="/internal/biz/biz.go"
+
+package biz
+
+import "github.com/google/wire"
+
+// ProviderSet is biz providers.
+var ProviderSet = wire.NewSet({{range $i, $table := .Tables}}{{if $i}}, {{end}}New{{pascal $table.TableName}}Usecase{{end}})
@@ -0,0 +1,66 @@
+@@Meta.Output="/internal/biz/{{.Table.TableName}}.go"
+
+package biz
+
+import (
+	"context"
+{{- if .Table.HasGoType "time.Time"}}
+	"time"
+{{- end}}
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Err{{.ClassName}}NotFound error {{.Table.TableName}} not found.
+var Err{{.ClassName}}NotFound = errors.NotFound("{{.Table.TableName | upper}}", "{{.Table.TableName}} not found")
+
+// {{.ClassName}} is a {{.Table.TableComment}} model.
+type {{.ClassName}} struct {
+{{- range .Table.Fields}}
+	{{entName .ColumnName}} {{.GoType}}{{if .ColumnComment}} // {{.ColumnComment}}{{end}}
+{{- end}}
+}
+
+// {{.ClassName}}Repo is a {{.Table.TableComment}} repo.
+type {{.ClassName}}Repo interface {
+	FindByID(context.Context, {{.Table.PrimaryKey.GoType}}) (*{{.ClassName}}, error)
+	List{{.ClassName}}s(context.Context, ...ListOption) ([]*{{.ClassName}}, error)
+	Create{{.ClassName}}(context.Context, *{{.ClassName}}) (*{{.ClassName}}, error)
+	Update{{.ClassName}}(context.Context, *{{.ClassName}}) (*{{.ClassName}}, error)
+	Delete{{.ClassName}}(context.Context, {{.Table.PrimaryKey.GoType}}) error
+}
+
+// {{.ClassName}}Usecase is a {{.Table.TableComment}} usecase.
+type {{.ClassName}}Usecase struct {
+	repo {{.ClassName}}Repo
+}
+
+// New{{.ClassName}}Usecase new a {{.Table.TableComment}} usecase.
+func New{{.ClassName}}Usecase(repo {{.ClassName}}Repo) *{{.ClassName}}Usecase {
+	return &{{.ClassName}}Usecase{repo: repo}
+}
+
+// Get{{.ClassName}} retrieves a {{.Table.TableComment}} by ID.
+func (uc *{{.ClassName}}Usecase) Get{{.ClassName}}(ctx context.Context, id {{.Table.PrimaryKey.GoType}}) (*{{.ClassName}}, error) {
+	return uc.repo.FindByID(ctx, id)
+}
+
+// List{{.ClassName}}s lists {{.Table.TableComment}} with filtering, ordering and pagination.
+func (uc *{{.ClassName}}Usecase) List{{.ClassName}}s(ctx context.Context, opts ...ListOption) ([]*{{.ClassName}}, error) {
+	return uc.repo.List{{.ClassName}}s(ctx, opts...)
+}
+
+// Create{{.ClassName}} creates a new {{.Table.TableComment}}.
+func (uc *{{.ClassName}}Usecase) Create{{.ClassName}}(ctx context.Context, m *{{.ClassName}}) (*{{.ClassName}}, error) {
+	return uc.repo.Create{{.ClassName}}(ctx, m)
+}
+
+// Update{{.ClassName}} updates an existing {{.Table.TableComment}}.
+func (uc *{{.ClassName}}Usecase) Update{{.ClassName}}(ctx context.Context, m *{{.ClassName}}) (*{{.ClassName}}, error) {
+	return uc.repo.Update{{.ClassName}}(ctx, m)
+}
+
+// Delete{{.ClassName}} deletes a {{.Table.TableComment}} by ID.
+func (uc *{{.ClassName}}Usecase) Delete{{.ClassName}}(ctx context.Context, id {{.Table.PrimaryKey.GoType}}) error {
+	return uc.repo.Delete{{.ClassName}}(ctx, id)
+}
@@ -0,0 +1,22 @@
+@@Meta.Output="/internal/data/data.go"
+
+package data
+
+import (
+	"{{.Config.ProjectName}}/internal/data/ent"
+
+	"github.com/google/wire"
+)
+
+// ProviderSet is data providers.
+var ProviderSet = wire.NewSet(NewData{{range .Tables}}, New{{pascal .TableName}}Repo{{end}})
+
+// Data is a struct that contains the database client.
+type Data struct {
+	db *ent.Client
+}
+
+// NewData creates a new Data instance.
+func NewData(db *ent.Client) *Data {
+	return &Data{db: db}
+}
@@ -0,0 +1,5 @@
+@@Meta.Output="/internal/data/ent/generate.go"
+
+package ent
+
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate ./schema --feature sql/execquery --feature sql/upsert
@@ -0,0 +1,39 @@
+@@Meta.Output="/internal/data/ent/schema/{{.Table.TableName}}.go"
+
+package schema
+
+import (
+{{- if .Table.HasFillTime}}
+	"time"
+{{end}}
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// {{.ClassName}} holds the schema definition for the {{.ClassName}} entity.
+type {{.ClassName}} struct {
+	ent.Schema
+}
+
+// Annotations of the {{.ClassName}}.
+func ({{.ClassName}}) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "{{.Table.TableName}}"},
+	}
+}
+
+// Fields of the {{.ClassName}}.
+func ({{.ClassName}}) Fields() []ent.Field {
+	return []ent.Field{
+{{- range .Table.Fields}}
+{{- if eq .ColumnName $.Table.PrimaryKey.ColumnName}}
+		field.{{entType .GoType}}("id"){{if ne .ColumnName "id"}}.StorageKey("{{.ColumnName}}"){{end}}.Unique().Immutable(){{if .ColumnComment}}.Comment({{printf "%q" .ColumnComment}}){{end}},
+{{- else}}
+		field.{{entType .GoType}}("{{.ColumnName}}"){{if .IsNullable}}.Optional(){{end}}
+{{- if .IsFillTime}}.Default(time.Now){{if eq .Fill "INSERT"}}.Immutable(){{else}}.UpdateDefault(time.Now){{end}}{{end}}{{if .ColumnComment}}.Comment({{printf "%q" .ColumnComment}}){{end}},
+{{- end}}
+{{- end}}
+	}
+}
@@ -0,0 +1,110 @@
+@@Meta.Output="/internal/data/{{.Table.TableName}}.go"
+
+package data
+
+import (
+	"context"
+
+	"{{.Config.ProjectName}}/internal/biz"
+	"{{.Config.ProjectName}}/internal/data/ent"
+
+	"github.com/go-kratos/aip-go/ents"
+)
+
+func convert{{.ClassName}}(po *ent.{{.ClassName}}) *biz.{{.ClassName}} {
+	return &biz.{{.ClassName}}{
+{{- range .Table.Fields}}
+{{- if eq .ColumnName $.Table.PrimaryKey.ColumnName}}
+		{{entName .ColumnName}}: po.ID,
+{{- else}}
+		{{entName .ColumnName}}: po.{{entName .ColumnName}},
+{{- end}}
+{{- end}}
+	}
+}
+
+type {{.Table.TableName | pascal | lowerFirst}}Repo struct {
+	data *Data
+}
+
+// New{{.ClassName}}Repo creates a new {{.ClassName}}Repo instance.
+func New{{.ClassName}}Repo(data *Data) biz.{{.ClassName}}Repo {
+	return &{{.Table.TableName | pascal | lowerFirst}}Repo{
+		data: data,
+	}
+}
+
+func (r *{{.Table.TableName | pascal | lowerFirst}}Repo) FindByID(ctx context.Context, id {{.Table.PrimaryKey.GoType}}) (*biz.{{.ClassName}}, error) {
+	po, err := r.data.db.{{.ClassName}}.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.Err{{.ClassName}}NotFound
+		}
+		return nil, err
+	}
+	return convert{{.ClassName}}(po), nil
+}
+
+func (r *{{.Table.TableName | pascal | lowerFirst}}Repo) List{{.ClassName}}s(ctx context.Context, opts ...biz.ListOption) ([]*biz.{{.ClassName}}, error) {
+	o := biz.ListOptions{Limit: 20}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	pos, err := r.data.db.{{.ClassName}}.Query().
+		Where(ents.ApplyFilter(o.Filter)).
+		Order(ents.ApplyOrderBy(o.OrderBy)).
+		Offset(o.Offset).
+		Limit(o.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var items []*biz.{{.ClassName}}
+	for _, po := range pos {
+		items = append(items, convert{{.ClassName}}(po))
+	}
+	return items, nil
+}
+
+func (r *{{.Table.TableName | pascal | lowerFirst}}Repo) Create{{.ClassName}}(ctx context.Context, m *biz.{{.ClassName}}) (*biz.{{.ClassName}}, error) {
+	po, err := r.data.db.{{.ClassName}}.Create().
+{{- range .Table.Fields}}
+{{- if eq .ColumnName $.Table.PrimaryKey.ColumnName}}
+{{- if eq .GoType "string"}}
+		SetID(m.{{entName .ColumnName}}).
+{{- end}}
+{{- else if not .IsFillTime}}
+		Set{{entName .ColumnName}}(m.{{entName .ColumnName}}).
+{{- end}}
+{{- end}}
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convert{{.ClassName}}(po), nil
+}
+
+func (r *{{.Table.TableName | pascal | lowerFirst}}Repo) Update{{.ClassName}}(ctx context.Context, m *biz.{{.ClassName}}) (*biz.{{.ClassName}}, error) {
+	po, err := r.data.db.{{.ClassName}}.UpdateOneID(m.{{entName .Table.PrimaryKey.ColumnName}}).
+{{- range .Table.Fields}}
+{{- if and (ne .ColumnName $.Table.PrimaryKey.ColumnName) (not .IsFillTime)}}
+		Set{{entName .ColumnName}}(m.{{entName .ColumnName}}).
+{{- end}}
+{{- end}}
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.Err{{.ClassName}}NotFound
+		}
+		return nil, err
+	}
+	return convert{{.ClassName}}(po), nil
+}
+
+func (r *{{.Table.TableName | pascal | lowerFirst}}Repo) Delete{{.ClassName}}(ctx context.Context, id {{.Table.PrimaryKey.GoType}}) error {
+	err := r.data.db.{{.ClassName}}.DeleteOneID(id).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return biz.Err{{.ClassName}}NotFound
+	}
+	return err
+}
@@ -0,0 +1,86 @@
+@@Meta.Output="/internal/data/{{.Table.TableName}}_test.go"
+
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+{{- $time := false}}
+{{- range .Table.Fields}}{{if and (eq .GoType "time.Time") (not .IsFillTime) (not .IsPrimaryKey)}}{{$time = true}}{{end}}{{end}}
+{{- if $time}}
+	"time"
+{{- end}}
+
+	"{{.Config.ProjectName}}/internal/biz"
+	"{{.Config.ProjectName}}/internal/data/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func Test{{.ClassName}}Repo(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+	repo := New{{.ClassName}}Repo(&Data{db: client})
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		in   *biz.{{.ClassName}}
+	}{
+		{
+			name: "sample values",
+			in: &biz.{{.ClassName}}{
+{{- range .Table.Fields}}
+{{- if .IsPrimaryKey}}
+{{- if eq .GoType "string"}}
+				{{entName .ColumnName}}: "sample",
+{{- end}}
+{{- else if not .IsFillTime}}
+				{{entName .ColumnName}}: {{goSample .}},
+{{- end}}
+{{- end}}
+			},
+		},
+		{
+			name: "zero values",
+			in: &biz.{{.ClassName}}{
+{{- if eq .Table.PrimaryKey.GoType "string"}}
+				{{entName .Table.PrimaryKey.ColumnName}}: "zero",
+{{- end}}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created, err := repo.Create{{.ClassName}}(ctx, tt.in)
+			if err != nil {
+				t.Fatalf("Create{{.ClassName}}() error = %v", err)
+			}
+			got, err := repo.FindByID(ctx, created.{{entName .Table.PrimaryKey.ColumnName}})
+			if err != nil {
+				t.Fatalf("FindByID() error = %v", err)
+			}
+{{- range .Table.Fields}}
+{{- if not (or .IsPrimaryKey .IsFillTime (eq .GoType "time.Time") (eq .GoType "[]byte"))}}
+			if got.{{entName .ColumnName}} != tt.in.{{entName .ColumnName}} {
+				t.Errorf("{{entName .ColumnName}} = %v, want %v", got.{{entName .ColumnName}}, tt.in.{{entName .ColumnName}})
+			}
+{{- end}}
+{{- end}}
+			if _, err := repo.Update{{.ClassName}}(ctx, got); err != nil {
+				t.Fatalf("Update{{.ClassName}}() error = %v", err)
+			}
+			items, err := repo.List{{.ClassName}}s(ctx)
+			if err != nil || len(items) == 0 {
+				t.Fatalf("List{{.ClassName}}s() = %d items, error = %v", len(items), err)
+			}
+			if err := repo.Delete{{.ClassName}}(ctx, created.{{entName .Table.PrimaryKey.ColumnName}}); err != nil {
+				t.Fatalf("Delete{{.ClassName}}() error = %v", err)
+			}
+			if _, err := repo.FindByID(ctx, created.{{entName .Table.PrimaryKey.ColumnName}}); !errors.Is(err, biz.Err{{.ClassName}}NotFound) {
+				t.Errorf("FindByID() after delete error = %v, want %v", err, biz.Err{{.ClassName}}NotFound)
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+@@Meta.Output="/internal/service/service.go"
+
+package service
+
+import "github.com/google/wire"
+
+// ProviderSet is service providers.
+var ProviderSet = wire.NewSet({{range $i, $table := .Tables}}{{if $i}}, {{end}}New{{pascal $table.TableName}}Service{{end}})
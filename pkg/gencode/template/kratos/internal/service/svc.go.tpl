@@ -0,0 +1,147 @@
+@@Meta.Output="/internal/service/{{.Table.TableName}}.go"
+
+package service
+
+import (
+	"context"
+
+	v1 "{{.Config.ProjectName}}/api/{{.Table.TableName}}/v1"
+	"{{.Config.ProjectName}}/internal/biz"
+
+	"go.einride.tech/aip/fieldmask"
+	"go.einride.tech/aip/filtering"
+	"go.einride.tech/aip/ordering"
+	"go.einride.tech/aip/pagination"
+	"google.golang.org/protobuf/types/known/emptypb"
+{{- if .Table.HasGoType "time.Time"}}
+	"google.golang.org/protobuf/types/known/timestamppb"
+{{- end}}
+)
+
+func convert{{.ClassName}}(m *biz.{{.ClassName}}) *v1.{{.ClassName}} {
+	return &v1.{{.ClassName}}{
+{{- range .Table.ResponseFields}}
+{{- if eq .GoType "time.Time"}}
+		{{pascal .ColumnName}}: timestamppb.New(m.{{entName .ColumnName}}),
+{{- else if or (eq .GoType "int16") (eq .GoType "int8")}}
+		{{pascal .ColumnName}}: int32(m.{{entName .ColumnName}}),
+{{- else}}
+		{{pascal .ColumnName}}: m.{{entName .ColumnName}},
+{{- end}}
+{{- end}}
+	}
+}
+
+func convert{{.ClassName}}Proto(m *v1.{{.ClassName}}) *biz.{{.ClassName}} {
+	return &biz.{{.ClassName}}{
+{{- range .Table.Fields}}
+{{- if eq .GoType "time.Time"}}
+		{{entName .ColumnName}}: m.Get{{pascal .ColumnName}}().AsTime(),
+{{- else if or (eq .GoType "int16") (eq .GoType "int8")}}
+		{{entName .ColumnName}}: {{.GoType}}(m.Get{{pascal .ColumnName}}()),
+{{- else}}
+		{{entName .ColumnName}}: m.Get{{pascal .ColumnName}}(),
+{{- end}}
+{{- end}}
+	}
+}
+
+// {{.ClassName}}Service is a {{.Table.TableComment}} service.
+type {{.ClassName}}Service struct {
+	v1.Unimplemented{{.ClassName}}ServiceServer
+
+	uc *biz.{{.ClassName}}Usecase
+}
+
+// New{{.ClassName}}Service new a {{.Table.TableComment}} service.
+func New{{.ClassName}}Service(uc *biz.{{.ClassName}}Usecase) *{{.ClassName}}Service {
+	return &{{.ClassName}}Service{uc: uc}
+}
+
+// Create{{.ClassName}} implements {{.Table.TableComment}} creation.
+func (s *{{.ClassName}}Service) Create{{.ClassName}}(ctx context.Context, req *v1.Create{{.ClassName}}Request) (*v1.{{.ClassName}}, error) {
+	m, err := s.uc.Create{{.ClassName}}(ctx, convert{{.ClassName}}Proto(req.{{.ClassName}}))
+	if err != nil {
+		return nil, err
+	}
+	return convert{{.ClassName}}(m), nil
+}
+
+// Update{{.ClassName}} implements {{.Table.TableComment}} update.
+func (s *{{.ClassName}}Service) Update{{.ClassName}}(ctx context.Context, req *v1.Update{{.ClassName}}Request) (*v1.{{.ClassName}}, error) {
+	current, err := s.Get{{.ClassName}}(ctx, &v1.Get{{.ClassName}}Request{Id: req.{{.ClassName}}.Get{{pascal .Table.PrimaryKey.ColumnName}}()})
+	if err != nil {
+		return nil, err
+	}
+	fieldmask.Update(req.UpdateMask, current, req.{{.ClassName}})
+	m, err := s.uc.Update{{.ClassName}}(ctx, convert{{.ClassName}}Proto(current))
+	if err != nil {
+		return nil, err
+	}
+	return convert{{.ClassName}}(m), nil
+}
+
+// Delete{{.ClassName}} implements {{.Table.TableComment}} deletion.
+func (s *{{.ClassName}}Service) Delete{{.ClassName}}(ctx context.Context, req *v1.Delete{{.ClassName}}Request) (*emptypb.Empty, error) {
+	if err := s.uc.Delete{{.ClassName}}(ctx, {{template "pkValue" .}}); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Get{{.ClassName}} implements {{.Table.TableComment}} retrieval.
+func (s *{{.ClassName}}Service) Get{{.ClassName}}(ctx context.Context, req *v1.Get{{.ClassName}}Request) (*v1.{{.ClassName}}, error) {
+	m, err := s.uc.Get{{.ClassName}}(ctx, {{template "pkValue" .}})
+	if err != nil {
+		return nil, err
+	}
+	return convert{{.ClassName}}(m), nil
+}
+
+// List{{.ClassName}}s implements {{.Table.TableComment}} listing with filtering, ordering, and pagination.
+func (s *{{.ClassName}}Service) List{{.ClassName}}s(ctx context.Context, req *v1.List{{.ClassName}}sRequest) (*v1.{{.ClassName}}Set, error) {
+	declarations, err := filtering.NewDeclarations(
+		filtering.DeclareStandardFunctions(),
+{{- range .Table.QueryFields}}
+		filtering.DeclareIdent("{{.ColumnName}}", {{filterType .GoType}}),
+{{- end}}
+	)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := filtering.ParseFilter(req, declarations)
+	if err != nil {
+		return nil, err
+	}
+	pageToken, err := pagination.ParsePageToken(req)
+	if err != nil {
+		return nil, err
+	}
+	orderBy, err := ordering.ParseOrderBy(req)
+	if err != nil {
+		return nil, err
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	items, err := s.uc.List{{.ClassName}}s(ctx,
+		biz.ListFilter(filter),
+		biz.ListOrderBy(orderBy),
+		biz.ListLimit(int(req.PageSize)),
+		biz.ListOffset(int(pageToken.Offset)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	set := &v1.{{.ClassName}}Set{
+		{{.ClassName}}s: make([]*v1.{{.ClassName}}, 0, len(items)),
+	}
+	if len(items) >= int(req.PageSize) {
+		set.NextPageToken = pageToken.Next(req).String()
+	}
+	for _, item := range items {
+		set.{{.ClassName}}s = append(set.{{.ClassName}}s, convert{{.ClassName}}(item))
+	}
+	return set, nil
+}
+{{- define "pkValue"}}{{if or (eq .Table.PrimaryKey.GoType "int16") (eq .Table.PrimaryKey.GoType "int8")}}{{.Table.PrimaryKey.GoType}}(req.Id){{else}}req.Id{{end}}{{end}}
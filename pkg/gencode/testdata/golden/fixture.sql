@@ -0,0 +1,25 @@
+CREATE TABLE `sys_dept` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT '部门ID',
+  `parent_id` bigint DEFAULT NULL COMMENT '上级部门',
+  `dept_name` varchar(64) NOT NULL COMMENT '部门名称',
+  `create_time` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB COMMENT='部门表';
+
+CREATE TABLE `sys_user` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT '用户ID',
+  `dept_id` bigint DEFAULT NULL COMMENT '部门ID',
+  `username` varchar(32) NOT NULL COMMENT '用户名',
+  `password` varchar(128) NOT NULL COMMENT '密码',
+  `email` varchar(128) DEFAULT NULL COMMENT '邮箱',
+  `gender` enum('male','female') DEFAULT NULL COMMENT '性别',
+  `balance` decimal(10,2) NOT NULL DEFAULT '0.00' COMMENT '余额',
+  `status` tinyint NOT NULL DEFAULT '0' COMMENT '状态',
+  `deleted` tinyint NOT NULL DEFAULT '0' COMMENT '逻辑删除',
+  `version` int NOT NULL DEFAULT '0' COMMENT '版本号',
+  `create_time` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `update_time` datetime DEFAULT NULL ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_username` (`username`),
+  KEY `idx_dept_id` (`dept_id`)
+) ENGINE=InnoDB COMMENT='用户表';
@@ -0,0 +1,136 @@
+package gencode
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// envPattern 配置值中的环境变量引用 ${NAME}、${NAME:-默认值}，$$ 表示字面量 $
+var envPattern = regexp.MustCompile(`\$\$|\$\{([A-Za-z_][A-Za-z0-9_]*)(:-[^}]*)?\}`)
+
+// ExpandConfig 展开配置中所有字符串值的环境变量引用，包括 Vars 中的值，只展开一次，
+// GenerateCode 会自动调用，需要在生成前校验展开后的配置时可提前调用
+func (g *Generator) ExpandConfig() error {
+	if g.expanded {
+		return nil
+	}
+	lookup := g.LookupEnv
+	if lookup == nil {
+		lookup = os.LookupEnv
+	}
+	expand := func(s string) (string, error) {
+		return expandEnv(s, lookup, g.Config.GenConfig.Strict)
+	}
+
+	config, err := expandValue(reflect.ValueOf(g.Config), expand)
+	if err != nil {
+		return err
+	}
+	g.Config = config.Interface().(Config)
+	g.expanded = true
+	return nil
+}
+
+// expandEnv 展开字符串中的环境变量引用，变量未定义或为空时使用默认值，
+// 没有默认值时展开为空，严格模式下报错
+func expandEnv(s string, lookup func(string) (string, bool), strict bool) (string, error) {
+	var missing []string
+	result := envPattern.ReplaceAllStringFunc(s, func(ref string) string {
+		if ref == "$$" {
+			return "$"
+		}
+		match := envPattern.FindStringSubmatch(ref)
+		name, defaultValue := match[1], match[2]
+		value, ok := lookup(name)
+		if ok && (value != "" || defaultValue == "") {
+			return value
+		}
+		if defaultValue != "" {
+			return strings.TrimPrefix(defaultValue, ":-")
+		}
+		if strict {
+			missing = append(missing, name)
+		}
+		return ""
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("未定义的环境变量: %s", strings.Join(missing, ", "))
+	}
+	return result, nil
+}
+
+// expandValue 递归展开结构体、切片、映射中的字符串，返回副本，不修改原值
+func expandValue(v reflect.Value, expand func(string) (string, error)) (reflect.Value, error) {
+	switch v.Kind() {
+	case reflect.String:
+		s, err := expand(v.String())
+		if err != nil {
+			return v, err
+		}
+		return reflect.ValueOf(s).Convert(v.Type()), nil
+	case reflect.Struct:
+		out := reflect.New(v.Type()).Elem()
+		out.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if !out.Field(i).CanSet() {
+				continue
+			}
+			field, err := expandValue(v.Field(i), expand)
+			if err != nil {
+				return v, err
+			}
+			out.Field(i).Set(field)
+		}
+		return out, nil
+	case reflect.Slice:
+		if v.IsNil() {
+			return v, nil
+		}
+		out := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elem, err := expandValue(v.Index(i), expand)
+			if err != nil {
+				return v, err
+			}
+			out.Index(i).Set(elem)
+		}
+		return out, nil
+	case reflect.Map:
+		if v.IsNil() {
+			return v, nil
+		}
+		out := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			elem, err := expandValue(iter.Value(), expand)
+			if err != nil {
+				return v, err
+			}
+			out.SetMapIndex(iter.Key(), elem)
+		}
+		return out, nil
+	case reflect.Interface:
+		if v.IsNil() {
+			return v, nil
+		}
+		elem, err := expandValue(v.Elem(), expand)
+		if err != nil {
+			return v, err
+		}
+		out := reflect.New(v.Type()).Elem()
+		out.Set(elem)
+		return out, nil
+	}
+	return v, nil
+}
+
+// missingKey 模板中引用映射不存在的键时的处理方式，严格模式下报错，如未定义的 .Vars 变量
+func (g *Generator) missingKey() string {
+	if g.Config.GenConfig.Strict {
+		return "missingkey=error"
+	}
+	return "missingkey=default"
+}
@@ -0,0 +1,103 @@
+package gencode
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExpandEnv(t *testing.T) {
+	env := map[string]string{"COMPANY": "Acme", "EMPTY": ""}
+	lookup := func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+
+	testCases := []struct {
+		input    string
+		strict   bool
+		expected string
+		err      bool
+	}{
+		{"${COMPANY} Inc.", false, "Acme Inc.", false},
+		{"${MISSING}", false, "", false},
+		{"${MISSING:-default}", true, "default", false},
+		{"${EMPTY:-default}", false, "default", false},
+		{"${EMPTY}", true, "", false},
+		{"$${COMPANY} $5", false, "${COMPANY} $5", false},
+		{"${MISSING}", true, "", true},
+	}
+	for _, tc := range testCases {
+		result, err := expandEnv(tc.input, lookup, tc.strict)
+		if (err != nil) != tc.err {
+			t.Errorf("expandEnv(%q) 错误 = %v", tc.input, err)
+			continue
+		}
+		if result != tc.expected {
+			t.Errorf("expandEnv(%q) = %q, 期望 %q", tc.input, result, tc.expected)
+		}
+	}
+}
+
+func TestGenerateVars(t *testing.T) {
+	// 使用自定义模板目录
+	templatePath := t.TempDir()
+	templateDir := filepath.Join(templatePath, "pkg/gencode/template/java")
+	if err := os.MkdirAll(templateDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	notice := "@@Meta.Output=\"/NOTICE\"\n{{.Vars.company}} {{.Vars.license.name}} {{.Config.ProjectName}} {{.Config.GenConfig.Author}}\n"
+	if err := os.WriteFile(filepath.Join(templateDir, "NOTICE.tpl"), []byte(notice), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	env := map[string]string{"TEAM": "platform", "LICENSE": "MIT"}
+	newGenerator := func(outputPath string, strict bool, vars map[string]any) *Generator {
+		config := testConfig(outputPath)
+		config.ProjectName = "${TEAM}-service"
+		config.GenConfig.Author = "${AUTHOR:-CodeGenerator}"
+		config.GenConfig.Strict = strict
+		config.Vars = vars
+		g := NewGenerator(config, testTables())
+		g.TemplatePath = templatePath
+		g.LookupEnv = func(key string) (string, bool) {
+			value, ok := env[key]
+			return value, ok
+		}
+		return g
+	}
+
+	vars := map[string]any{
+		"company": "Acme",
+		"license": map[string]any{"name": "${LICENSE}"},
+	}
+	outputPath := t.TempDir()
+	g := newGenerator(outputPath, true, vars)
+	if err := g.GenerateCode(); err != nil {
+		t.Fatalf("生成代码失败: %v", err)
+	}
+	content, err := os.ReadFile(filepath.Join(outputPath, "NOTICE"))
+	if err != nil {
+		t.Fatalf("读取文件失败: %v", err)
+	}
+	if expected := "Acme MIT platform-service CodeGenerator\n"; string(content) != expected {
+		t.Errorf("NOTICE = %q, 期望 %q", content, expected)
+	}
+	if vars["license"].(map[string]any)["name"] != "${LICENSE}" {
+		t.Errorf("展开环境变量不应修改原配置")
+	}
+
+	// 严格模式下引用未定义的变量时报错
+	err = newGenerator(t.TempDir(), true, map[string]any{"company": "Acme"}).GenerateCode()
+	if err == nil || !strings.Contains(err.Error(), "license") {
+		t.Errorf("未定义的 .Vars 变量应报错: %v", err)
+	}
+	err = newGenerator(t.TempDir(), true, map[string]any{"company": "${COMPANY}", "license": map[string]any{}}).GenerateCode()
+	if err == nil || !strings.Contains(err.Error(), "COMPANY") {
+		t.Errorf("未定义的环境变量应报错: %v", err)
+	}
+	if err := newGenerator(t.TempDir(), false, nil).GenerateCode(); err != nil {
+		t.Errorf("非严格模式不应报错: %v", err)
+	}
+}
@@ -0,0 +1,428 @@
+package gencode
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// WatchOptions 监听模式配置
+type WatchOptions struct {
+	ConfigFile string        // JSON 项目文件，格式见 ProjectFile，变更时重新加载并全部重新生成
+	DDLFile    string        // MySQL 建表语句文件，变更时只为有变化的表重新生成
+	Output     io.Writer     // 输出每个文件的生成状态，默认 os.Stdout
+	Delay      time.Duration // 合并连续变更的等待时间，默认 100ms
+}
+
+// ProjectFile 监听模式读取的项目文件，表结构字段名与清单中的表结构快照相同
+type ProjectFile struct {
+	Config Config  `json:"config"`
+	Tables []Table `json:"tables"`
+}
+
+// Watch 监听模板目录和表结构来源，变更后只重新生成受影响的文件，直到 ctx 结束。
+// 内容未变化的文件不会重写，开启 KeepModified 时跳过手动修改过的文件；
+// 监听模式不生成迁移脚本，也不清理过期文件，需要时执行一次 GenerateCode
+func (g *Generator) Watch(ctx context.Context, opts WatchOptions) error {
+	if opts.Output == nil {
+		opts.Output = os.Stdout
+	}
+	if opts.Delay <= 0 {
+		opts.Delay = 100 * time.Millisecond
+	}
+
+	// 监听到的变更路径与监听目录的形式一致，统一使用绝对路径比较
+	for _, file := range []*string{&opts.ConfigFile, &opts.DDLFile} {
+		if *file == "" {
+			continue
+		}
+		abs, err := filepath.Abs(*file)
+		if err != nil {
+			return err
+		}
+		*file = abs
+	}
+
+	err := g.loadConfigFile(opts.ConfigFile)
+	if err != nil {
+		return err
+	}
+	err = g.loadDDLFile(opts.DDLFile)
+	if err != nil {
+		return err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("创建文件监听失败: %v", err)
+	}
+	defer watcher.Close()
+
+	// 编辑器通常以重命名的方式保存文件，因此监听所在目录
+	for _, file := range []string{opts.ConfigFile, opts.DDLFile} {
+		if file == "" {
+			continue
+		}
+		err = watcher.Add(filepath.Dir(file))
+		if err != nil {
+			return fmt.Errorf("监听文件失败 [%s]: %v", file, err)
+		}
+	}
+	// 使用内嵌的模板时模板不会变更，无需监听
+	if g.templatesOnDisk() {
+		err = g.watchTemplateDirs(watcher, g.watchedTemplateDir())
+		if err != nil {
+			return err
+		}
+	}
+
+	// 启动时生成全部文件
+	g.regenerate(opts.Output, func(tables []Table) error {
+		templates, err := g.scanTemplates()
+		if err != nil {
+			return err
+		}
+		return g.renderTemplates(ctx, templates, tables, nil)
+	})
+
+	pending := map[string]bool{}
+	timer := time.NewTimer(opts.Delay)
+	timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			// 新建的模板子目录同样需要监听
+			if event.Has(fsnotify.Create) && g.isTemplatePath(event.Name) {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+					_ = g.watchTemplateDirs(watcher, event.Name)
+				}
+			}
+			if g.isWatchedFile(event.Name, opts) {
+				pending[filepath.Clean(event.Name)] = true
+				timer.Reset(opts.Delay)
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			fmt.Fprintf(opts.Output, "监听出错: %v\n", err)
+		case <-timer.C:
+			g.handleChanges(ctx, watcher, opts, pending)
+			pending = map[string]bool{}
+		}
+	}
+}
+
+// handleChanges 根据变更的文件重新生成受影响的文件
+func (g *Generator) handleChanges(ctx context.Context, watcher *fsnotify.Watcher, opts WatchOptions, changed map[string]bool) {
+	out := opts.Output
+
+	// 项目文件变更后配置和表结构都可能变化，全部重新生成
+	if opts.ConfigFile != "" && changed[filepath.Clean(opts.ConfigFile)] {
+		fmt.Fprintf(out, "项目文件已变更: %s\n", opts.ConfigFile)
+		err := g.loadConfigFile(opts.ConfigFile)
+		if err == nil {
+			err = g.loadDDLFile(opts.DDLFile)
+		}
+		if err == nil {
+			err = g.watchTemplateDirs(watcher, g.watchedTemplateDir())
+		}
+		if err != nil {
+			fmt.Fprintf(out, "加载失败: %v\n", err)
+			return
+		}
+		g.regenerate(out, func(tables []Table) error {
+			templates, err := g.scanTemplates()
+			if err != nil {
+				return err
+			}
+			return g.renderTemplates(ctx, templates, tables, nil)
+		})
+		return
+	}
+
+	// 表结构变更时，按表生成的模板只为有变化的表重新生成
+	var only map[string]bool
+	if opts.DDLFile != "" && changed[filepath.Clean(opts.DDLFile)] {
+		fmt.Fprintf(out, "表结构已变更: %s\n", opts.DDLFile)
+		prev := g.Tables
+		err := g.loadDDLFile(opts.DDLFile)
+		if err != nil {
+			fmt.Fprintf(out, "加载失败: %v\n", err)
+			return
+		}
+		var removed []string
+		only, removed = diffTableNames(prev, g.Tables)
+		for _, name := range removed {
+			fmt.Fprintf(out, "表已删除: %s，其生成的文件在下次完整生成时清理\n", name)
+		}
+	}
+
+	// 变更的模板为所有表重新生成
+	var templates []TemplateInfo
+	for _, path := range sortedPaths(changed) {
+		if !g.isTemplatePath(path) {
+			continue
+		}
+		if _, err := os.Stat(path); err != nil {
+			fmt.Fprintf(out, "模板已删除: %s，其生成的文件在下次完整生成时清理\n", g.relTemplatePath(path))
+			continue
+		}
+		fmt.Fprintf(out, "模板已变更: %s\n", g.relTemplatePath(path))
+		tmplInfo, err := g.parseTemplateInfo(path)
+		if err != nil {
+			fmt.Fprintf(out, "解析模板信息失败 [%s]: %v\n", g.relTemplatePath(path), err)
+			continue
+		}
+		templates = append(templates, tmplInfo)
+	}
+	if only == nil && len(templates) == 0 {
+		return
+	}
+
+	g.regenerate(out, func(tables []Table) error {
+		if len(templates) > 0 {
+			err := g.renderTemplates(ctx, templates, tables, nil)
+			if err != nil {
+				return err
+			}
+		}
+		if only == nil {
+			return nil
+		}
+		all, err := g.scanTemplates()
+		if err != nil {
+			return err
+		}
+		return g.renderTemplates(ctx, all, tables, only)
+	})
+}
+
+// regenerate 在内存中执行 render，只将内容有变化的文件写入磁盘，并输出每个文件的状态
+func (g *Generator) regenerate(out io.Writer, render func(tables []Table) error) {
+	start := time.Now()
+	err := g.ExpandConfig()
+	if err != nil {
+		fmt.Fprintf(out, "展开配置失败: %v\n", err)
+		return
+	}
+	err = g.checkConfig()
+	if err != nil {
+		fmt.Fprintf(out, "生成失败: %v\n", err)
+		return
+	}
+
+	tables, err := g.prepareTables()
+	if err != nil {
+		fmt.Fprintf(out, "生成失败: %v\n", err)
+		return
+	}
+	g.files = map[string][]byte{}
+	g.manifest = nil
+	err = render(tables)
+	files := g.files
+	g.files = nil
+	if err != nil {
+		fmt.Fprintf(out, "生成失败: %v\n", err)
+		return
+	}
+
+	written, err := g.writeChanged(out, files)
+	if err == nil && len(written) > 0 {
+		err = g.afterRun(written)
+	}
+	if err != nil {
+		fmt.Fprintf(out, "写入失败: %v\n", err)
+		return
+	}
+	fmt.Fprintf(out, "已渲染 %d 个文件，写入 %d 个，耗时 %s\n", len(files), len(written), time.Since(start).Round(time.Millisecond))
+}
+
+// writeChanged 写入内容有变化的文件，并更新清单中对应文件的哈希，返回写入的文件
+func (g *Generator) writeChanged(out io.Writer, files map[string][]byte) ([]string, error) {
+	manifest, err := LoadManifest(g.outputDir())
+	if err != nil {
+		return nil, err
+	}
+
+	var written []string
+	updated := false
+	for _, output := range sortedPaths(files) {
+		content := files[output]
+		status := "新增"
+		existing, err := os.ReadFile(filepath.Join(g.outputDir(), filepath.FromSlash(output)))
+		if err == nil {
+			if bytes.Equal(existing, content) {
+				continue
+			}
+			status = "更新"
+		}
+
+		entry, ok := manifest.Lookup(output)
+		if ok && g.Config.GenConfig.KeepModified && g.isModified(entry) {
+			fmt.Fprintf(out, "保留 %s（已手动修改）\n", output)
+			continue
+		}
+		err = g.writeOutput(output, content)
+		if err != nil {
+			return written, err
+		}
+		written = append(written, output)
+		fmt.Fprintf(out, "%s %s\n", status, output)
+
+		// 更新清单中的哈希，避免下次生成时误判为手动修改
+		for i := range manifest.Files {
+			if manifest.Files[i].Output == output {
+				manifest.Files[i].Hash = hashContent(content)
+				updated = true
+			}
+		}
+	}
+
+	if updated {
+		return written, manifest.Save(g.outputDir())
+	}
+	return written, nil
+}
+
+// loadConfigFile 加载项目文件中的配置，项目文件包含表结构时一并替换
+func (g *Generator) loadConfigFile(path string) error {
+	if path == "" {
+		return nil
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("读取项目文件失败: %v", err)
+	}
+	var project ProjectFile
+	err = json.Unmarshal(content, &project)
+	if err != nil {
+		return fmt.Errorf("解析项目文件失败: %v", err)
+	}
+
+	if project.Config.GenConfig.Date == "" {
+		project.Config.GenConfig.Date = time.Now().Format("2006-01-02")
+	}
+	g.Config = project.Config
+	g.expanded = false
+	if len(project.Tables) > 0 {
+		g.Tables = make([]Table, len(project.Tables))
+		for i, table := range project.Tables {
+			g.Tables[i] = CompleteTable(table)
+		}
+	}
+	return nil
+}
+
+// loadDDLFile 解析建表语句文件中的表结构
+func (g *Generator) loadDDLFile(path string) error {
+	if path == "" {
+		return nil
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("读取建表语句失败: %v", err)
+	}
+	tables, err := ParseDDL(string(content))
+	if err != nil {
+		return fmt.Errorf("解析建表语句失败: %v", err)
+	}
+	g.Tables = tables
+	return nil
+}
+
+// watchTemplateDirs 监听模板目录及其所有子目录
+func (g *Generator) watchTemplateDirs(watcher *fsnotify.Watcher, dir string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		err = watcher.Add(path)
+		if err != nil {
+			return fmt.Errorf("监听模板目录失败 [%s]: %v", path, err)
+		}
+		return nil
+	})
+}
+
+// watchedTemplateDir 获取当前生成目标的模板目录
+func (g *Generator) watchedTemplateDir() string {
+	return filepath.Join(g.templateDir(), g.target())
+}
+
+// isTemplatePath 判断路径是否位于当前生成目标的模板目录下
+func (g *Generator) isTemplatePath(path string) bool {
+	rel, err := filepath.Rel(g.watchedTemplateDir(), path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// relTemplatePath 获取相对模板根目录的路径，用于输出
+func (g *Generator) relTemplatePath(path string) string {
+	rel, err := filepath.Rel(g.templateDir(), path)
+	if err != nil {
+		return path
+	}
+	return filepath.ToSlash(rel)
+}
+
+// isWatchedFile 判断变更的文件是否影响生成结果
+func (g *Generator) isWatchedFile(path string, opts WatchOptions) bool {
+	path = filepath.Clean(path)
+	for _, file := range []string{opts.ConfigFile, opts.DDLFile} {
+		if file != "" && path == filepath.Clean(file) {
+			return true
+		}
+	}
+	return strings.HasSuffix(path, ".tpl") && g.isTemplatePath(path)
+}
+
+// diffTableNames 对比两次的表结构，返回新增或有变化的表名，以及已删除的表名
+func diffTableNames(prev, next []Table) (map[string]bool, []string) {
+	prevTables := map[string]Table{}
+	for _, table := range prev {
+		prevTables[table.TableName] = table
+	}
+
+	changed := map[string]bool{}
+	for _, table := range next {
+		if old, ok := prevTables[table.TableName]; !ok || !reflect.DeepEqual(old, table) {
+			changed[table.TableName] = true
+		}
+		delete(prevTables, table.TableName)
+	}
+
+	var removed []string
+	for name := range prevTables {
+		removed = append(removed, name)
+	}
+	sort.Strings(removed)
+	return changed, removed
+}
+
+// sortedPaths 按路径排序，保证输出顺序稳定
+func sortedPaths[V any](m map[string]V) []string {
+	paths := make([]string, 0, len(m))
+	for path := range m {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
@@ -0,0 +1,128 @@
+package gencode
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// syncBuffer 可并发读写的输出
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestWatch(t *testing.T) {
+	templatePath := t.TempDir()
+	templateDir := filepath.Join(templatePath, "pkg/gencode/template/java")
+	if err := os.MkdirAll(templateDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeFile := func(path, content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	entityTemplate := filepath.Join(templateDir, "entity.tpl")
+	writeFile(entityTemplate, "@@Meta.Output=\"/{{.ClassName}}.txt\"\n{{.ClassName}}:{{range .Table.Fields}} {{.ColumnName}}{{end}}\n")
+	writeFile(filepath.Join(templateDir, "tables.tpl"), "@@Meta.Output=\"/tables.txt\"\n{{range .Tables}}{{.TableName}} {{end}}\n")
+
+	sourceDir := t.TempDir()
+	ddlFile := filepath.Join(sourceDir, "schema.sql")
+	writeFile(ddlFile, "CREATE TABLE `user` (`id` bigint NOT NULL, PRIMARY KEY (`id`));\nCREATE TABLE `product` (`id` bigint NOT NULL, PRIMARY KEY (`id`));\n")
+
+	outputPath := t.TempDir()
+	g := NewGenerator(testConfig(outputPath), nil)
+	g.TemplatePath = templatePath
+
+	ctx, cancel := context.WithCancel(context.Background())
+	out := &syncBuffer{}
+	done := make(chan error, 1)
+	go func() {
+		done <- g.Watch(ctx, WatchOptions{DDLFile: ddlFile, Output: out, Delay: 20 * time.Millisecond})
+	}()
+
+	// waitFor 等待 offset 之后的输出中出现 expected，返回新增的输出
+	waitFor := func(offset int, expected string) string {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for time.Now().Before(deadline) {
+			if output := out.String()[offset:]; strings.Contains(output, expected) {
+				return output
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("等待输出超时: %s\n%s", expected, out.String())
+		return ""
+	}
+	readFile := func(name string) string {
+		t.Helper()
+		content, err := os.ReadFile(filepath.Join(outputPath, name))
+		if err != nil {
+			t.Fatalf("读取文件失败: %v", err)
+		}
+		return string(content)
+	}
+
+	output := waitFor(0, "已渲染 3 个文件，写入 3 个")
+	for _, name := range []string{"User.txt", "Product.txt", "tables.txt"} {
+		if !strings.Contains(output, "新增 "+name) {
+			t.Errorf("缺少状态: 新增 %s\n%s", name, output)
+		}
+	}
+
+	// 只修改 product 表，只重新生成 product 的文件
+	offset := len(out.String())
+	writeFile(ddlFile, "CREATE TABLE `user` (`id` bigint NOT NULL, PRIMARY KEY (`id`));\nCREATE TABLE `product` (`id` bigint NOT NULL, `name` varchar(64), PRIMARY KEY (`id`));\n")
+	output = waitFor(offset, "写入 1 个")
+	if !strings.Contains(output, "更新 Product.txt") || strings.Contains(output, "User.txt") {
+		t.Errorf("表结构变更只应更新 Product.txt\n%s", output)
+	}
+	if content := readFile("Product.txt"); content != "Product: id name\n" {
+		t.Errorf("Product.txt = %q", content)
+	}
+
+	// 修改模板后为所有表重新生成
+	offset = len(out.String())
+	writeFile(entityTemplate, "@@Meta.Output=\"/{{.ClassName}}.txt\"\n{{.ClassName}} ->{{range .Table.Fields}} {{.ColumnName}}{{end}}\n")
+	output = waitFor(offset, "已渲染 2 个文件，写入 2 个")
+	if !strings.Contains(output, "模板已变更: java/entity.tpl") || strings.Contains(output, "tables.txt") {
+		t.Errorf("模板变更只应重新生成该模板\n%s", output)
+	}
+	if content := readFile("User.txt"); content != "User -> id\n" {
+		t.Errorf("User.txt = %q", content)
+	}
+
+	// 模板出错时输出错误并继续监听
+	offset = len(out.String())
+	writeFile(entityTemplate, "@@Meta.Output=\"/{{.ClassName}}.txt\"\n{{.ClassName\n")
+	waitFor(offset, "生成失败")
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Watch 返回错误: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("取消后 Watch 未返回")
+	}
+}